@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
@@ -31,7 +32,7 @@ func runMain(ctx context.Context) error {
 	}
 
 	wg := sync.WaitGroup{}
-	wg.Add(2)
+	wg.Add(6)
 
 	grpcServer := e.LinksGRPCServer
 
@@ -45,7 +46,39 @@ func runMain(ctx context.Context) error {
 	go func() {
 		defer wg.Done()
 		if err := e.LinkUpdater.Run(ctx); err != nil {
-			slog.Error("link updater Run: %w", err)
+			slog.Error("link updater Run", slog.Any("err", err))
+		}
+	}()
+
+	// Фоновый импорт закладок
+	go func() {
+		defer wg.Done()
+		if err := e.Importer.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("importer Run", slog.Any("err", err))
+		}
+	}()
+
+	// Сборка выгрузок данных пользователей
+	go func() {
+		defer wg.Done()
+		if err := e.UserExport.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("user export Run", slog.Any("err", err))
+		}
+	}()
+
+	// Очистка данных удаленных пользователей по событиям users-srv
+	go func() {
+		defer wg.Done()
+		if err := e.UserCleanup.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("user cleanup Run", slog.Any("err", err))
+		}
+	}()
+
+	// Очистка корзины от ссылок, срок хранения которых истек
+	go func() {
+		defer wg.Done()
+		if err := e.LinksTrash.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("links trash Run", slog.Any("err", err))
 		}
 	}()
 
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
@@ -31,7 +32,7 @@ func runMain(ctx context.Context) error {
 	}
 
 	wg := sync.WaitGroup{}
-	wg.Add(1)
+	wg.Add(2)
 
 	grpcServer := e.UsersGRPCServer
 
@@ -41,6 +42,14 @@ func runMain(ctx context.Context) error {
 		grpcServer.Stop()
 	}()
 
+	// Очистка удаленных пользователей, срок хранения которых истек
+	go func() {
+		defer wg.Done()
+		if err := e.UsersTrash.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("users trash Run", slog.Any("err", err))
+		}
+	}()
+
 	go func() {
 		defer wg.Done()
 		slog.Info(fmt.Sprintf("users grpc was started %s", e.Config.UsersService.GRPCServer.Addr))
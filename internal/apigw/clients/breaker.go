@@ -0,0 +1,116 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/breaker"
+)
+
+// unaryBreaker не пускает вызовы к сервису, пока его выключатель разомкнут.
+func unaryBreaker(name string, cb *breaker.Breaker) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if err := allow(name, cb); err != nil {
+			return err
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		record(name, cb, err)
+
+		return err
+	}
+}
+
+// streamBreaker делает то же для потоковых вызовов. Их результат известен только после первого ответа сервиса.
+func streamBreaker(name string, cb *breaker.Breaker) grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		if err := allow(name, cb); err != nil {
+			return nil, err
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			record(name, cb, err)
+			return nil, err
+		}
+
+		s := &recordedStream{ClientStream: stream, name: name, cb: cb}
+		// Если до ответа дело не дошло, вызов не должен навсегда занять пробу выключателя
+		go func() {
+			<-ctx.Done()
+			s.once.Do(cb.Cancel)
+		}()
+
+		return s, nil
+	}
+}
+
+type recordedStream struct {
+	grpc.ClientStream
+	name string
+	cb   *breaker.Breaker
+	once sync.Once
+}
+
+func (s *recordedStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	s.once.Do(func() {
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+		record(s.name, s.cb, err)
+	})
+
+	return err
+}
+
+// allow возвращает Unavailable с подсказкой RetryInfo, если выключатель разомкнут.
+func allow(name string, cb *breaker.Breaker) error {
+	wait, ok := cb.Allow()
+	if ok {
+		return nil
+	}
+
+	st, err := status.New(codes.Unavailable, name+" is unavailable: circuit breaker is open").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)})
+	if err != nil {
+		return status.Error(codes.Unavailable, name+" is unavailable: circuit breaker is open")
+	}
+
+	return st.Err()
+}
+
+// record сообщает выключателю результат вызова. Отказом сервиса считаются только недоступность и
+// истекший срок: ошибки запроса вроде NotFound или InvalidArgument говорят о том, что сервис работает.
+func record(name string, cb *breaker.Breaker, err error) {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		before := cb.State()
+		cb.Failure()
+		if before != breaker.Open && cb.State() == breaker.Open {
+			slog.Warn("circuit breaker is open", slog.String("service", name), slog.Any("err", err))
+		}
+	case codes.Canceled:
+		cb.Cancel()
+	default:
+		if cb.State() != breaker.Closed {
+			slog.Info("circuit breaker is closed", slog.String("service", name))
+		}
+		cb.Success()
+	}
+}
@@ -0,0 +1,196 @@
+// Package clients создает подключения api-gw к сервисам: с балансировкой между адресами,
+// сроками и повторами вызовов из service config gRPC и автоматическим выключателем на каждый сервис.
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/breaker"
+)
+
+// Options - настройки подключения к одному сервису.
+type Options struct {
+	// Name - имя сервиса в ошибках и логах, например users-srv
+	Name string
+	// Addr - host:port, dns:///host:port или несколько host:port через запятую
+	Addr string
+	// Services - сервисы gRPC по этому адресу, из них берется список методов для service config
+	Services []grpc.ServiceDesc
+	// Timeout - срок unary вызова, если для метода не задан свой в MethodTimeouts.
+	// Потоковым вызовам срок задает обработчик, потому что выгрузки идут долго
+	Timeout        time.Duration
+	MethodTimeouts map[string]time.Duration // имя метода -> срок
+	Retry          RetryOptions
+	Breaker        BreakerOptions
+}
+
+// RetryOptions - повторы идемпотентных вызовов, отклоненных недоступным сервисом.
+type RetryOptions struct {
+	MaxAttempts    int // 1 и меньше отключает повторы, больше 5 gRPC не делает
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// BreakerOptions - выключатель размыкается после Threshold отказов подряд на OpenFor.
+type BreakerOptions struct {
+	Threshold int
+	OpenFor   time.Duration
+}
+
+// Dial создает подключение к сервису. Адрес разрешается при первом вызове, поэтому сервис может быть еще не запущен.
+func Dial(ctx context.Context, opts Options) (*grpc.ClientConn, error) {
+	sc, err := serviceConfig(opts)
+	if err != nil {
+		return nil, fmt.Errorf("build service config: %w", err)
+	}
+
+	cb := breaker.New(opts.Breaker.Threshold, opts.Breaker.OpenFor)
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(sc),
+		grpc.WithChainUnaryInterceptor(unaryBreaker(opts.Name, cb)),
+		grpc.WithChainStreamInterceptor(streamBreaker(opts.Name, cb)),
+	}
+
+	target := opts.Addr
+	if addrs := splitAddrs(opts.Addr); len(addrs) > 1 {
+		// Статический список адресов отдается балансировщику через собственный resolver
+		r := manual.NewBuilderWithScheme("static-" + strings.ReplaceAll(strings.ToLower(opts.Name), "_", "-"))
+		state := resolver.State{Addresses: make([]resolver.Address, len(addrs))}
+		for i, addr := range addrs {
+			state.Addresses[i] = resolver.Address{Addr: addr}
+		}
+		r.InitialState(state)
+
+		target = r.Scheme() + ":///" + opts.Name
+		dialOpts = append(dialOpts, grpc.WithResolvers(r))
+	}
+
+	conn, err := grpc.DialContext(ctx, target, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("grpc DialContext %s: %w", opts.Name, err)
+	}
+
+	return conn, nil
+}
+
+func splitAddrs(addr string) []string {
+	var res []string
+	for _, a := range strings.Split(addr, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			res = append(res, a)
+		}
+	}
+
+	return res
+}
+
+// idempotentPrefixes - методы, которые только читают данные и поэтому безопасно повторяются.
+// ResolveSlug и OpenShare не входят: они записывают переходы.
+var idempotentPrefixes = []string{"Get", "List", "Find", "Export", "Download"}
+
+func idempotent(method string) bool {
+	for _, p := range idempotentPrefixes {
+		if strings.HasPrefix(method, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	Timeout     string       `json:"timeout,omitempty"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// serviceConfig собирает service config gRPC: round robin между адресами, срок и политику повторов каждого метода.
+func serviceConfig(opts Options) (string, error) {
+	var retry *retryPolicy
+	if opts.Retry.MaxAttempts > 1 {
+		retry = &retryPolicy{
+			MaxAttempts:          opts.Retry.MaxAttempts,
+			InitialBackoff:       protoDuration(opts.Retry.InitialBackoff),
+			MaxBackoff:           protoDuration(opts.Retry.MaxBackoff),
+			BackoffMultiplier:    2,
+			RetryableStatusCodes: []string{"UNAVAILABLE"},
+		}
+	}
+
+	known := make(map[string]bool)
+	var methods []methodConfig
+	add := func(service, method string, unary bool) {
+		known[method] = true
+
+		mc := methodConfig{Name: []methodName{{Service: service, Method: method}}}
+		if timeout, ok := opts.MethodTimeouts[method]; ok {
+			mc.Timeout = protoDuration(timeout)
+		} else if unary && opts.Timeout > 0 {
+			mc.Timeout = protoDuration(opts.Timeout)
+		}
+		if idempotent(method) {
+			mc.RetryPolicy = retry
+		}
+
+		if mc.Timeout != "" || mc.RetryPolicy != nil {
+			methods = append(methods, mc)
+		}
+	}
+
+	for _, sd := range opts.Services {
+		for _, m := range sd.Methods {
+			add(sd.ServiceName, m.MethodName, true)
+		}
+		for _, s := range sd.Streams {
+			add(sd.ServiceName, s.StreamName, false)
+		}
+	}
+
+	for method := range opts.MethodTimeouts {
+		if !known[method] {
+			return "", fmt.Errorf("unknown method %q in timeouts of %s", method, opts.Name)
+		}
+	}
+
+	b, err := json.Marshal(struct {
+		LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig"`
+		MethodConfig        []methodConfig        `json:"methodConfig,omitempty"`
+	}{
+		LoadBalancingConfig: []map[string]struct{}{{"round_robin": {}}},
+		MethodConfig:        methods,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// protoDuration записывает срок в формате google.protobuf.Duration, например "0.5s".
+func protoDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
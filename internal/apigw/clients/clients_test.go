@@ -0,0 +1,151 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/breaker"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func testOptions() Options {
+	return Options{
+		Name:           "links-srv",
+		Addr:           "127.0.0.1:51000,127.0.0.1:51001",
+		Services:       []grpc.ServiceDesc{pb.LinkService_ServiceDesc, pb.ShortenerService_ServiceDesc},
+		Timeout:        2 * time.Second,
+		MethodTimeouts: map[string]time.Duration{"FindLinks": 5 * time.Second, "ExportLinks": time.Minute},
+		Retry:          RetryOptions{MaxAttempts: 3, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second},
+		Breaker:        BreakerOptions{Threshold: 2, OpenFor: time.Second},
+	}
+}
+
+func TestServiceConfig(t *testing.T) {
+	sc, err := serviceConfig(testOptions())
+	if err != nil {
+		t.Fatalf("serviceConfig() error = %v", err)
+	}
+
+	var parsed struct {
+		MethodConfig []methodConfig `json:"methodConfig"`
+	}
+	if err := json.Unmarshal([]byte(sc), &parsed); err != nil {
+		t.Fatalf("service config is not JSON: %v", err)
+	}
+
+	methods := make(map[string]methodConfig)
+	for _, mc := range parsed.MethodConfig {
+		methods[mc.Name[0].Method] = mc
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		wantTimeout string
+		wantRetry   bool
+	}{
+		{
+			name:        "test_idempotent_unary",
+			method:      "GetLink",
+			wantTimeout: "2s",
+			wantRetry:   true,
+		},
+		{
+			name:        "test_write_is_not_retried",
+			method:      "CreateLink",
+			wantTimeout: "2s",
+		},
+		{
+			name:        "test_resolve_records_clicks",
+			method:      "ResolveSlug",
+			wantTimeout: "2s",
+		},
+		{
+			name:        "test_method_timeout",
+			method:      "FindLinks",
+			wantTimeout: "5s",
+			wantRetry:   true,
+		},
+		{
+			name:        "test_stream_with_timeout",
+			method:      "ExportLinks",
+			wantTimeout: "60s",
+			wantRetry:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc, ok := methods[tt.method]
+			if !ok {
+				t.Fatalf("no config for %s", tt.method)
+			}
+			if mc.Timeout != tt.wantTimeout {
+				t.Errorf("timeout = %q, want %q", mc.Timeout, tt.wantTimeout)
+			}
+			if got := mc.RetryPolicy != nil; got != tt.wantRetry {
+				t.Errorf("retry = %v, want %v", got, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestServiceConfig_UnknownMethod(t *testing.T) {
+	opts := testOptions()
+	opts.MethodTimeouts = map[string]time.Duration{"GetUser": time.Second}
+
+	if _, err := serviceConfig(opts); err == nil {
+		t.Error("serviceConfig() error = nil for a method of another service")
+	}
+}
+
+func TestDial(t *testing.T) {
+	// gRPC разбирает service config при подключении, так что ошибка в нем проявилась бы здесь
+	for _, addr := range []string{"127.0.0.1:51000", "dns:///localhost:51000", "127.0.0.1:51000, 127.0.0.1:51001"} {
+		opts := testOptions()
+		opts.Addr = addr
+
+		conn, err := Dial(context.Background(), opts)
+		if err != nil {
+			t.Fatalf("Dial(%q) error = %v", addr, err)
+		}
+		conn.Close()
+	}
+}
+
+func TestUnaryBreaker(t *testing.T) {
+	cb := breaker.New(2, time.Minute)
+	interceptor := unaryBreaker("links-srv", cb)
+
+	call := func(err error) error {
+		return interceptor(context.Background(), "/pb.LinkService/GetLink", nil, nil, nil,
+			func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+				return err
+			},
+		)
+	}
+
+	// Ошибки запроса не считаются отказом сервиса
+	for i := 0; i < 3; i++ {
+		call(status.Error(codes.NotFound, "not found"))
+	}
+	if got := cb.State(); got != breaker.Closed {
+		t.Fatalf("State() = %s after NotFound errors, want closed", got)
+	}
+
+	call(status.Error(codes.Unavailable, "down"))
+	call(status.Error(codes.DeadlineExceeded, "slow"))
+
+	err := call(nil)
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("call with open breaker error = %v, want Unavailable", err)
+	}
+	if len(status.Convert(err).Details()) == 0 {
+		t.Error("open breaker error has no RetryInfo")
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
+)
+
+// requestMeta передает сведения о запросе в сервисы через метаданные gRPC вызовов обработчиков
+// и возвращает клиенту ID запроса для поиска в логах.
+func requestMeta(proxies reqmeta.Proxies) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			info := reqmeta.FromHTTP(r, proxies)
+			w.Header().Set(reqmeta.HeaderRequestID, info.RequestID)
+			next.ServeHTTP(w, r.WithContext(info.AppendOutgoing(r.Context())))
+		})
+	}
+}
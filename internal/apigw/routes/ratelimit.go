@@ -0,0 +1,118 @@
+package routes
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/ratelimit"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
+)
+
+// RateLimits - ограничения частоты запросов одного клиента по группам маршрутов.
+type RateLimits struct {
+	Store   ratelimit.Store
+	Reads   ratelimit.Limit
+	Writes  ratelimit.Limit
+	Imports ratelimit.Limit // загрузка файлов закладок, самая тяжелая операция
+}
+
+type routeGroup string
+
+const (
+	groupReads   routeGroup = "reads"
+	groupWrites  routeGroup = "writes"
+	groupImports routeGroup = "imports"
+)
+
+func (l RateLimits) limit(group routeGroup) ratelimit.Limit {
+	switch group {
+	case groupImports:
+		return l.Imports
+	case groupWrites:
+		return l.Writes
+	default:
+		return l.Reads
+	}
+}
+
+// groupOf относит запрос к группе: запуск импорта, прочие изменения или чтение.
+func groupOf(r *http.Request) routeGroup {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return groupReads
+	}
+
+	if r.URL.Path == apiPrefix+"/import" {
+		return groupImports
+	}
+
+	return groupWrites
+}
+
+// clientKey определяет, чьи запросы считаются вместе. X-API-Key и X-User-ID api-gw не проверяет,
+// и клиент, меняя их, получал бы новую корзину на каждый запрос, поэтому запросы считаются по адресу.
+func clientKey(r *http.Request, proxies reqmeta.Proxies) string {
+	return "ip:" + proxies.ClientIP(r)
+}
+
+// rateLimit отклоняет запросы сверх лимита с 429 и сообщает клиенту состояние лимита
+// в заголовках RateLimit-* по черновику IETF httpapi-ratelimit-headers.
+func rateLimit(limits RateLimits, proxies reqmeta.Proxies) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			group := groupOf(r)
+			limit := limits.limit(group)
+			if limit.Requests <= 0 || limit.Per <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			res, err := limits.Store.Take(r.Context(), string(group)+":"+clientKey(r, proxies), limit)
+			if err != nil {
+				// Без хранилища лимитов api-gw продолжает работать, иначе его отказ остановил бы все запросы
+				slog.Error("cannot check rate limit", slog.String("group", string(group)), slog.Any("err", err))
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h := w.Header()
+			h.Set("RateLimit-Policy", strconv.Itoa(limit.Requests)+";w="+strconv.Itoa(ceilSeconds(limit.Per)))
+			h.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+			h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			h.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(res.Reset)))
+
+			if !res.Allowed {
+				h.Set("Retry-After", strconv.Itoa(max(ceilSeconds(res.RetryAfter), 1)))
+				writeTooManyRequests(w, group)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeTooManyRequests(w http.ResponseWriter, group routeGroup) {
+	message := "rate limit exceeded for " + string(group)
+	b, err := json.Marshal(apiv1.Error{Code: apiv1.TooManyRequests, Message: &message})
+	if err != nil {
+		slog.Error("cannot marshal rate limit error", slog.Any("err", err))
+		http.Error(w, message, http.StatusTooManyRequests)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	if _, err := w.Write(b); err != nil {
+		slog.Error("cannot write rate limit error", slog.Any("err", err))
+	}
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,109 @@
+package routes
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/ratelimit"
+)
+
+func TestRateLimit(t *testing.T) {
+	limits := RateLimits{
+		Store:   ratelimit.NewMemory(100),
+		Reads:   ratelimit.Limit{Requests: 2, Per: time.Minute},
+		Writes:  ratelimit.Limit{Requests: 1, Per: time.Minute},
+		Imports: ratelimit.Limit{Requests: 1, Per: time.Hour},
+	}
+	handler := rateLimit(limits, nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	do := func(method, path string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		headers       map[string]string
+		wantCode      int
+		wantRemaining string
+	}{
+		{
+			name:          "test_first_read",
+			method:        http.MethodGet,
+			path:          "/api/v1/links",
+			wantCode:      http.StatusNoContent,
+			wantRemaining: "1",
+		},
+		{
+			name:          "test_second_read",
+			method:        http.MethodGet,
+			path:          "/api/v1/users",
+			wantCode:      http.StatusNoContent,
+			wantRemaining: "0",
+		},
+		{
+			name:     "test_read_over_limit",
+			method:   http.MethodGet,
+			path:     "/api/v1/links",
+			wantCode: http.StatusTooManyRequests,
+		},
+		{
+			name:          "test_writes_have_own_limit",
+			method:        http.MethodPost,
+			path:          "/api/v1/links",
+			wantCode:      http.StatusNoContent,
+			wantRemaining: "0",
+		},
+		{
+			name:          "test_imports_have_own_limit",
+			method:        http.MethodPost,
+			path:          "/api/v1/import",
+			wantCode:      http.StatusNoContent,
+			wantRemaining: "0",
+		},
+		{
+			name:     "test_unverified_headers_do_not_change_client",
+			method:   http.MethodGet,
+			path:     "/api/v1/links",
+			headers:  map[string]string{"X-User-ID": "42", "X-API-Key": "secret"},
+			wantCode: http.StatusTooManyRequests,
+		},
+		{
+			name:     "test_forwarded_for_from_untrusted_peer_is_ignored",
+			method:   http.MethodGet,
+			path:     "/api/v1/links",
+			headers:  map[string]string{"X-Forwarded-For": "203.0.113.7"},
+			wantCode: http.StatusTooManyRequests,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := do(tt.method, tt.path, tt.headers)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantCode)
+			}
+			if tt.wantCode == http.StatusTooManyRequests {
+				if rec.Header().Get("Retry-After") == "" {
+					t.Error("no Retry-After header in 429 response")
+				}
+				return
+			}
+			if got := rec.Header().Get("RateLimit-Remaining"); got != tt.wantRemaining {
+				t.Errorf("RateLimit-Remaining = %q, want %q", got, tt.wantRemaining)
+			}
+		})
+	}
+}
@@ -1,26 +1,60 @@
 package routes
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
 
 	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
 )
 
-// Router has base path /api/v1.
-func Router(handler apiv1.ServerInterface) http.Handler {
+type Handler interface {
+	apiv1.ServerInterface
+	RedirectSlug(w http.ResponseWriter, r *http.Request)
+	UserAtomFeed(w http.ResponseWriter, r *http.Request)
+	TagRSSFeed(w http.ResponseWriter, r *http.Request)
+}
+
+// Options настраивает роутер api-gw.
+type Options struct {
+	// ValidateResponses включает проверку ответов /api/v1 по спецификации. Ответы копятся в памяти,
+	// поэтому режим предназначен для тестов.
+	ValidateResponses bool
+	// RateLimits ограничивает частоту запросов каждого клиента, nil отключает ограничение
+	RateLimits *RateLimits
+	// TrustedProxies - балансировщики перед api-gw. По ним определяется адрес клиента для журнала аудита,
+	// статистики переходов и ограничения частоты запросов
+	TrustedProxies reqmeta.Proxies
+}
+
+// Router has base path /api/v1, short links are served at /s/{slug} and feeds at /feeds.
+// Requests to /api/v1 are validated against the OpenAPI spec.
+func Router(handler Handler, opts Options) (http.Handler, error) {
+	validator, err := newValidator(opts.ValidateResponses)
+	if err != nil {
+		return nil, fmt.Errorf("load OpenAPI spec: %w", err)
+	}
+
 	router := chi.NewRouter()
+	router.Use(requestMeta(opts.TrustedProxies))
+	if opts.RateLimits != nil {
+		router.Use(rateLimit(*opts.RateLimits, opts.TrustedProxies))
+	}
+	router.Get("/s/{slug}", handler.RedirectSlug)
+	router.Get("/feeds/users/{id}.atom", handler.UserAtomFeed)
+	router.Get("/feeds/users/{id}/tags/{tag}", handler.TagRSSFeed)
 	router.Mount(
-		"/api", apiv1.HandlerWithOptions(
+		"/api", validator.middleware(apiv1.HandlerWithOptions(
 			handler, apiv1.ChiServerOptions{
 				BaseURL: "/v1",
 				ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
 					slog.Error("handle error", slog.String("err", err.Error()))
 				},
 			},
-		),
+		)),
 	)
-	return router
+	return router, nil
 }
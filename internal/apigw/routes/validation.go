@@ -0,0 +1,260 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+)
+
+const apiPrefix = "/api/v1"
+
+// validator проверяет запросы к /api/v1 по встроенной в apiv1 спецификации, а в режиме для тестов - и ответы.
+type validator struct {
+	router            routers.Router
+	validateResponses bool
+}
+
+func newValidator(validateResponses bool) (*validator, error) {
+	spec, err := apiv1.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	// Сервер в спецификации не задан, поэтому маршруты ищутся по пути без префикса, см. findRoute
+	spec.Servers = nil
+
+	router, err := legacy.NewRouter(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &validator{router: router, validateResponses: validateResponses}, nil
+}
+
+func (v *validator) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := v.findRoute(r)
+		if err != nil {
+			// Неизвестные пути и методы обрабатывает роутер: 404 и 405
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				MultiError:         true,
+				AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+				// Загрузка файла импорта большая, ее формат проверяет сам links-srv
+				ExcludeRequestBody: isMultipart(r.Header.Get("Content-Type")),
+			},
+		}
+		if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+			writeViolations(w, http.StatusBadRequest, "request does not match the API specification", violations(err))
+			return
+		}
+
+		if !v.validateResponses {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &responseRecorder{header: http.Header{}, code: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		v.writeValidated(r.Context(), w, rec, input)
+	})
+}
+
+// findRoute ищет операцию спецификации по пути запроса без префикса /api/v1.
+func (v *validator) findRoute(r *http.Request) (*routers.Route, map[string]string, error) {
+	if !strings.HasPrefix(r.URL.Path, apiPrefix+"/") {
+		return nil, nil, routers.ErrPathNotFound
+	}
+
+	stripped := r.Clone(r.Context())
+	stripped.URL.Path = strings.TrimPrefix(r.URL.Path, apiPrefix)
+	stripped.URL.RawPath = ""
+
+	return v.router.FindRoute(stripped)
+}
+
+// writeValidated отдает записанный ответ, если он соответствует спецификации, и 500 со списком нарушений, если нет.
+func (v *validator) writeValidated(
+	ctx context.Context, w http.ResponseWriter, rec *responseRecorder, input *openapi3filter.RequestValidationInput,
+) {
+	err := openapi3filter.ValidateResponse(ctx, &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 rec.code,
+		Header:                 rec.header,
+		Body:                   io.NopCloser(bytes.NewReader(rec.body.Bytes())),
+		Options: &openapi3filter.Options{
+			MultiError:            true,
+			IncludeResponseStatus: true,
+			// Тела кроме JSON, например архивы и снимки страниц, не проверяются
+			ExcludeResponseBody: !isJSON(rec.header.Get("Content-Type")),
+		},
+	})
+	if err != nil {
+		list := violations(err)
+		slog.Error(
+			"response does not match the API specification",
+			slog.String("method", input.Request.Method),
+			slog.String("path", input.Request.URL.Path),
+			slog.Any("violations", list),
+		)
+		writeViolations(w, http.StatusInternalServerError, "response does not match the API specification", list)
+		return
+	}
+
+	for k, values := range rec.header {
+		w.Header()[k] = values
+	}
+	w.WriteHeader(rec.code)
+	if _, err := w.Write(rec.body.Bytes()); err != nil {
+		slog.Error("cannot write validated response", slog.Any("err", err))
+	}
+}
+
+// violations раскладывает ошибку проверки на отдельные нарушения, упорядоченные по месту и полю.
+func violations(err error) []apiv1.Violation {
+	var res []apiv1.Violation
+
+	var walk func(err error, in, field string)
+	walk = func(err error, in, field string) {
+		var (
+			multi     openapi3.MultiError
+			reqErr    *openapi3filter.RequestError
+			respErr   *openapi3filter.ResponseError
+			schemaErr *openapi3.SchemaError
+		)
+		switch {
+		case errors.As(err, &multi):
+			for _, e := range multi {
+				walk(e, in, field)
+			}
+		case errors.As(err, &reqErr):
+			in, field = "body", ""
+			if p := reqErr.Parameter; p != nil {
+				in, field = p.In, p.Name
+			}
+			if reqErr.Err == nil {
+				res = append(res, violation(in, field, reqErr.Reason))
+				return
+			}
+			walk(reqErr.Err, in, field)
+		case errors.As(err, &respErr):
+			if respErr.Err == nil {
+				res = append(res, violation("response", "", respErr.Reason))
+				return
+			}
+			walk(respErr.Err, "response", "")
+		case errors.As(err, &schemaErr):
+			if path := schemaErr.JSONPointer(); len(path) > 0 {
+				field = strings.Join(append(nonEmpty(field), path...), ".")
+			}
+			res = append(res, violation(in, field, schemaErr.Reason))
+		default:
+			res = append(res, violation(in, field, err.Error()))
+		}
+	}
+	walk(err, "", "")
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].In != res[j].In {
+			return res[i].In < res[j].In
+		}
+		return value(res[i].Field) < value(res[j].Field)
+	})
+
+	return res
+}
+
+func violation(in, field, message string) apiv1.Violation {
+	v := apiv1.Violation{In: in, Message: message}
+	if field != "" {
+		v.Field = &field
+	}
+
+	return v
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func nonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return []string{s}
+}
+
+func writeViolations(w http.ResponseWriter, code int, message string, list []apiv1.Violation) {
+	errCode := apiv1.BadRequest
+	if code == http.StatusInternalServerError {
+		errCode = apiv1.InternalServerError
+	}
+
+	b, err := json.Marshal(apiv1.Error{Code: errCode, Message: &message, Violations: &list})
+	if err != nil {
+		slog.Error("cannot marshal validation error", slog.Any("err", err))
+		http.Error(w, message, code)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if _, err := w.Write(b); err != nil {
+		slog.Error("cannot write validation error", slog.Any("err", err))
+	}
+}
+
+func isMultipart(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return strings.HasPrefix(mediaType, "multipart/")
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "application/json"
+}
+
+// responseRecorder копит ответ обработчика, чтобы проверить его до отправки клиенту.
+type responseRecorder struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	r.code = code
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
@@ -0,0 +1,155 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+)
+
+func TestValidator_Request(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantCode   int
+		wantFields []string
+	}{
+		{
+			name:     "test_valid_link",
+			method:   http.MethodPost,
+			path:     "/api/v1/links",
+			body:     `{"url": "https://go.dev/", "tags": ["go"]}`,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:       "test_link_without_url",
+			method:     http.MethodPost,
+			path:       "/api/v1/links",
+			body:       `{"title": "go"}`,
+			wantCode:   http.StatusBadRequest,
+			wantFields: []string{"url"},
+		},
+		{
+			name:       "test_link_javascript_url_and_long_tag",
+			method:     http.MethodPost,
+			path:       "/api/v1/links",
+			body:       `{"url": "javascript:alert(1)", "tags": ["` + strings.Repeat("t", 51) + `"]}`,
+			wantCode:   http.StatusBadRequest,
+			wantFields: []string{"tags.0", "url"},
+		},
+		{
+			name:       "test_unknown_field",
+			method:     http.MethodPost,
+			path:       "/api/v1/users",
+			body:       `{"username": "pavel", "password": "long-password", "admin": true}`,
+			wantCode:   http.StatusBadRequest,
+			wantFields: []string{""},
+		},
+		{
+			name:       "test_bad_username",
+			method:     http.MethodPost,
+			path:       "/api/v1/users",
+			body:       `{"username": "p a", "password": "long-password"}`,
+			wantCode:   http.StatusBadRequest,
+			wantFields: []string{"username"},
+		},
+		{
+			name:       "test_password_without_digit_or_symbol",
+			method:     http.MethodPost,
+			path:       "/api/v1/users",
+			body:       `{"username": "pavel", "password": "longpassword"}`,
+			wantCode:   http.StatusBadRequest,
+			wantFields: []string{"password"},
+		},
+		{
+			name:     "test_password_with_letter_and_symbol",
+			method:   http.MethodPost,
+			path:     "/api/v1/users",
+			body:     `{"username": "pavel", "password": "пароль-длинный"}`,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:       "test_bad_query_parameter",
+			method:     http.MethodGet,
+			path:       "/api/v1/links?limit=ten",
+			wantCode:   http.StatusBadRequest,
+			wantFields: []string{"limit"},
+		},
+		{
+			name:     "test_unknown_path",
+			method:   http.MethodGet,
+			path:     "/api/v1/unknown",
+			wantCode: http.StatusOK,
+		},
+	}
+
+	v, err := newValidator(false)
+	if err != nil {
+		t.Fatalf("newValidator() error = %v", err)
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				r := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+				if tt.body != "" {
+					r.Header.Set("Content-Type", "application/json")
+				}
+				w := httptest.NewRecorder()
+
+				v.middleware(next).ServeHTTP(w, r)
+
+				if w.Code != tt.wantCode {
+					t.Fatalf("status = %d, want %d, body %s", w.Code, tt.wantCode, w.Body.String())
+				}
+				if tt.wantCode != http.StatusBadRequest {
+					return
+				}
+
+				var res apiv1.Error
+				if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+					t.Fatalf("cannot unmarshal error: %v", err)
+				}
+				if res.Violations == nil || len(*res.Violations) != len(tt.wantFields) {
+					t.Fatalf("violations = %+v, want fields %v", res.Violations, tt.wantFields)
+				}
+				for i, v := range *res.Violations {
+					var field string
+					if v.Field != nil {
+						field = *v.Field
+					}
+					if field != tt.wantFields[i] {
+						t.Errorf("violation %d field = %q, want %q (%s)", i, field, tt.wantFields[i], v.Message)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestValidator_Response(t *testing.T) {
+	v, err := newValidator(true)
+	if err != nil {
+		t.Fatalf("newValidator() error = %v", err)
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"link_id": "1"}`))
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/links/1/content", nil)
+	w := httptest.NewRecorder()
+	v.middleware(next).ServeHTTP(w, r)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d for a response without required fields", w.Code, http.StatusInternalServerError)
+	}
+}
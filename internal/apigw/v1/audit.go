@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+// GetAudit отдает журнал аудита. Администратора из X-User-ID и секрет из X-Admin-Token проверяет users-srv,
+// заголовки доходят до него в метаданных запроса.
+func (h *usersHandler) GetAudit(w http.ResponseWriter, r *http.Request, params apiv1.GetAuditParams) {
+	ctx := r.Context()
+
+	req := &pb.ListAuditLogRequest{
+		Actor:  value(params.Actor),
+		Action: string(value(params.Action)),
+		Limit:  value(params.Limit),
+		Offset: value(params.Offset),
+	}
+	if params.From != nil {
+		req.From = params.From.Format(time.RFC3339)
+	}
+	if params.To != nil {
+		req.To = params.To.Format(time.RFC3339)
+	}
+
+	entries, err := h.client.ListAuditLog(ctx, req)
+	if err != nil {
+		writeGRPCError(w, "GetAudit", err, "Cannot get audit log")
+		return
+	}
+
+	res := make([]apiv1.AuditEntry, len(entries.Entries))
+	for i, e := range entries.Entries {
+		res[i] = apiv1.AuditEntry{
+			Id:         e.Id,
+			Actor:      optional(e.Actor),
+			Action:     e.Action,
+			TargetType: e.TargetType,
+			TargetId:   e.TargetId,
+			RequestId:  optional(e.RequestId),
+			Ip:         optional(e.Ip),
+			Before:     auditFields(e.Before),
+			After:      auditFields(e.After),
+			CreatedAt:  e.CreatedAt,
+		}
+	}
+
+	writeJSON(w, "GetAudit", http.StatusOK, res)
+}
+
+// auditFields разбирает поля записи аудита, которые users-srv передает в JSON.
+func auditFields(raw string) *map[string]any {
+	if raw == "" {
+		return nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+		slog.Warn("cannot unmarshal audit entry fields", slog.Any("err", err))
+		return nil
+	}
+
+	return &fields
+}
@@ -0,0 +1,200 @@
+package v1
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func newCollectionsHandler(collectionsClient collectionsClient) *collectionsHandler {
+	return &collectionsHandler{client: collectionsClient}
+}
+
+type collectionsHandler struct {
+	client collectionsClient
+}
+
+func (h *collectionsHandler) GetCollections(w http.ResponseWriter, r *http.Request, params apiv1.GetCollectionsParams) {
+	ctx := r.Context()
+
+	collections, err := h.client.ListCollections(ctx, &pb.ListCollectionsRequest{UserId: params.UserId})
+	if err != nil {
+		writeGRPCError(w, "GetCollections", err, "Cannot get Collections")
+		return
+	}
+
+	res := collections.Collections
+	if res == nil {
+		res = []*pb.Collection{}
+	}
+
+	writeJSON(w, "GetCollections", http.StatusOK, res)
+}
+
+func (h *collectionsHandler) PostCollections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var collectionReq apiv1.CollectionCreate
+	if err := json.NewDecoder(r.Body).Decode(&collectionReq); err != nil {
+		slog.Error("cannot decode request body at PostCollections handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	collection, err := h.client.CreateCollection(ctx, &pb.CreateCollectionRequest{
+		UserId:      collectionReq.UserId,
+		Name:        collectionReq.Name,
+		Description: value(collectionReq.Description),
+		ParentId:    value(collectionReq.ParentId),
+		Position:    value(collectionReq.Position),
+	})
+	if err != nil {
+		writeGRPCError(w, "PostCollections", err, "Cannot create Collection")
+		return
+	}
+
+	writeJSON(w, "PostCollections", http.StatusCreated, collection)
+}
+
+func (h *collectionsHandler) GetCollectionsId(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+
+	collection, err := h.client.GetCollection(ctx, &pb.GetCollectionRequest{Id: id})
+	if err != nil {
+		writeGRPCError(w, "GetCollectionsId", err, "Cannot get Collection")
+		return
+	}
+
+	writeJSON(w, "GetCollectionsId", http.StatusOK, collection)
+}
+
+func (h *collectionsHandler) PutCollectionsId(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+
+	var collectionReq apiv1.CollectionUpdate
+	if err := json.NewDecoder(r.Body).Decode(&collectionReq); err != nil {
+		slog.Error("cannot decode request body at PutCollectionsId handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	collection, err := h.client.UpdateCollection(ctx, &pb.UpdateCollectionRequest{
+		Id:          id,
+		Name:        collectionReq.Name,
+		Description: value(collectionReq.Description),
+		ParentId:    value(collectionReq.ParentId),
+		Position:    value(collectionReq.Position),
+	})
+	if err != nil {
+		writeGRPCError(w, "PutCollectionsId", err, "Cannot update Collection")
+		return
+	}
+
+	writeJSON(w, "PutCollectionsId", http.StatusOK, collection)
+}
+
+func (h *collectionsHandler) DeleteCollectionsId(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+
+	if _, err := h.client.DeleteCollection(ctx, &pb.DeleteCollectionRequest{Id: id}); err != nil {
+		writeGRPCError(w, "DeleteCollectionsId", err, "Cannot delete Collection")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *collectionsHandler) GetCollectionsIdLinks(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.GetCollectionsIdLinksParams,
+) {
+	ctx := r.Context()
+
+	links, err := h.client.ListCollectionLinks(ctx, &pb.GetCollectionRequest{Id: id})
+	if err != nil {
+		writeGRPCError(w, "GetCollectionsIdLinks", err, "Cannot get Collection Links")
+		return
+	}
+
+	res := links.Links
+	if res == nil {
+		res = []*pb.Link{}
+	}
+
+	writeJSON(w, "GetCollectionsIdLinks", http.StatusOK, res)
+}
+
+func (h *collectionsHandler) PostCollectionsIdLinks(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+
+	var linkReq apiv1.CollectionLink
+	if err := json.NewDecoder(r.Body).Decode(&linkReq); err != nil {
+		slog.Error("cannot decode request body at PostCollectionsIdLinks handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, err := h.client.AddLinkToCollection(ctx, &pb.CollectionLinkRequest{CollectionId: id, LinkId: linkReq.LinkId})
+	if err != nil {
+		writeGRPCError(w, "PostCollectionsIdLinks", err, "Cannot add Link to Collection")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *collectionsHandler) PutCollectionsIdLinks(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+
+	var orderReq apiv1.CollectionOrder
+	if err := json.NewDecoder(r.Body).Decode(&orderReq); err != nil {
+		slog.Error("cannot decode request body at PutCollectionsIdLinks handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, err := h.client.ReorderCollectionLinks(ctx, &pb.ReorderCollectionLinksRequest{
+		CollectionId: id,
+		LinkIds:      orderReq.LinkIds,
+	})
+	if err != nil {
+		writeGRPCError(w, "PutCollectionsIdLinks", err, "Cannot reorder Collection Links")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *collectionsHandler) DeleteCollectionsIdLinksLinkID(w http.ResponseWriter, r *http.Request, id string, linkID string) {
+	ctx := r.Context()
+
+	_, err := h.client.RemoveLinkFromCollection(ctx, &pb.CollectionLinkRequest{CollectionId: id, LinkId: linkID})
+	if err != nil {
+		writeGRPCError(w, "DeleteCollectionsIdLinksLinkID", err, "Cannot remove Link from Collection")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// value возвращает значение необязательного поля запроса или нулевое значение, если поле не передано.
+func value[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+
+	return *v
+}
+
+// optional возвращает указатель на значение поля ответа или nil, если значение нулевое.
+func optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+
+	return &v
+}
@@ -11,3 +11,23 @@ type usersClient interface {
 type linksClient interface {
 	pb.LinkServiceClient
 }
+
+type collectionsClient interface {
+	pb.CollectionServiceClient
+}
+
+type sharesClient interface {
+	pb.ShareServiceClient
+}
+
+type shortenerClient interface {
+	pb.ShortenerServiceClient
+}
+
+type importsClient interface {
+	pb.ImportServiceClient
+}
+
+type userExportsClient interface {
+	pb.UserExportServiceClient
+}
@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/validation"
+)
+
+// httpStatus сопоставляет код ошибки gRPC с HTTP статусом.
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeGRPCError отвечает клиенту статусом, соответствующим ошибке gRPC. Сообщения внутренних ошибок
+// наружу не отдаются, вместо них пишется fallback. Нарушения полей из деталей ошибки отдаются списком.
+func writeGRPCError(w http.ResponseWriter, handler string, err error, fallback string) {
+	code := httpStatus(err)
+	if wait, ok := retryAfter(err); ok && code == http.StatusServiceUnavailable {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	}
+	if list := validation.FieldViolations(err); code == http.StatusBadRequest && len(list) > 0 {
+		slog.Info(fallback+" at "+handler+" handler", slog.Any("err", err))
+		writeJSON(w, handler, code, violationsError(err, list))
+		return
+	}
+
+	if code == http.StatusInternalServerError {
+		slog.Error(fallback+" at "+handler+" handler", slog.Any("err", err))
+		http.Error(w, "500 - "+fallback, code)
+		return
+	}
+
+	slog.Info(fallback+" at "+handler+" handler", slog.Any("err", err))
+	http.Error(w, fmt.Sprintf("%d - %s", code, status.Convert(err).Message()), code)
+}
+
+// violationsError переводит нарушения полей из ответа сервиса в ошибку API. Сервисы проверяют поля тела запроса.
+func violationsError(err error, list []*errdetails.BadRequest_FieldViolation) apiv1.Error {
+	violations := make([]apiv1.Violation, 0, len(list))
+	for _, v := range list {
+		violations = append(violations, apiv1.Violation{In: "body", Field: optional(v.GetField()), Message: v.GetDescription()})
+	}
+
+	return apiv1.Error{
+		Code:       apiv1.BadRequest,
+		Message:    optional(status.Convert(err).Message()),
+		Violations: &violations,
+	}
+}
+
+// retryAfter возвращает, через сколько повторить запрос, если сервис или выключатель клиента
+// приложили к ошибке RetryInfo.
+func retryAfter(err error) (time.Duration, bool) {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.RetryInfo); ok && info.RetryDelay != nil {
+			return max(info.RetryDelay.AsDuration(), time.Second), true
+		}
+	}
+
+	return 0, false
+}
+
+func writeJSON(w http.ResponseWriter, handler string, code int, v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		slog.Error("cannot marshal response to JSON at "+handler+" handler", slog.Any("err", err))
+		http.Error(w, "500 - Cannot marshal response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, err = w.Write(b)
+	if err != nil {
+		slog.Error("cannot write response at "+handler+" handler", slog.Any("err", err))
+	}
+}
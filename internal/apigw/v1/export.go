@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/bookmarks"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+const (
+	// exportTimeout ограничивает выгрузку целиком: у пользователя могут быть десятки тысяч ссылок.
+	exportTimeout = 10 * time.Minute
+	// exportFlushEvery - через сколько ссылок отправлять накопленное клиенту.
+	exportFlushEvery = 500
+	// pbTimeLayout - формат time.Time.String, в котором links-srv отдает даты.
+	pbTimeLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+)
+
+func (h *linksHandler) GetLinksExport(w http.ResponseWriter, r *http.Request, params apiv1.GetLinksExportParams) {
+	ctx, cancel := context.WithTimeout(r.Context(), exportTimeout)
+	defer cancel()
+
+	format := bookmarks.ExportJSON
+	if params.Format != nil {
+		format = bookmarks.ExportFormat(*params.Format)
+	}
+
+	bw, err := bookmarks.NewWriter(format, w)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stream, err := h.client.ExportLinks(ctx, &pb.GetLinksByUserId{UserId: params.UserId})
+	if err != nil {
+		writeGRPCError(w, "GetLinksExport", err, "Cannot export Links")
+		return
+	}
+
+	// Первое сообщение читаем до заголовков, чтобы ошибку links-srv можно было вернуть со своим статусом
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		writeGRPCError(w, "GetLinksExport", err, "Cannot export Links")
+		return
+	}
+
+	filename := fmt.Sprintf("links-%s.%s", time.Now().UTC().Format("20060102"), format)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	for n, link := 1, first; link != nil; n++ {
+		if err := bw.Write(linkToBookmark(link)); err != nil {
+			slog.Error("cannot write Link at GetLinksExport handler", slog.Any("err", err))
+			return
+		}
+
+		if flusher != nil && n%exportFlushEvery == 0 {
+			flusher.Flush()
+		}
+
+		link, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// Статус уже отправлен, поэтому обрываем соединение, чтобы клиент не принял обрезанный файл за полный
+			slog.Error("cannot receive Link at GetLinksExport handler", slog.Any("err", err))
+			panic(http.ErrAbortHandler)
+		}
+	}
+
+	if err := bw.Close(); err != nil {
+		slog.Error("cannot finish export at GetLinksExport handler", slog.Any("err", err))
+	}
+}
+
+func linkToBookmark(l *pb.Link) bookmarks.Bookmark {
+	return bookmarks.Bookmark{
+		URL:         l.Url,
+		Title:       l.Title,
+		Description: l.Description,
+		Tags:        l.Tags,
+		AddedAt:     pbTime(l.CreatedAt),
+	}
+}
+
+// pbTime разбирает дату из ответа links-srv, для неизвестного формата возвращает нулевое время.
+func pbTime(s string) time.Time {
+	t, err := time.Parse(pbTimeLayout, s)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
@@ -0,0 +1,158 @@
+package v1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/feed"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+// feedSize - сколько последних ссылок попадает в ленту.
+const feedSize = 50
+
+func newFeedsHandler(usersClient usersClient, linksClient linksClient) *feedsHandler {
+	return &feedsHandler{users: usersClient, links: linksClient}
+}
+
+// feedsHandler отдает ленты для читалок вне /api/v1, поэтому его методы не входят в спецификацию.
+type feedsHandler struct {
+	users usersClient
+	links linksClient
+}
+
+// UserAtomFeed обслуживает /feeds/users/{id}.atom: последние ссылки пользователя.
+func (h *feedsHandler) UserAtomFeed(w http.ResponseWriter, r *http.Request) {
+	h.serveFeed(w, r, "UserAtomFeed", "", feed.ContentTypeAtom, feed.WriteAtom)
+}
+
+// TagRSSFeed обслуживает /feeds/users/{id}/tags/{tag}.rss: последние ссылки пользователя с тегом.
+// Суффикс отрезается здесь, а не в маршруте, потому что тег может содержать точку.
+func (h *feedsHandler) TagRSSFeed(w http.ResponseWriter, r *http.Request) {
+	tag, ok := strings.CutSuffix(urlParam(r, "tag"), ".rss")
+	if !ok || tag == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	h.serveFeed(w, r, "TagRSSFeed", tag, feed.ContentTypeRSS, feed.WriteRSS)
+}
+
+func (h *feedsHandler) serveFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	handler, tag, contentType string,
+	write func(w io.Writer, f feed.Feed) error,
+) {
+	ctx := r.Context()
+
+	userID := urlParam(r, "id")
+
+	user, err := h.users.GetUser(ctx, &pb.GetUserRequest{Id: userID})
+	if err != nil {
+		writeGRPCError(w, handler, err, "Cannot get User")
+		return
+	}
+
+	req := &pb.FindLinksRequest{UserId: userID, Limit: feedSize, NewestFirst: true}
+	title := "Links of " + user.Username
+	if tag != "" {
+		req.Tags = []string{tag}
+		title = fmt.Sprintf("Links of %s tagged %s", user.Username, tag)
+	}
+
+	links, err := h.links.FindLinks(ctx, req)
+	if err != nil {
+		writeGRPCError(w, handler, err, "Cannot get Links")
+		return
+	}
+
+	base := baseURL(r)
+	self := base + r.URL.EscapedPath()
+	f := feed.Feed{
+		ID:      self,
+		Title:   title,
+		Self:    self,
+		Author:  user.Username,
+		Updated: pbTime(user.CreatedAt),
+		Items:   make([]feed.Item, len(links.Links)),
+	}
+	for i, l := range links.Links {
+		f.Items[i] = feed.Item{
+			ID:          base + "/api/v1/links/" + l.Id,
+			Title:       l.Title,
+			URL:         l.Url,
+			Description: l.Description,
+			Categories:  l.Tags,
+			Published:   pbTime(l.CreatedAt),
+			Updated:     pbTime(l.UpdatedAt),
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := write(&buf, f); err != nil {
+		slog.Error("cannot render feed at "+handler+" handler", slog.Any("err", err))
+		http.Error(w, "500 - Cannot render feed", http.StatusInternalServerError)
+		return
+	}
+
+	// Лента целиком определяется содержимым, поэтому ETag - хеш тела ответа
+	sum := sha256.Sum256(buf.Bytes())
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "max-age=300")
+	if etagMatch(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		slog.Error("cannot write response at "+handler+" handler", slog.Any("err", err))
+	}
+}
+
+// etagMatch проверяет If-None-Match по слабому сравнению, как требует RFC 9110 для GET.
+func etagMatch(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// baseURL восстанавливает внешний адрес шлюза с учетом прокси перед ним.
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+
+	return scheme + "://" + r.Host
+}
+
+// urlParam возвращает параметр пути chi в раскодированном виде.
+func urlParam(r *http.Request, key string) string {
+	raw := chi.URLParam(r, key)
+	if v, err := url.PathUnescape(raw); err == nil {
+		return v
+	}
+
+	return raw
+}
@@ -10,14 +10,37 @@ type serverInterface interface {
 
 var _ serverInterface = (*Handler)(nil)
 
-func New(usersRepository usersClient, linksRepository linksClient) *Handler {
+func New(
+	usersRepository usersClient,
+	linksRepository linksClient,
+	collectionsRepository collectionsClient,
+	sharesRepository sharesClient,
+	shortenerRepository shortenerClient,
+	importsRepository importsClient,
+	userExportsRepository userExportsClient,
+	importMaxSize int64,
+) *Handler {
 	return &Handler{
-		usersHandler: newUsersHandler(usersRepository),
-		linksHandler: newLinksHandler(linksRepository),
+		usersHandler:       newUsersHandler(usersRepository),
+		linksHandler:       newLinksHandler(linksRepository),
+		collectionsHandler: newCollectionsHandler(collectionsRepository),
+		sharesHandler:      newSharesHandler(sharesRepository),
+		shortenerHandler:   newShortenerHandler(shortenerRepository),
+		importsHandler:     newImportsHandler(importsRepository, importMaxSize),
+		feedsHandler:       newFeedsHandler(usersRepository, linksRepository),
+		profileHandler:     newProfileHandler(usersRepository, linksRepository),
+		userExportsHandler: newUserExportsHandler(usersRepository, userExportsRepository),
 	}
 }
 
 type Handler struct {
 	*usersHandler
 	*linksHandler
+	*collectionsHandler
+	*sharesHandler
+	*shortenerHandler
+	*importsHandler
+	*feedsHandler
+	*profileHandler
+	*userExportsHandler
 }
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func (h *linksHandler) GetLinksIdHistory(
+	w http.ResponseWriter, r *http.Request, id string, params apiv1.GetLinksIdHistoryParams,
+) {
+	ctx := r.Context()
+
+	revisions, err := h.client.ListLinkRevisions(ctx, &pb.ListLinkRevisionsRequest{LinkId: id, UserId: params.UserId})
+	if err != nil {
+		writeGRPCError(w, "GetLinksIdHistory", err, "Cannot get Link history")
+		return
+	}
+
+	res := make([]apiv1.LinkRevision, len(revisions.Revisions))
+	for i, rev := range revisions.Revisions {
+		res[i] = revisionFromPB(rev)
+	}
+
+	writeJSON(w, "GetLinksIdHistory", http.StatusOK, res)
+}
+
+func (h *linksHandler) PostLinksIdHistoryRevisionIDRevert(
+	w http.ResponseWriter, r *http.Request, id string, revisionID string,
+	params apiv1.PostLinksIdHistoryRevisionIDRevertParams,
+) {
+	ctx := r.Context()
+
+	link, err := h.client.RevertLink(ctx, &pb.RevertLinkRequest{LinkId: id, RevisionId: revisionID, UserId: params.UserId})
+	if err != nil {
+		writeGRPCError(w, "PostLinksIdHistoryRevisionIDRevert", err, "Cannot revert Link")
+		return
+	}
+
+	writeJSON(w, "PostLinksIdHistoryRevisionIDRevert", http.StatusOK, link)
+}
+
+// revisionFromPB переводит ревизию в ответ API. Значения полей приходят из links-srv в JSON
+// и отдаются как есть: строкой или списком строк.
+func revisionFromPB(rev *pb.LinkRevision) apiv1.LinkRevision {
+	changes := make([]apiv1.FieldChange, len(rev.Changes))
+	for i, c := range rev.Changes {
+		changes[i] = apiv1.FieldChange{Field: c.Field, Old: json.RawMessage(c.Old), New: json.RawMessage(c.New)}
+	}
+
+	return apiv1.LinkRevision{
+		Id:        rev.Id,
+		LinkId:    rev.LinkId,
+		Actor:     optional(rev.Actor),
+		Source:    apiv1.LinkRevisionSource(rev.Source),
+		Changes:   changes,
+		CreatedAt: rev.CreatedAt,
+	}
+}
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+const (
+	// importMemory - часть формы, которая держится в памяти, остальное ParseMultipartForm пишет во временные файлы.
+	importMemory = 8 << 20
+	// importChunkSize - размер части файла в одном сообщении gRPC-стрима.
+	importChunkSize = 64 << 10
+	// importTimeout ограничивает передачу файла в links-srv: срок потоковым вызовам задает обработчик.
+	importTimeout = time.Minute
+	// importFormOverhead - запас на остальные поля формы и разделители multipart сверх размера файла.
+	importFormOverhead = 1 << 20
+)
+
+// newImportsHandler создает обработчик импорта. Тело запроса больше maxSize байт (с запасом на форму) не читается.
+func newImportsHandler(importsClient importsClient, maxSize int64) *importsHandler {
+	return &importsHandler{client: importsClient, maxSize: maxSize}
+}
+
+type importsHandler struct {
+	client  importsClient
+	maxSize int64
+}
+
+func (h *importsHandler) PostImport(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), importTimeout)
+	defer cancel()
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxSize+importFormOverhead)
+	if err := r.ParseMultipartForm(importMemory); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			slog.Info("import file is too large at PostImport handler", slog.Any("err", err))
+			http.Error(w, "413 - Import file is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		slog.Error("cannot parse multipart form at PostImport handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		slog.Error("cannot get file at PostImport handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	stream, err := h.client.ImportLinks(ctx)
+	if err != nil {
+		writeGRPCError(w, "PostImport", err, "Cannot import Links")
+		return
+	}
+
+	req := &pb.ImportLinksRequest{UserId: r.FormValue("user_id"), Format: r.FormValue("format")}
+	buf := make([]byte, importChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			req.Chunk = buf[:n]
+			// Сервер может прервать стрим раньше, например из-за размера файла: ошибку вернет CloseAndRecv
+			if sendErr := stream.Send(req); sendErr != nil {
+				break
+			}
+			req = &pb.ImportLinksRequest{}
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			slog.Error("cannot read file at PostImport handler", slog.Any("err", err))
+			http.Error(w, "500 - Cannot read file", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	job, err := stream.CloseAndRecv()
+	if err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			http.Error(w, "413 - "+status.Convert(err).Message(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		writeGRPCError(w, "PostImport", err, "Cannot import Links")
+		return
+	}
+
+	writeJSON(w, "PostImport", http.StatusAccepted, job)
+}
+
+func (h *importsHandler) GetImportId(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.GetImportIdParams,
+) {
+	ctx := r.Context()
+
+	job, err := h.client.GetImportJob(ctx, &pb.GetImportJobRequest{Id: id})
+	if err != nil {
+		writeGRPCError(w, "GetImportId", err, "Cannot get import job")
+		return
+	}
+
+	writeJSON(w, "GetImportId", http.StatusOK, job)
+}
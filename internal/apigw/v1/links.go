@@ -1,12 +1,14 @@
 package v1
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
 	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
 )
@@ -19,14 +21,20 @@ type linksHandler struct {
 	client linksClient
 }
 
-func (h *linksHandler) GetLinks(w http.ResponseWriter, r *http.Request) {
-	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-	defer cancel()
-
-	links, err := h.client.ListLinks(ctx, &pb.Empty{})
+func (h *linksHandler) GetLinks(w http.ResponseWriter, r *http.Request, params apiv1.GetLinksParams) {
+	ctx := r.Context()
+
+	var (
+		links *pb.ListLinkResponse
+		err   error
+	)
+	if req, ok := findLinksRequest(params); ok {
+		links, err = h.client.FindLinks(ctx, req)
+	} else {
+		links, err = h.client.ListLinks(ctx, &pb.Empty{})
+	}
 	if err != nil {
-		slog.Error("cannot get Links at GetLinks handler", slog.Any("err", err))
-		http.Error(w, "500 - Cannot get Links", http.StatusInternalServerError)
+		writeGRPCError(w, "GetLinks", err, "Cannot get Links")
 		return
 	}
 
@@ -44,9 +52,42 @@ func (h *linksHandler) GetLinks(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// findLinksRequest собирает фильтр для FindLinks, если в запросе передан хотя бы один параметр.
+func findLinksRequest(params apiv1.GetLinksParams) (*pb.FindLinksRequest, bool) {
+	var (
+		req pb.FindLinksRequest
+		ok  bool
+	)
+
+	// Автора запроса из X-User-ID links-srv получает из метаданных вызова: владельцу из user_id
+	// его ссылки видны полностью, остальным только публичные
+	if params.UserId != nil {
+		req.UserId, ok = *params.UserId, true
+	}
+	if params.Tags != nil {
+		req.Tags, ok = *params.Tags, true
+	}
+	if params.TagsMode != nil {
+		req.MatchAllTags, ok = *params.TagsMode == apiv1.All, true
+	}
+	if params.ExcludeTags != nil {
+		req.ExcludeTags, ok = *params.ExcludeTags, true
+	}
+	if params.Q != nil {
+		req.Query, ok = *params.Q, true
+	}
+	if params.Limit != nil {
+		req.Limit, ok = *params.Limit, true
+	}
+	if params.Offset != nil {
+		req.Offset, ok = *params.Offset, true
+	}
+
+	return &req, ok
+}
+
 func (h *linksHandler) PostLinks(w http.ResponseWriter, r *http.Request) {
-	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-	defer cancel()
+	ctx := r.Context()
 
 	var linkReq apiv1.LinkCreate
 	err := json.NewDecoder(r.Body).Decode(&linkReq)
@@ -56,65 +97,60 @@ func (h *linksHandler) PostLinks(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if linkReq.Id != "" || linkReq.Url == "" {
-		slog.Error("invalid body params at PostLinks handler", slog.Any("err", err))
-		http.Error(w, "bad request body", http.StatusBadRequest)
+	// Формат полей уже проверен по спецификации, см. routes
+	if linkReq.Id != "" {
+		slog.Info("link ID is set at PostLinks handler")
+		http.Error(w, "400 - Link ID is generated by the server", http.StatusBadRequest)
 		return
 	}
 
 	req := &pb.CreateLinkRequest{
-		Id:     linkReq.Id,
-		Images: linkReq.Images,
-		Tags:   linkReq.Tags,
-		Title:  linkReq.Title,
-		UserId: linkReq.UserId,
-		Url:    linkReq.Url,
+		Id:             linkReq.Id,
+		Images:         linkReq.Images,
+		Tags:           linkReq.Tags,
+		Title:          linkReq.Title,
+		Description:    value(linkReq.Description),
+		UserId:         linkReq.UserId,
+		Url:            linkReq.Url,
+		Visibility:     string(value(linkReq.Visibility)),
+		ReturnExisting: value(linkReq.ReturnExisting),
 	}
 
-	_, err = h.client.CreateLink(ctx, req)
+	res, err := h.client.CreateLink(ctx, req)
 	if err != nil {
-		slog.Error("cannot create Link at PostLinks handler", slog.Any("err", err))
-		http.Error(w, "500 - Cannot create Link", http.StatusInternalServerError)
+		writeGRPCError(w, "PostLinks", err, "Cannot create Link")
 		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
-}
-
-func (h *linksHandler) DeleteLinksId(w http.ResponseWriter, r *http.Request, id string) {
-	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-	defer cancel()
+	code := http.StatusCreated
+	if res.Existing {
+		code = http.StatusOK
+	}
 
-	req := &pb.GetLinkRequest{Id: r.PathValue("id")}
+	writeJSON(w, "PostLinks", code, res.Link)
+}
 
-	_, err := h.client.GetLink(ctx, req)
-	if err != nil {
-		slog.Info("cannot get Link at DeleteLinksId handler", slog.Any("err", err))
-		http.Error(w, fmt.Sprintf("404 - Link with ID %s is not found", r.PathValue("id")), http.StatusNotFound)
-		return
-	}
+func (h *linksHandler) DeleteLinksId(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.DeleteLinksIdParams,
+) {
+	ctx := r.Context()
 
-	delReq := &pb.DeleteLinkRequest{Id: r.PathValue("id")}
-	_, err = h.client.DeleteLink(ctx, delReq)
+	// Владельца из X-User-ID проверяет links-srv, чужая ссылка для него не найдена
+	_, err := h.client.DeleteLink(ctx, &pb.DeleteLinkRequest{Id: id})
 	if err != nil {
-		slog.Error("cannot delete Link at DeleteLinksId handler", slog.Any("err", err))
-		http.Error(w, "500 - Cannot delete Link", http.StatusInternalServerError)
+		writeGRPCError(w, "DeleteLinksId", err, "Cannot delete Link")
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (h *linksHandler) GetLinksId(w http.ResponseWriter, r *http.Request, id string) {
-	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-	defer cancel()
+func (h *linksHandler) GetLinksId(w http.ResponseWriter, r *http.Request, id string, _ apiv1.GetLinksIdParams) {
+	ctx := r.Context()
 
-	req := &pb.GetLinkRequest{Id: r.PathValue("id")}
-
-	link, err := h.client.GetLink(ctx, req)
+	link, err := h.client.GetLink(ctx, &pb.GetLinkRequest{Id: id})
 	if err != nil {
-		slog.Info("cannot get Link at GetLinksId handler", slog.Any("err", err))
-		http.Error(w, fmt.Sprintf("404 - Link with ID %s is not found", r.PathValue("id")), http.StatusNotFound)
+		writeGRPCError(w, "GetLinksId", err, "Cannot get Link")
 		return
 	}
 
@@ -122,6 +158,7 @@ func (h *linksHandler) GetLinksId(w http.ResponseWriter, r *http.Request, id str
 	if err != nil {
 		slog.Error("cannot marshal Link to JSON at GetLinksId handler", slog.Any("err", err))
 		http.Error(w, "500 - Cannot marshal Link", http.StatusInternalServerError)
+		return
 	}
 
 	w.Header().Add("Content-Type", "application/json")
@@ -132,8 +169,7 @@ func (h *linksHandler) GetLinksId(w http.ResponseWriter, r *http.Request, id str
 }
 
 func (h *linksHandler) PutLinksId(w http.ResponseWriter, r *http.Request, id string) {
-	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-	defer cancel()
+	ctx := r.Context()
 
 	var linkReq apiv1.LinkCreate
 	err := json.NewDecoder(r.Body).Decode(&linkReq)
@@ -143,41 +179,49 @@ func (h *linksHandler) PutLinksId(w http.ResponseWriter, r *http.Request, id str
 		return
 	}
 
+	if linkReq.Id != "" && linkReq.Id != id {
+		slog.Info("link ID in body differs from path at PutLinksId handler")
+		http.Error(w, "400 - Link ID in body differs from path", http.StatusBadRequest)
+		return
+	}
+
 	updReq := &pb.UpdateLinkRequest{
-		Id:     linkReq.Id,
-		Title:  linkReq.Title,
-		Url:    linkReq.Url,
-		Images: linkReq.Images,
-		Tags:   linkReq.Tags,
-		UserId: linkReq.UserId,
+		Id:          id,
+		Title:       linkReq.Title,
+		Description: value(linkReq.Description),
+		Visibility:  string(value(linkReq.Visibility)),
+		Url:         linkReq.Url,
+		Images:      linkReq.Images,
+		Tags:        linkReq.Tags,
+		UserId:      linkReq.UserId,
 	}
 
 	_, err = h.client.UpdateLink(ctx, updReq)
 	if err != nil {
-		slog.Error("cannot updaet Link at PutLinksId handler", slog.Any("err", err))
-		http.Error(w, "500 - Cannot update Link", http.StatusInternalServerError)
+		writeGRPCError(w, "PutLinksId", err, "Cannot update Link")
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (h *linksHandler) GetLinksUserUserID(w http.ResponseWriter, r *http.Request, userID string) {
-	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-	defer cancel()
+func (h *linksHandler) GetLinksUserUserID(
+	w http.ResponseWriter, r *http.Request, userID string, _ apiv1.GetLinksUserUserIDParams,
+) {
+	ctx := r.Context()
 
-	req := &pb.GetLinksByUserId{UserId: r.PathValue("userID")}
+	// Приватные и скрытые ссылки видны, только если пользователь из X-User-ID запрашивает свой список
+	req := &pb.GetLinksByUserId{UserId: userID}
 
 	links, err := h.client.GetLinkByUserID(ctx, req)
 	if err != nil {
-		slog.Info("cannot get Links by UserID at GetLinksUserUserID handler", slog.Any("err", err))
-		http.Error(w, err.Error(), http.StatusNotFound)
+		writeGRPCError(w, "GetLinksUserUserID", err, "Cannot get Links")
 		return
 	}
 
 	if len(links.Links) == 0 {
-		slog.Info("no Links found by UserID at GetLinksUserUserID handler", slog.Any("err", err))
-		http.Error(w, fmt.Sprintf("404 - Links for user with ID %s are not found", r.PathValue("id")), http.StatusNotFound)
+		slog.Info("no Links found by UserID at GetLinksUserUserID handler", slog.String("user_id", userID))
+		http.Error(w, fmt.Sprintf("404 - Links for user with ID %s are not found", userID), http.StatusNotFound)
 		return
 	}
 
@@ -194,3 +238,34 @@ func (h *linksHandler) GetLinksUserUserID(w http.ResponseWriter, r *http.Request
 		slog.Error("cannot write response at GetLinksUserUserID handler", slog.Any("err", err))
 	}
 }
+
+func (h *linksHandler) GetLinksIdContent(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.GetLinksIdContentParams,
+) {
+	ctx := r.Context()
+
+	content, err := h.client.GetLinkContent(ctx, &pb.GetLinkRequest{Id: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound || status.Code(err) == codes.InvalidArgument {
+			slog.Info("cannot get Link content at GetLinksIdContent handler", slog.Any("err", err))
+			http.Error(w, fmt.Sprintf("404 - Content of Link with ID %s is not found", id), http.StatusNotFound)
+			return
+		}
+		slog.Error("cannot get Link content at GetLinksIdContent handler", slog.Any("err", err))
+		http.Error(w, "500 - Cannot get Link content", http.StatusInternalServerError)
+		return
+	}
+
+	b, err := json.Marshal(content)
+	if err != nil {
+		slog.Error("cannot marshal Link content to JSON at GetLinksIdContent handler", slog.Any("err", err))
+		http.Error(w, "500 - Cannot marshal Link content", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	_, err = w.Write(b)
+	if err != nil {
+		slog.Error("cannot write response at GetLinksIdContent handler", slog.Any("err", err))
+	}
+}
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func (h *linksHandler) GetLinksIdNotes(
+	w http.ResponseWriter, r *http.Request, id string, params apiv1.GetLinksIdNotesParams,
+) {
+	ctx := r.Context()
+
+	notes, err := h.client.ListNotes(ctx, &pb.ListNotesRequest{LinkId: id, UserId: params.UserId})
+	if err != nil {
+		writeGRPCError(w, "GetLinksIdNotes", err, "Cannot get Notes")
+		return
+	}
+
+	res := notes.Notes
+	if res == nil {
+		res = []*pb.Note{}
+	}
+
+	writeJSON(w, "GetLinksIdNotes", http.StatusOK, res)
+}
+
+func (h *linksHandler) PostLinksIdNotes(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+
+	var req apiv1.NoteCreate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("cannot decode request body at PostLinksIdNotes handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	note, err := h.client.CreateNote(ctx, &pb.CreateNoteRequest{
+		LinkId: id,
+		UserId: req.UserId,
+		Body:   value(req.Body),
+		Quote:  value(req.Quote),
+		Start:  req.Start,
+		End:    req.End,
+	})
+	if err != nil {
+		writeGRPCError(w, "PostLinksIdNotes", err, "Cannot create Note")
+		return
+	}
+
+	writeJSON(w, "PostLinksIdNotes", http.StatusCreated, note)
+}
+
+func (h *linksHandler) PutLinksIdNotesNoteID(w http.ResponseWriter, r *http.Request, id string, noteID string) {
+	ctx := r.Context()
+
+	var req apiv1.NoteUpdate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("cannot decode request body at PutLinksIdNotesNoteID handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	note, err := h.client.UpdateNote(ctx, &pb.UpdateNoteRequest{
+		Id:     noteID,
+		LinkId: id,
+		UserId: req.UserId,
+		Body:   req.Body,
+	})
+	if err != nil {
+		writeGRPCError(w, "PutLinksIdNotesNoteID", err, "Cannot update Note")
+		return
+	}
+
+	writeJSON(w, "PutLinksIdNotesNoteID", http.StatusOK, note)
+}
+
+func (h *linksHandler) DeleteLinksIdNotesNoteID(
+	w http.ResponseWriter, r *http.Request, id string, noteID string, params apiv1.DeleteLinksIdNotesNoteIDParams,
+) {
+	ctx := r.Context()
+
+	_, err := h.client.DeleteNote(ctx, &pb.DeleteNoteRequest{Id: noteID, LinkId: id, UserId: params.UserId})
+	if err != nil {
+		writeGRPCError(w, "DeleteLinksIdNotesNoteID", err, "Cannot delete Note")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
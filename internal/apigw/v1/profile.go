@@ -0,0 +1,164 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+// profileTimeout - общий срок сборки профиля.
+const profileTimeout = 3 * time.Second
+
+func newProfileHandler(usersClient usersClient, linksClient linksClient) *profileHandler {
+	return &profileHandler{users: usersClient, links: linksClient}
+}
+
+// profileHandler собирает профиль пользователя из users-srv и links-srv, чтобы главный экран
+// получал все данные за один запрос.
+type profileHandler struct {
+	users usersClient
+	links linksClient
+}
+
+func (h *profileHandler) GetUsersIdProfile(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.GetUsersIdProfileParams,
+) {
+	// Срок общий для всех разделов: профиль отдается не позже, чем за profileTimeout, даже если у методов сроки больше
+	ctx, cancel := context.WithTimeout(r.Context(), profileTimeout)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		user     *pb.User
+		links    *pb.ListLinkResponse
+		tags     *pb.ListTagsResponse
+		userErr  error
+		linksErr error
+		tagsErr  error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		user, userErr = h.users.GetUser(ctx, &pb.GetUserRequest{Id: id})
+	}()
+	go func() {
+		defer wg.Done()
+		// Приватные и скрытые ссылки и их теги видны, только если пользователь из X-User-ID запрашивает свой профиль
+		links, linksErr = h.links.GetLinkByUserID(ctx, &pb.GetLinksByUserId{UserId: id})
+	}()
+	go func() {
+		defer wg.Done()
+		tags, tagsErr = h.links.ListTags(ctx, &pb.GetLinksByUserId{UserId: id})
+	}()
+	wg.Wait()
+
+	// Без пользователя профиля нет: об отсутствующем пользователе и неверном ID сообщается сразу,
+	// а если не ответил ни один раздел, отдавать нечего
+	switch code := status.Code(userErr); {
+	case code == codes.NotFound, code == codes.InvalidArgument:
+		writeGRPCError(w, "GetUsersIdProfile", userErr, "Cannot get User")
+		return
+	case userErr != nil && linksErr != nil && tagsErr != nil:
+		writeGRPCError(w, "GetUsersIdProfile", userErr, "Cannot get Profile")
+		return
+	}
+
+	res := apiv1.UserProfile{Errors: []apiv1.ProfileSectionError{}}
+	addError := func(section apiv1.ProfileSectionErrorSection, err error) {
+		slog.Warn(
+			"cannot get profile section at GetUsersIdProfile handler",
+			slog.String("section", string(section)),
+			slog.Any("err", err),
+		)
+		res.Errors = append(res.Errors, sectionError(section, err))
+	}
+
+	if userErr != nil {
+		addError(apiv1.ProfileSectionErrorSectionUser, userErr)
+	} else {
+		res.User = &apiv1.ProfileUser{
+			Id:        user.Id,
+			Username:  user.Username,
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+		}
+	}
+
+	if linksErr != nil {
+		addError(apiv1.ProfileSectionErrorSectionLinks, linksErr)
+	} else {
+		list := make([]apiv1.Link, 0, len(links.Links))
+		for _, l := range links.Links {
+			list = append(list, linkFromPB(l))
+		}
+		res.Links = &list
+	}
+
+	if tagsErr != nil {
+		addError(apiv1.ProfileSectionErrorSectionTags, tagsErr)
+	} else {
+		list := make([]apiv1.TagCount, 0, len(tags.Tags))
+		for _, t := range tags.Tags {
+			list = append(list, apiv1.TagCount{Tag: t.Tag, Count: t.Count})
+		}
+		res.Tags = &list
+	}
+
+	res.Partial = len(res.Errors) > 0
+
+	writeJSON(w, "GetUsersIdProfile", http.StatusOK, res)
+}
+
+// sectionError описывает недоступный раздел профиля. Сообщения внутренних ошибок наружу не отдаются.
+func sectionError(section apiv1.ProfileSectionErrorSection, err error) apiv1.ProfileSectionError {
+	st := status.Convert(err)
+	msg := st.Message()
+	if httpStatus(err) == http.StatusInternalServerError {
+		msg = "internal error"
+	}
+
+	return apiv1.ProfileSectionError{Section: section, Code: st.Code().String(), Message: msg}
+}
+
+func linkFromPB(l *pb.Link) apiv1.Link {
+	res := apiv1.Link{
+		Id:          l.Id,
+		Title:       l.Title,
+		Url:         l.Url,
+		UserId:      l.UserId,
+		Images:      nonNilStrings(l.Images),
+		Tags:        nonNilStrings(l.Tags),
+		CreatedAt:   l.CreatedAt,
+		UpdatedAt:   l.UpdatedAt,
+		Description: optional(l.Description),
+		ReadAt:      optional(l.ReadAt),
+		DeletedAt:   optional(l.DeletedAt),
+		Favorite:    optional(l.Favorite),
+		Archived:    optional(l.Archived),
+	}
+	if len(l.AutoTags) > 0 {
+		res.AutoTags = &l.AutoTags
+	}
+	if l.Visibility != "" {
+		v := apiv1.Visibility(l.Visibility)
+		res.Visibility = &v
+	}
+
+	return res
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+
+	return s
+}
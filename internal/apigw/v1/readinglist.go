@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+// readingListLimit - размер страницы списков для чтения, если limit не передан.
+const readingListLimit = 50
+
+func (h *linksHandler) GetLinksUnread(w http.ResponseWriter, r *http.Request, params apiv1.GetLinksUnreadParams) {
+	read, archived := false, false
+	h.readingList(w, r, "GetLinksUnread", &pb.FindLinksRequest{
+		UserId:   params.UserId,
+		Read:     &read,
+		Archived: &archived,
+		Limit:    value(params.Limit),
+		Offset:   value(params.Offset),
+	})
+}
+
+func (h *linksHandler) GetLinksFavorites(w http.ResponseWriter, r *http.Request, params apiv1.GetLinksFavoritesParams) {
+	favorite := true
+	h.readingList(w, r, "GetLinksFavorites", &pb.FindLinksRequest{
+		UserId:   params.UserId,
+		Favorite: &favorite,
+		Limit:    value(params.Limit),
+		Offset:   value(params.Offset),
+	})
+}
+
+func (h *linksHandler) GetLinksArchive(w http.ResponseWriter, r *http.Request, params apiv1.GetLinksArchiveParams) {
+	archived := true
+	h.readingList(w, r, "GetLinksArchive", &pb.FindLinksRequest{
+		UserId:   params.UserId,
+		Archived: &archived,
+		Limit:    value(params.Limit),
+		Offset:   value(params.Offset),
+	})
+}
+
+// readingList отдает ссылки пользователя, подходящие под фильтр состояния, новые первыми.
+// Приватные и скрытые ссылки видны, только если пользователь из X-User-ID запрашивает свой список.
+func (h *linksHandler) readingList(w http.ResponseWriter, r *http.Request, handler string, req *pb.FindLinksRequest) {
+	ctx := r.Context()
+
+	if req.UserId == "" {
+		http.Error(w, "user_id is required", http.StatusBadRequest)
+		return
+	}
+
+	req.NewestFirst = true
+	if req.Limit <= 0 {
+		req.Limit = readingListLimit
+	}
+
+	links, err := h.client.FindLinks(ctx, req)
+	if err != nil {
+		writeGRPCError(w, handler, err, "Cannot get Links")
+		return
+	}
+
+	res := links.Links
+	if res == nil {
+		res = []*pb.Link{}
+	}
+
+	writeJSON(w, handler, http.StatusOK, res)
+}
+
+func (h *linksHandler) PostLinksState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req apiv1.LinksStateUpdate
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("cannot decode request body at PostLinksState handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := h.client.UpdateLinksState(ctx, &pb.UpdateLinksStateRequest{
+		UserId:   req.UserId,
+		Ids:      req.Ids,
+		Read:     req.Read,
+		Favorite: req.Favorite,
+		Archived: req.Archived,
+	})
+	if err != nil {
+		writeGRPCError(w, "PostLinksState", err, "Cannot update Links state")
+		return
+	}
+
+	writeJSON(w, "PostLinksState", http.StatusOK, apiv1.LinksStateResult{Matched: res.Matched})
+}
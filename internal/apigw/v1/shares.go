@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func newSharesHandler(sharesClient sharesClient) *sharesHandler {
+	return &sharesHandler{client: sharesClient}
+}
+
+type sharesHandler struct {
+	client sharesClient
+}
+
+// GetShares и DeleteSharesId доступны только владельцу: пользователя из X-User-ID проверяет links-srv,
+// заголовок доходит до него в метаданных запроса.
+func (h *sharesHandler) GetShares(w http.ResponseWriter, r *http.Request, params apiv1.GetSharesParams) {
+	ctx := r.Context()
+
+	shares, err := h.client.ListShares(ctx, &pb.ListSharesRequest{UserId: params.UserId})
+	if err != nil {
+		writeGRPCError(w, "GetShares", err, "Cannot get Shares")
+		return
+	}
+
+	res := shares.Shares
+	if res == nil {
+		res = []*pb.Share{}
+	}
+
+	writeJSON(w, "GetShares", http.StatusOK, res)
+}
+
+func (h *sharesHandler) PostShares(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var shareReq apiv1.ShareCreate
+	if err := json.NewDecoder(r.Body).Decode(&shareReq); err != nil {
+		slog.Error("cannot decode request body at PostShares handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	share, err := h.client.CreateShare(ctx, &pb.CreateShareRequest{
+		UserId:       shareReq.UserId,
+		CollectionId: value(shareReq.CollectionId),
+		LinkId:       value(shareReq.LinkId),
+		Password:     value(shareReq.Password),
+		TtlSeconds:   value(shareReq.TtlSeconds),
+	})
+	if err != nil {
+		writeGRPCError(w, "PostShares", err, "Cannot create Share")
+		return
+	}
+
+	writeJSON(w, "PostShares", http.StatusCreated, share)
+}
+
+func (h *sharesHandler) DeleteSharesId(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.DeleteSharesIdParams,
+) {
+	ctx := r.Context()
+
+	share, err := h.client.RevokeShare(ctx, &pb.RevokeShareRequest{Id: id})
+	if err != nil {
+		writeGRPCError(w, "DeleteSharesId", err, "Cannot revoke Share")
+		return
+	}
+
+	writeJSON(w, "DeleteSharesId", http.StatusOK, share)
+}
+
+// GetSharedToken доступен без учетной записи: владелец передает токен тем, кому хочет показать содержимое.
+func (h *sharesHandler) GetSharedToken(
+	w http.ResponseWriter, r *http.Request, token string, params apiv1.GetSharedTokenParams,
+) {
+	ctx := r.Context()
+
+	content, err := h.client.OpenShare(ctx, &pb.OpenShareRequest{Token: token, Password: value(params.XSharePassword)})
+	if err != nil {
+		writeGRPCError(w, "GetSharedToken", err, "Cannot open Share")
+		return
+	}
+
+	if content.Links == nil {
+		content.Links = []*pb.SharedLink{}
+	}
+
+	// Счетчик просмотров должен расти на каждое открытие, поэтому ответ не кэшируем
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	writeJSON(w, "GetSharedToken", http.StatusOK, content)
+}
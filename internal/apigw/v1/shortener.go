@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
+)
+
+func newShortenerHandler(shortenerClient shortenerClient) *shortenerHandler {
+	return &shortenerHandler{client: shortenerClient}
+}
+
+type shortenerHandler struct {
+	client shortenerClient
+}
+
+func (h *shortenerHandler) PutLinksIdSlug(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.PutLinksIdSlugParams,
+) {
+	ctx := r.Context()
+
+	var slugReq apiv1.LinkSlugCreate
+	if err := json.NewDecoder(r.Body).Decode(&slugReq); err != nil {
+		slog.Error("cannot decode request body at PutLinksIdSlug handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	slug, err := h.client.SetLinkSlug(ctx, &pb.SetLinkSlugRequest{LinkId: id, Slug: value(slugReq.Slug)})
+	if err != nil {
+		writeGRPCError(w, "PutLinksIdSlug", err, "Cannot set Link slug")
+		return
+	}
+
+	writeJSON(w, "PutLinksIdSlug", http.StatusOK, slug)
+}
+
+func (h *shortenerHandler) GetLinksIdStats(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.GetLinksIdStatsParams,
+) {
+	ctx := r.Context()
+
+	stats, err := h.client.GetLinkStats(ctx, &pb.GetLinkRequest{Id: id})
+	if err != nil {
+		writeGRPCError(w, "GetLinksIdStats", err, "Cannot get Link stats")
+		return
+	}
+
+	res := apiv1.LinkStats{
+		LinkId:      stats.LinkId,
+		Total:       stats.Total,
+		ByDay:       bucketsFromPB(stats.ByDay),
+		ByReferrer:  bucketsFromPB(stats.ByReferrer),
+		ByUserAgent: bucketsFromPB(stats.ByUserAgent),
+		ByCountry:   bucketsFromPB(stats.ByCountry),
+	}
+
+	writeJSON(w, "GetLinksIdStats", http.StatusOK, res)
+}
+
+// RedirectSlug обслуживает короткие ссылки /s/{slug} вне /api/v1, поэтому не входит в спецификацию.
+func (h *shortenerHandler) RedirectSlug(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	res, err := h.client.ResolveSlug(ctx, &pb.ResolveSlugRequest{
+		Slug:      chi.URLParam(r, "slug"),
+		Referrer:  r.Referer(),
+		UserAgent: r.UserAgent(),
+		RemoteIp:  reqmeta.FromOutgoing(ctx).ClientIP, // адрес с учетом доверенных балансировщиков
+	})
+	if err != nil {
+		writeGRPCError(w, "RedirectSlug", err, "Cannot resolve slug")
+		return
+	}
+
+	// Каждый переход должен дойти до сервера, иначе клики не будут учтены
+	w.Header().Set("Cache-Control", "private, no-store")
+	http.Redirect(w, r, res.Url, http.StatusFound)
+}
+
+func bucketsFromPB(buckets []*pb.StatBucket) []apiv1.StatBucket {
+	res := make([]apiv1.StatBucket, len(buckets))
+	for i, b := range buckets {
+		res[i] = apiv1.StatBucket{Key: b.Key, Count: b.Count}
+	}
+
+	return res
+}
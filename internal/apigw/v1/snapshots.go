@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func (h *linksHandler) GetLinksIdSnapshots(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.GetLinksIdSnapshotsParams,
+) {
+	ctx := r.Context()
+
+	snapshots, err := h.client.ListSnapshots(ctx, &pb.GetLinkRequest{Id: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound || status.Code(err) == codes.InvalidArgument {
+			slog.Info("cannot get Snapshots at GetLinksIdSnapshots handler", slog.Any("err", err))
+			http.Error(w, fmt.Sprintf("404 - Link with ID %s is not found", id), http.StatusNotFound)
+			return
+		}
+		slog.Error("cannot get Snapshots at GetLinksIdSnapshots handler", slog.Any("err", err))
+		http.Error(w, "500 - Cannot get Snapshots", http.StatusInternalServerError)
+		return
+	}
+
+	res := snapshots.Snapshots
+	if res == nil {
+		res = []*pb.Snapshot{}
+	}
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		slog.Error("cannot marshal Snapshots to JSON at GetLinksIdSnapshots handler", slog.Any("err", err))
+		http.Error(w, "500 - Cannot marshal Snapshots", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	_, err = w.Write(b)
+	if err != nil {
+		slog.Error("cannot write response at GetLinksIdSnapshots handler", slog.Any("err", err))
+	}
+}
+
+func (h *linksHandler) GetLinksIdSnapshotsSha(
+	w http.ResponseWriter, r *http.Request, id string, sha string, _ apiv1.GetLinksIdSnapshotsShaParams,
+) {
+	ctx := r.Context()
+
+	snapshot, err := h.client.GetSnapshot(ctx, &pb.GetSnapshotRequest{LinkId: id, Sha256: sha})
+	if err != nil {
+		if status.Code(err) == codes.NotFound || status.Code(err) == codes.InvalidArgument {
+			slog.Info("cannot get Snapshot at GetLinksIdSnapshotsSha handler", slog.Any("err", err))
+			http.Error(w, fmt.Sprintf("404 - Snapshot %s of Link with ID %s is not found", sha, id), http.StatusNotFound)
+			return
+		}
+		slog.Error("cannot get Snapshot at GetLinksIdSnapshotsSha handler", slog.Any("err", err))
+		http.Error(w, "500 - Cannot get Snapshot", http.StatusInternalServerError)
+		return
+	}
+
+	// Сохраненная страница чужая, поэтому запрещаем ей выполнять скрипты и обращаться к нашему origin
+	w.Header().Add("Content-Security-Policy", "sandbox")
+	w.Header().Add("Content-Type", snapshot.Snapshot.ContentType)
+	w.Header().Add("X-Content-Type-Options", "nosniff")
+	w.Header().Add("ETag", `"`+snapshot.Snapshot.Sha256+`"`)
+	_, err = w.Write(snapshot.Data)
+	if err != nil {
+		slog.Error("cannot write response at GetLinksIdSnapshotsSha handler", slog.Any("err", err))
+	}
+}
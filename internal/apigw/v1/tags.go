@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func (h *linksHandler) GetUsersIdTags(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.GetUsersIdTagsParams,
+) {
+	ctx := r.Context()
+
+	tags, err := h.client.ListTags(ctx, &pb.GetLinksByUserId{UserId: id})
+	if err != nil {
+		writeGRPCError(w, "GetUsersIdTags", err, "Cannot process Tags")
+		return
+	}
+
+	res := tags.Tags
+	if res == nil {
+		res = []*pb.TagCount{}
+	}
+
+	writeJSON(w, "GetUsersIdTags", http.StatusOK, res)
+}
+
+func (h *linksHandler) PostUsersIdTagsMerge(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+
+	var mergeReq apiv1.TagMerge
+	if err := json.NewDecoder(r.Body).Decode(&mergeReq); err != nil {
+		slog.Error("cannot decode request body at PostUsersIdTagsMerge handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := h.client.MergeTags(ctx, &pb.MergeTagsRequest{UserId: id, From: mergeReq.From, To: mergeReq.To})
+	if err != nil {
+		writeGRPCError(w, "PostUsersIdTagsMerge", err, "Cannot process Tags")
+		return
+	}
+
+	writeJSON(w, "PostUsersIdTagsMerge", http.StatusOK, apiv1.TagsUpdateResult{Modified: res.Modified})
+}
+
+func (h *linksHandler) PutUsersIdTagsTag(w http.ResponseWriter, r *http.Request, id string, tag string) {
+	ctx := r.Context()
+
+	var renameReq apiv1.TagRename
+	if err := json.NewDecoder(r.Body).Decode(&renameReq); err != nil {
+		slog.Error("cannot decode request body at PutUsersIdTagsTag handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := h.client.RenameTag(ctx, &pb.RenameTagRequest{UserId: id, From: tag, To: renameReq.Name})
+	if err != nil {
+		writeGRPCError(w, "PutUsersIdTagsTag", err, "Cannot process Tags")
+		return
+	}
+
+	writeJSON(w, "PutUsersIdTagsTag", http.StatusOK, apiv1.TagsUpdateResult{Modified: res.Modified})
+}
+
+func (h *linksHandler) DeleteUsersIdTagsTag(w http.ResponseWriter, r *http.Request, id string, tag string) {
+	ctx := r.Context()
+
+	res, err := h.client.DeleteTag(ctx, &pb.DeleteTagRequest{UserId: id, Tag: tag})
+	if err != nil {
+		writeGRPCError(w, "DeleteUsersIdTagsTag", err, "Cannot process Tags")
+		return
+	}
+
+	writeJSON(w, "DeleteUsersIdTagsTag", http.StatusOK, apiv1.TagsUpdateResult{Modified: res.Modified})
+}
@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func (h *linksHandler) GetTrashLinks(w http.ResponseWriter, r *http.Request, params apiv1.GetTrashLinksParams) {
+	ctx := r.Context()
+
+	links, err := h.client.ListDeletedLinks(ctx, &pb.GetLinksByUserId{UserId: params.UserId})
+	if err != nil {
+		writeGRPCError(w, "GetTrashLinks", err, "Cannot get deleted Links")
+		return
+	}
+
+	res := links.Links
+	if res == nil {
+		res = []*pb.Link{}
+	}
+
+	writeJSON(w, "GetTrashLinks", http.StatusOK, res)
+}
+
+func (h *linksHandler) PostTrashLinksIdRestore(
+	w http.ResponseWriter, r *http.Request, id string, params apiv1.PostTrashLinksIdRestoreParams,
+) {
+	ctx := r.Context()
+
+	link, err := h.client.RestoreLink(ctx, &pb.RestoreLinkRequest{Id: id, UserId: params.UserId})
+	if err != nil {
+		writeGRPCError(w, "PostTrashLinksIdRestore", err, "Cannot restore Link")
+		return
+	}
+
+	writeJSON(w, "PostTrashLinksIdRestore", http.StatusOK, link)
+}
+
+func (h *usersHandler) GetTrashUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	users, err := h.client.ListDeletedUsers(ctx, &pb.Empty{})
+	if err != nil {
+		writeGRPCError(w, "GetTrashUsers", err, "Cannot get deleted Users")
+		return
+	}
+
+	res := users.Users
+	if res == nil {
+		res = []*pb.User{}
+	}
+
+	writeJSON(w, "GetTrashUsers", http.StatusOK, res)
+}
+
+func (h *usersHandler) PostTrashUsersIdRestore(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+
+	user, err := h.client.RestoreUser(ctx, &pb.RestoreUserRequest{Id: id})
+	if err != nil {
+		writeGRPCError(w, "PostTrashUsersIdRestore", err, "Cannot restore User")
+		return
+	}
+
+	writeJSON(w, "PostTrashUsersIdRestore", http.StatusOK, user)
+}
+
+func (h *linksHandler) GetUsersIdCleanup(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+
+	cleanup, err := h.client.GetUserCleanup(ctx, &pb.GetLinksByUserId{UserId: id})
+	if err != nil {
+		writeGRPCError(w, "GetUsersIdCleanup", err, "Cannot get user cleanup")
+		return
+	}
+
+	writeJSON(w, "GetUsersIdCleanup", http.StatusOK, cleanup)
+}
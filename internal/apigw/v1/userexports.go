@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+// userExportRetryAfter - через сколько секунд клиенту стоит снова спросить о выгрузке, которая собирается.
+const userExportRetryAfter = "5"
+
+func newUserExportsHandler(usersClient usersClient, exportsClient userExportsClient) *userExportsHandler {
+	return &userExportsHandler{users: usersClient, exports: exportsClient}
+}
+
+// userExportsHandler связывает users-srv и links-srv: запись пользователя берется из первого
+// и передается во второй, который собирает остальные данные и архив.
+type userExportsHandler struct {
+	users   usersClient
+	exports userExportsClient
+}
+
+func (h *userExportsHandler) PostUsersIdExport(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.PostUsersIdExportParams,
+) {
+	ctx := r.Context()
+
+	user, err := h.users.GetUser(ctx, &pb.GetUserRequest{Id: id})
+	if err != nil {
+		slog.Info("cannot get User at PostUsersIdExport handler", slog.Any("err", err))
+		http.Error(w, fmt.Sprintf("404 - User with ID %s is not found", id), http.StatusNotFound)
+		return
+	}
+
+	job, err := h.exports.CreateUserExport(ctx, &pb.CreateUserExportRequest{User: user})
+	if err != nil {
+		writeGRPCError(w, "PostUsersIdExport", err, "Cannot create user export")
+		return
+	}
+
+	writeJSON(w, "PostUsersIdExport", http.StatusAccepted, job)
+}
+
+// GetUsersIdExport отдает выгрузку. Пользователя, от имени которого выполняется запрос, links-srv
+// получает из метаданных вызова и сам проверяет, что выгрузка ему доступна.
+func (h *userExportsHandler) GetUsersIdExport(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.GetUsersIdExportParams,
+) {
+	ctx, cancel := context.WithTimeout(r.Context(), exportTimeout)
+	defer cancel()
+
+	job, err := h.exports.GetUserExport(ctx, &pb.GetUserExportRequest{UserId: id})
+	if err != nil {
+		writeGRPCError(w, "GetUsersIdExport", err, "Cannot get user export")
+		return
+	}
+
+	switch job.Status {
+	case "pending", "running":
+		w.Header().Set("Retry-After", userExportRetryAfter)
+		writeJSON(w, "GetUsersIdExport", http.StatusAccepted, job)
+		return
+	case "failed":
+		writeJSON(w, "GetUsersIdExport", http.StatusOK, job)
+		return
+	}
+
+	stream, err := h.exports.DownloadUserExport(ctx, &pb.GetUserExportRequest{UserId: id})
+	if err != nil {
+		writeGRPCError(w, "GetUsersIdExport", err, "Cannot download user export")
+		return
+	}
+
+	// Первую часть читаем до заголовков, чтобы ошибку links-srv можно было вернуть со своим статусом
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		writeGRPCError(w, "GetUsersIdExport", err, "Cannot download user export")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "user-"+id+"-export.zip"))
+	w.Header().Set("Content-Length", fmt.Sprint(job.Size))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	for chunk := first; chunk != nil; {
+		if _, err := w.Write(chunk.Chunk); err != nil {
+			slog.Error("cannot write response at GetUsersIdExport handler", slog.Any("err", err))
+			return
+		}
+
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// Статус уже отправлен, поэтому обрываем соединение, чтобы клиент не принял обрезанный архив за полный
+			slog.Error("cannot receive user export at GetUsersIdExport handler", slog.Any("err", err))
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
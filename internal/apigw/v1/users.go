@@ -1,20 +1,14 @@
 package v1
 
 import (
-	"context"
 	"encoding/json"
-	"fmt"
 	"log/slog"
 	"net/http"
-	"time"
 
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
 	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
 )
 
-const (
-	ctxTimeout = 2 * time.Second
-)
-
 func newUsersHandler(usersClient usersClient) *usersHandler {
 	return &usersHandler{client: usersClient}
 }
@@ -24,8 +18,7 @@ type usersHandler struct {
 }
 
 func (h *usersHandler) GetUsers(w http.ResponseWriter, r *http.Request) {
-	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-	defer cancel()
+	ctx := r.Context()
 
 	users, err := h.client.ListUsers(ctx, &pb.Empty{})
 	if err != nil {
@@ -49,10 +42,10 @@ func (h *usersHandler) GetUsers(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *usersHandler) PostUsers(w http.ResponseWriter, r *http.Request) {
-	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-	defer cancel()
+	ctx := r.Context()
 
-	var userReq pb.CreateUserRequest
+	// Формат полей уже проверен по спецификации, см. routes
+	var userReq apiv1.UserCreate
 	err := json.NewDecoder(r.Body).Decode(&userReq)
 	if err != nil {
 		slog.Error("cannot decode request body at PostUsers handler", slog.Any("err", err))
@@ -60,16 +53,15 @@ func (h *usersHandler) PostUsers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if userReq.Id != "" || userReq.Username == "" || userReq.Password == "" {
-		slog.Error("invalid body params at PostUsers handler", slog.Any("err", err))
-		http.Error(w, "bad request body", http.StatusBadRequest)
+	if userReq.Id != "" {
+		slog.Info("user ID is set at PostUsers handler")
+		http.Error(w, "400 - User ID is generated by the server", http.StatusBadRequest)
 		return
 	}
 
-	_, err = h.client.CreateUser(ctx, &userReq)
+	_, err = h.client.CreateUser(ctx, &pb.CreateUserRequest{Username: userReq.Username, Password: userReq.Password})
 	if err != nil {
-		slog.Error("cannot create User at PostUsers handler", slog.Any("err", err))
-		http.Error(w, "500 - Cannot create User", http.StatusInternalServerError)
+		writeGRPCError(w, "PostUsers", err, "Cannot create User")
 		return
 	}
 
@@ -77,23 +69,17 @@ func (h *usersHandler) PostUsers(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *usersHandler) DeleteUsersId(w http.ResponseWriter, r *http.Request, id string) {
-	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-	defer cancel()
-
-	req := &pb.GetUserRequest{Id: r.PathValue("id")}
+	ctx := r.Context()
 
-	_, err := h.client.GetUser(ctx, req)
+	_, err := h.client.GetUser(ctx, &pb.GetUserRequest{Id: id})
 	if err != nil {
-		slog.Info("cannot get User at DeleteUsersId handler", slog.Any("err", err))
-		http.Error(w, fmt.Sprintf("404 - User with ID %s is not found", r.PathValue("id")), http.StatusNotFound)
+		writeGRPCError(w, "DeleteUsersId", err, "Cannot get User")
 		return
 	}
 
-	delReq := &pb.DeleteUserRequest{Id: r.PathValue("id")}
-	_, err = h.client.DeleteUser(ctx, delReq)
+	_, err = h.client.DeleteUser(ctx, &pb.DeleteUserRequest{Id: id})
 	if err != nil {
-		slog.Error("cannot delete User at DeleteUsersId handler", slog.Any("err", err))
-		http.Error(w, "500 - Cannot create User", http.StatusInternalServerError)
+		writeGRPCError(w, "DeleteUsersId", err, "Cannot delete User")
 		return
 	}
 
@@ -101,15 +87,11 @@ func (h *usersHandler) DeleteUsersId(w http.ResponseWriter, r *http.Request, id
 }
 
 func (h *usersHandler) GetUsersId(w http.ResponseWriter, r *http.Request, id string) {
-	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-	defer cancel()
+	ctx := r.Context()
 
-	req := &pb.GetUserRequest{Id: r.PathValue("id")}
-
-	user, err := h.client.GetUser(ctx, req)
+	user, err := h.client.GetUser(ctx, &pb.GetUserRequest{Id: id})
 	if err != nil {
-		slog.Info("cannot get User at GetUsersId handler", slog.Any("err", err))
-		http.Error(w, fmt.Sprintf("404 - User with ID %s is not found", r.PathValue("id")), http.StatusNotFound)
+		writeGRPCError(w, "GetUsersId", err, "Cannot get User")
 		return
 	}
 
@@ -128,10 +110,9 @@ func (h *usersHandler) GetUsersId(w http.ResponseWriter, r *http.Request, id str
 }
 
 func (h *usersHandler) PutUsersId(w http.ResponseWriter, r *http.Request, id string) {
-	ctx, cancel := context.WithTimeout(r.Context(), ctxTimeout)
-	defer cancel()
+	ctx := r.Context()
 
-	var userReq pb.UpdateUserRequest
+	var userReq apiv1.UserUpdate
 	err := json.NewDecoder(r.Body).Decode(&userReq)
 	if err != nil {
 		slog.Error("cannot decode request body at PutUsersId handler", slog.Any("err", err))
@@ -139,28 +120,25 @@ func (h *usersHandler) PutUsersId(w http.ResponseWriter, r *http.Request, id str
 		return
 	}
 
-	req := &pb.GetUserRequest{Id: r.PathValue("id")}
-
-	user, err := h.client.GetUser(ctx, req)
+	user, err := h.client.GetUser(ctx, &pb.GetUserRequest{Id: id})
 	if err != nil {
-		slog.Error("cannot get User at PutUsersId handler", slog.Any("err", err))
-		http.Error(w, fmt.Sprintf("404 - User with ID %s is not found", r.PathValue("id")), http.StatusNotFound)
+		writeGRPCError(w, "PutUsersId", err, "Cannot get User")
 		return
 	}
 
-	updReq := &pb.CreateUserRequest{Id: user.Id}
-	if user.Username != userReq.Username {
-		updReq.Username = userReq.Username
+	// Не переданные поля остаются прежними
+	updReq := &pb.CreateUserRequest{Id: user.Id, Username: user.Username, Password: user.Password}
+	if userReq.Username != nil {
+		updReq.Username = *userReq.Username
 	}
 
-	if user.Password != userReq.Password {
-		updReq.Password = userReq.Password
+	if userReq.Password != nil {
+		updReq.Password = *userReq.Password
 	}
 
 	_, err = h.client.CreateUser(ctx, updReq)
 	if err != nil {
-		slog.Error("cannot create User at PutUsersId handler", slog.Any("err", err))
-		http.Error(w, "500 - Cannot update User", http.StatusInternalServerError)
+		writeGRPCError(w, "PutUsersId", err, "Cannot update User")
 		return
 	}
 }
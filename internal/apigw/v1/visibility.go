@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func (h *linksHandler) GetPublicLinks(w http.ResponseWriter, r *http.Request, params apiv1.GetPublicLinksParams) {
+	ctx := r.Context()
+
+	links, err := h.client.ListPublicLinks(ctx, &pb.ListPublicLinksRequest{
+		Limit:  value(params.Limit),
+		Offset: value(params.Offset),
+	})
+	if err != nil {
+		writeGRPCError(w, "GetPublicLinks", err, "Cannot get public Links")
+		return
+	}
+
+	res := links.Links
+	if res == nil {
+		res = []*pb.Link{}
+	}
+
+	writeJSON(w, "GetPublicLinks", http.StatusOK, res)
+}
+
+func (h *linksHandler) GetUsersIdLinkSettings(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.GetUsersIdLinkSettingsParams,
+) {
+	ctx := r.Context()
+
+	settings, err := h.client.GetLinkSettings(ctx, &pb.GetLinksByUserId{UserId: id})
+	if err != nil {
+		writeGRPCError(w, "GetUsersIdLinkSettings", err, "Cannot get link settings")
+		return
+	}
+
+	writeJSON(w, "GetUsersIdLinkSettings", http.StatusOK, settings)
+}
+
+func (h *linksHandler) PutUsersIdLinkSettings(
+	w http.ResponseWriter, r *http.Request, id string, _ apiv1.PutUsersIdLinkSettingsParams,
+) {
+	ctx := r.Context()
+
+	var req apiv1.LinkSettings
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("cannot decode request body at PutUsersIdLinkSettings handler", slog.Any("err", err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	settings, err := h.client.UpdateLinkSettings(ctx, &pb.LinkSettings{
+		UserId:            id,
+		DefaultVisibility: string(req.DefaultVisibility),
+	})
+	if err != nil {
+		writeGRPCError(w, "PutUsersIdLinkSettings", err, "Cannot update link settings")
+		return
+	}
+
+	writeJSON(w, "PutUsersIdLinkSettings", http.StatusOK, settings)
+}
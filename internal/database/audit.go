@@ -0,0 +1,40 @@
+package database
+
+import (
+	"time"
+)
+
+// AuditAction - действие, записываемое в журнал аудита.
+type AuditAction string
+
+const (
+	AuditUserCreated  AuditAction = "user.created"
+	AuditUserUpdated  AuditAction = "user.updated"
+	AuditUserDeleted  AuditAction = "user.deleted"
+	AuditUserRestored AuditAction = "user.restored"
+)
+
+// AuditEntry - запись журнала аудита. Before и After содержат только изменившиеся поля цели,
+// секреты вроде пароля в них не попадают.
+type AuditEntry struct {
+	ID         int64          `db:"id"`
+	Actor      string         `db:"actor"` // ID пользователя из заголовка api-gw, пусто, если он неизвестен
+	Action     AuditAction    `db:"action"`
+	TargetType string         `db:"target_type"`
+	TargetID   string         `db:"target_id"`
+	RequestID  string         `db:"request_id"`
+	IP         string         `db:"ip"`
+	Before     map[string]any `db:"before"`
+	After      map[string]any `db:"after"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
+
+// AuditFilter задает выборку журнала аудита. Нулевые значения не ограничивают выборку.
+type AuditFilter struct {
+	Actor  string
+	Action AuditAction
+	From   time.Time
+	To     time.Time
+	Limit  int64
+	Offset int64
+}
@@ -0,0 +1,115 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 500
+)
+
+func New(db *pgxpool.Pool, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *pgxpool.Pool
+	timeout time.Duration
+}
+
+// Create добавляет запись в журнал аудита.
+func (r *Repository) Create(ctx context.Context, e database.AuditEntry) (database.AuditEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `
+		INSERT INTO audit_log (actor, action, target_type, target_id, request_id, ip, before, after)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRow(
+		ctx, query, e.Actor, string(e.Action), e.TargetType, e.TargetID, e.RequestID, e.IP, e.Before, e.After,
+	).Scan(&e.ID, &e.CreatedAt)
+	if err != nil {
+		return e, fmt.Errorf("postgres QueryRow Scan: %w", err)
+	}
+
+	return e, nil
+}
+
+// Find возвращает страницу журнала аудита, новые записи первыми.
+func (r *Repository) Find(ctx context.Context, filter database.AuditFilter) ([]database.AuditEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var (
+		where []string
+		args  []any
+	)
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		where = append(where, strings.ReplaceAll(cond, "?", "$"+strconv.Itoa(len(args))))
+	}
+
+	if filter.Actor != "" {
+		add("actor = ?", filter.Actor)
+	}
+	if filter.Action != "" {
+		add("action = ?", string(filter.Action))
+	}
+	if !filter.From.IsZero() {
+		add("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		add("created_at < ?", filter.To)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 || limit > maxLimit {
+		limit = defaultLimit
+	}
+
+	query := `SELECT id, actor, action, target_type, target_id, request_id, ip, before, after, created_at FROM audit_log`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, ` AND `)
+	}
+	query += fmt.Sprintf(` ORDER BY created_at DESC, id DESC LIMIT %d OFFSET %d`, limit, max(filter.Offset, 0))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres Query: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []database.AuditEntry
+	for rows.Next() {
+		var (
+			e      database.AuditEntry
+			action string
+		)
+		err := rows.Scan(
+			&e.ID, &e.Actor, &action, &e.TargetType, &e.TargetID, &e.RequestID, &e.IP, &e.Before, &e.After,
+			&e.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		e.Action = database.AuditAction(action)
+		entries = append(entries, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during rows iteration: %w", err)
+	}
+
+	return entries, nil
+}
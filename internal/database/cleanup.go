@@ -0,0 +1,29 @@
+package database
+
+import "time"
+
+type CleanupStatus string
+
+const (
+	CleanupRunning CleanupStatus = "running"
+	CleanupDone    CleanupStatus = "done"
+	CleanupFailed  CleanupStatus = "failed"
+	// CleanupRestored - пользователя восстановили, ссылки и коллекции, убранные очисткой, возвращены из корзины
+	CleanupRestored CleanupStatus = "restored"
+)
+
+// UserCleanup - ход очистки данных удаленного пользователя в links-srv. Повторная обработка
+// того же события продолжает запись, а не заводит новую: счетчики копятся за все попытки.
+type UserCleanup struct {
+	UserID      string        `bson:"_id"`
+	Status      CleanupStatus `bson:"status"`
+	Links       int64         `bson:"links"`       // ссылки, перемещенные в корзину
+	Collections int64         `bson:"collections"` // коллекции, перемещенные в корзину
+	Shares      int64         `bson:"shares"`      // отозванные ссылки доступа
+	Error       string        `bson:"error,omitempty"`
+	DeletedAt   time.Time     `bson:"deleted_at"` // когда пользователя удалили в users-srv
+	UpdatedAt   time.Time     `bson:"updated_at"`
+	FinishedAt  *time.Time    `bson:"finished_at,omitempty"`
+	Restored    int64         `bson:"restored"`              // ссылки, возвращенные из корзины при восстановлении
+	RestoredAt  *time.Time    `bson:"restored_at,omitempty"` // когда пользователя восстановили в users-srv
+}
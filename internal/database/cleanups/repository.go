@@ -0,0 +1,56 @@
+package cleanups
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const collection = "user_cleanups"
+
+func New(db *mongo.Database, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *mongo.Database
+	timeout time.Duration
+}
+
+func (r *Repository) FindByUserID(ctx context.Context, userID string) (database.UserCleanup, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var c database.UserCleanup
+	result := r.db.Collection(collection).FindOne(ctx, bson.M{"_id": userID})
+	if err := result.Err(); err != nil {
+		return c, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&c); err != nil {
+		return c, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return c, nil
+}
+
+// Upsert сохраняет ход очистки, заменяя предыдущую запись о том же пользователе.
+func (r *Repository) Upsert(ctx context.Context, c database.UserCleanup) (database.UserCleanup, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	c.UpdatedAt = time.Now()
+	opts := options.Replace().SetUpsert(true)
+
+	if _, err := r.db.Collection(collection).ReplaceOne(ctx, bson.M{"_id": c.UserID}, c, opts); err != nil {
+		return c, fmt.Errorf("mongo ReplaceOne: %w", err)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Slug связывает короткий идентификатор со ссылкой. Хранится отдельно от ссылки,
+// чтобы уникальность обеспечивал _id, а замена ссылки при обновлении не теряла slug.
+type Slug struct {
+	Slug      string             `bson:"_id"`
+	LinkID    primitive.ObjectID `bson:"link_id"`
+	UserID    string             `bson:"user_id"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// Click фиксирует переход по короткой ссылке. Записи только добавляются, IP-адрес не хранится.
+type Click struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	LinkID    primitive.ObjectID `bson:"link_id"`
+	At        time.Time          `bson:"at"`
+	Referrer  string             `bson:"referrer"` // хост источника перехода
+	UserAgent string             `bson:"user_agent"`
+	Country   string             `bson:"country,omitempty"`
+}
+
+type StatBucket struct {
+	Key   string `bson:"_id"`
+	Count int64  `bson:"count"`
+}
+
+type LinkStats struct {
+	Total       int64
+	ByDay       []StatBucket
+	ByReferrer  []StatBucket
+	ByUserAgent []StatBucket
+	ByCountry   []StatBucket
+}
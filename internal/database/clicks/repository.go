@@ -0,0 +1,107 @@
+package clicks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const collection = "clicks"
+
+func New(db *mongo.Database, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *mongo.Database
+	timeout time.Duration
+}
+
+func (r *Repository) Create(ctx context.Context, c database.Click) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if _, err := r.db.Collection(collection).InsertOne(ctx, c); err != nil {
+		return fmt.Errorf("mongo InsertOne: %w", err)
+	}
+
+	return nil
+}
+
+// Stats агрегирует переходы по ссылке за один запрос: по дням (UTC), источникам, клиентам и странам.
+func (r *Repository) Stats(ctx context.Context, linkID primitive.ObjectID) (database.LinkStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	group := func(key any, sort bson.D) bson.A {
+		return bson.A{
+			bson.M{"$group": bson.M{"_id": key, "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": sort},
+		}
+	}
+	byCount := bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"link_id": linkID}}},
+		{{Key: "$facet", Value: bson.M{
+			"total": bson.A{bson.M{"$count": "count"}},
+			"by_day": group(
+				bson.M{"$dateToString": bson.M{"format": "%Y-%m-%d", "date": "$at"}},
+				bson.D{{Key: "_id", Value: 1}},
+			),
+			"by_referrer":   group("$referrer", byCount),
+			"by_user_agent": group("$user_agent", byCount),
+			"by_country":    group(bson.M{"$ifNull": bson.A{"$country", ""}}, byCount),
+		}}},
+	}
+
+	cursor, err := r.db.Collection(collection).Aggregate(ctx, pipeline)
+	if err != nil {
+		return database.LinkStats{}, fmt.Errorf("mongo Aggregate: %w", err)
+	}
+
+	var res []struct {
+		Total []struct {
+			Count int64 `bson:"count"`
+		} `bson:"total"`
+		ByDay       []database.StatBucket `bson:"by_day"`
+		ByReferrer  []database.StatBucket `bson:"by_referrer"`
+		ByUserAgent []database.StatBucket `bson:"by_user_agent"`
+		ByCountry   []database.StatBucket `bson:"by_country"`
+	}
+	if err = cursor.All(ctx, &res); err != nil {
+		return database.LinkStats{}, fmt.Errorf("mongo All: %w", err)
+	}
+
+	var stats database.LinkStats
+	if len(res) == 0 {
+		return stats, nil
+	}
+
+	if len(res[0].Total) > 0 {
+		stats.Total = res[0].Total[0].Count
+	}
+	stats.ByDay = res[0].ByDay
+	stats.ByReferrer = res[0].ByReferrer
+	stats.ByUserAgent = res[0].ByUserAgent
+	stats.ByCountry = res[0].ByCountry
+
+	return stats, nil
+}
+
+func (r *Repository) DeleteByLinkID(ctx context.Context, linkID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if _, err := r.db.Collection(collection).DeleteMany(ctx, bson.M{"link_id": linkID}); err != nil {
+		return fmt.Errorf("mongo DeleteMany: %w", err)
+	}
+
+	return nil
+}
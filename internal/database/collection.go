@@ -0,0 +1,39 @@
+package database
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Collection группирует ссылки пользователя. Ссылка может входить в несколько коллекций,
+// порядок ссылок в коллекции задается порядком LinkIDs, а удаление коллекции ссылки не затрагивает.
+type Collection struct {
+	ID          primitive.ObjectID   `bson:"_id"`
+	UserID      string               `bson:"user_id"`
+	Name        string               `bson:"name"`
+	Description string               `bson:"description,omitempty"`
+	ParentID    *primitive.ObjectID  `bson:"parent_id,omitempty"`
+	Position    int64                `bson:"position"`
+	LinkIDs     []primitive.ObjectID `bson:"link_ids"`
+	DeletedAt   *time.Time           `bson:"deleted_at,omitempty"` // когда коллекцию скрыли вместе с удаленным пользователем
+	CreatedAt   time.Time            `bson:"created_at"`
+	UpdatedAt   time.Time            `bson:"updated_at"`
+}
+
+type CreateCollectionReq struct {
+	ID          primitive.ObjectID
+	UserID      string
+	Name        string
+	Description string
+	ParentID    *primitive.ObjectID
+	Position    int64
+}
+
+type UpdateCollectionReq struct {
+	ID          primitive.ObjectID
+	Name        string
+	Description string
+	ParentID    *primitive.ObjectID
+	Position    int64
+}
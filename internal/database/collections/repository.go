@@ -0,0 +1,261 @@
+package collections
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const collection = "collections"
+
+// notDeleted дополняет фильтр условием, исключающим коллекции удаленных пользователей.
+func notDeleted(filter bson.M) bson.M {
+	filter["deleted_at"] = bson.M{"$exists": false}
+	return filter
+}
+
+func New(db *mongo.Database, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *mongo.Database
+	timeout time.Duration
+}
+
+func (r *Repository) Create(ctx context.Context, req database.CreateCollectionReq) (database.Collection, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	now := time.Now()
+
+	c := database.Collection{
+		ID:          req.ID,
+		UserID:      req.UserID,
+		Name:        req.Name,
+		Description: req.Description,
+		ParentID:    req.ParentID,
+		Position:    req.Position,
+		LinkIDs:     []primitive.ObjectID{},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if _, err := r.db.Collection(collection).InsertOne(ctx, c); err != nil {
+		return c, fmt.Errorf("mongo InsertOne: %w", err)
+	}
+
+	return c, nil
+}
+
+// Update меняет описание и положение коллекции, не трогая ее ссылки и дату создания.
+func (r *Repository) Update(ctx context.Context, req database.UpdateCollectionReq) (database.Collection, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	set := bson.M{
+		"name":        req.Name,
+		"description": req.Description,
+		"position":    req.Position,
+		"updated_at":  time.Now(),
+	}
+	update := bson.M{"$set": set}
+	if req.ParentID != nil {
+		set["parent_id"] = req.ParentID
+	} else {
+		update["$unset"] = bson.M{"parent_id": ""}
+	}
+
+	var c database.Collection
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	result := r.db.Collection(collection).FindOneAndUpdate(ctx, notDeleted(bson.M{"_id": req.ID}), update, opts)
+	if err := result.Err(); err != nil {
+		return c, fmt.Errorf("mongo FindOneAndUpdate: %w", err)
+	}
+
+	if err := result.Decode(&c); err != nil {
+		return c, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return c, nil
+}
+
+// Delete удаляет коллекцию, а вложенные в нее коллекции переносит на уровень выше.
+func (r *Repository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var c database.Collection
+	result := r.db.Collection(collection).FindOneAndDelete(ctx, notDeleted(bson.M{"_id": id}))
+	if err := result.Err(); err != nil {
+		return fmt.Errorf("mongo FindOneAndDelete: %w", err)
+	}
+
+	if err := result.Decode(&c); err != nil {
+		return fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	update := bson.M{"$unset": bson.M{"parent_id": ""}}
+	if c.ParentID != nil {
+		update = bson.M{"$set": bson.M{"parent_id": c.ParentID}}
+	}
+
+	if _, err := r.db.Collection(collection).UpdateMany(ctx, bson.M{"parent_id": id}, update); err != nil {
+		return fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return nil
+}
+
+// SoftDeleteByUserID скрывает коллекции удаленного пользователя и возвращает их количество.
+// Коллекции возвращает RestoreByUserID, а безвозвратно удаляет DeleteDeletedBefore.
+func (r *Repository) SoftDeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	res, err := r.db.Collection(collection).UpdateMany(ctx, notDeleted(bson.M{"user_id": userID}), bson.M{
+		"$set": bson.M{"deleted_at": time.Now()},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// RestoreByUserID возвращает коллекции восстановленного пользователя и возвращает их количество.
+func (r *Repository) RestoreByUserID(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "deleted_at": bson.M{"$exists": true}}
+	res, err := r.db.Collection(collection).UpdateMany(ctx, filter, bson.M{
+		"$unset": bson.M{"deleted_at": ""},
+		"$set":   bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// DeleteDeletedBefore безвозвратно удаляет коллекции, скрытые раньше before, и возвращает их количество.
+func (r *Repository) DeleteDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	res, err := r.db.Collection(collection).DeleteMany(ctx, bson.M{"deleted_at": bson.M{"$lt": before}})
+	if err != nil {
+		return 0, fmt.Errorf("mongo DeleteMany: %w", err)
+	}
+
+	return res.DeletedCount, nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, id primitive.ObjectID) (database.Collection, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var c database.Collection
+	result := r.db.Collection(collection).FindOne(ctx, notDeleted(bson.M{"_id": id}))
+	if err := result.Err(); err != nil {
+		return c, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&c); err != nil {
+		return c, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return c, nil
+}
+
+// FindByUserID возвращает коллекции пользователя, упорядоченные по позиции.
+func (r *Repository) FindByUserID(ctx context.Context, userID string) ([]database.Collection, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "position", Value: 1}, {Key: "created_at", Value: 1}})
+	cursor, err := r.db.Collection(collection).Find(ctx, notDeleted(bson.M{"user_id": userID}), opts)
+	if err != nil {
+		return nil, fmt.Errorf("mongo Find: %w", err)
+	}
+
+	var collections []database.Collection
+	for cursor.Next(ctx) {
+		var c database.Collection
+		if err := cursor.Decode(&c); err != nil {
+			return nil, fmt.Errorf("mongo Decode: %w", err)
+		}
+		collections = append(collections, c)
+	}
+
+	return collections, nil
+}
+
+func (r *Repository) AddLink(ctx context.Context, id, linkID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	_, err := r.db.Collection(collection).UpdateOne(ctx, notDeleted(bson.M{"_id": id}), bson.M{
+		"$addToSet": bson.M{"link_ids": linkID},
+		"$set":      bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("mongo UpdateOne: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) RemoveLink(ctx context.Context, id, linkID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	_, err := r.db.Collection(collection).UpdateOne(ctx, notDeleted(bson.M{"_id": id}), bson.M{
+		"$pull": bson.M{"link_ids": linkID},
+		"$set":  bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("mongo UpdateOne: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveLinkEverywhere убирает удаленную ссылку из всех коллекций.
+func (r *Repository) RemoveLinkEverywhere(ctx context.Context, linkID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	_, err := r.db.Collection(collection).UpdateMany(ctx, bson.M{"link_ids": linkID}, bson.M{
+		"$pull": bson.M{"link_ids": linkID},
+		"$set":  bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return nil
+}
+
+// SetLinks задает порядок ссылок в коллекции.
+func (r *Repository) SetLinks(ctx context.Context, id primitive.ObjectID, linkIDs []primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	_, err := r.db.Collection(collection).UpdateOne(ctx, notDeleted(bson.M{"_id": id}), bson.M{
+		"$set": bson.M{"link_ids": linkIDs, "updated_at": time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("mongo UpdateOne: %w", err)
+	}
+
+	return nil
+}
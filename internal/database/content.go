@@ -0,0 +1,17 @@
+package database
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// LinkContent хранит извлеченный текст страницы отдельно от ссылки, чтобы списки ссылок оставались легкими.
+type LinkContent struct {
+	LinkID      primitive.ObjectID `bson:"_id"`
+	UserID      string             `bson:"user_id"`
+	Text        string             `bson:"text"`
+	WordCount   int                `bson:"word_count"`
+	ReadingTime time.Duration      `bson:"reading_time"`
+	ExtractedAt time.Time          `bson:"extracted_at"`
+}
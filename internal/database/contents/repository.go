@@ -0,0 +1,67 @@
+package contents
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const collection = "link_contents"
+
+func New(db *mongo.Database, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *mongo.Database
+	timeout time.Duration
+}
+
+// Upsert сохраняет текст ссылки, заменяя ранее извлеченный.
+func (r *Repository) Upsert(ctx context.Context, c database.LinkContent) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	opts := options.Replace().SetUpsert(true)
+
+	if _, err := r.db.Collection(collection).ReplaceOne(ctx, bson.M{"_id": c.LinkID}, c, opts); err != nil {
+		return fmt.Errorf("mongo ReplaceOne: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) FindByLinkID(ctx context.Context, linkID primitive.ObjectID) (database.LinkContent, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var c database.LinkContent
+	result := r.db.Collection(collection).FindOne(ctx, bson.M{"_id": linkID})
+	if err := result.Err(); err != nil {
+		return c, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&c); err != nil {
+		return c, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return c, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, linkID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if _, err := r.db.Collection(collection).DeleteOne(ctx, bson.M{"_id": linkID}); err != nil {
+		return fmt.Errorf("mongo DeleteOne: %w", err)
+	}
+
+	return nil
+}
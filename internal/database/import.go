@@ -0,0 +1,35 @@
+package database
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ImportStatus string
+
+const (
+	ImportPending ImportStatus = "pending"
+	ImportRunning ImportStatus = "running"
+	ImportDone    ImportStatus = "done"
+	ImportFailed  ImportStatus = "failed"
+)
+
+// ImportJob - фоновый импорт закладок. Загруженный файл лежит в хранилище блобов,
+// поэтому задание можно перезапустить после рестарта сервиса.
+type ImportJob struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	UserID     string             `bson:"user_id"`
+	Format     string             `bson:"format"`
+	BlobSHA256 string             `bson:"blob_sha256"`
+	Status     ImportStatus       `bson:"status"`
+	Total      int64              `bson:"total"`
+	Processed  int64              `bson:"processed"`
+	Created    int64              `bson:"created"`
+	Duplicates int64              `bson:"duplicates"`
+	Failed     int64              `bson:"failed"`
+	Error      string             `bson:"error,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	UpdatedAt  time.Time          `bson:"updated_at"`
+	FinishedAt *time.Time         `bson:"finished_at,omitempty"`
+}
@@ -0,0 +1,125 @@
+package imports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const collection = "import_jobs"
+
+func New(db *mongo.Database, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *mongo.Database
+	timeout time.Duration
+}
+
+func (r *Repository) Create(ctx context.Context, job database.ImportJob) (database.ImportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	now := time.Now()
+	job.Status = database.ImportPending
+	job.CreatedAt = now
+	job.UpdatedAt = now
+
+	if _, err := r.db.Collection(collection).InsertOne(ctx, job); err != nil {
+		return job, fmt.Errorf("mongo InsertOne: %w", err)
+	}
+
+	return job, nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, id primitive.ObjectID) (database.ImportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var job database.ImportJob
+	result := r.db.Collection(collection).FindOne(ctx, bson.M{"_id": id})
+	if err := result.Err(); err != nil {
+		return job, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&job); err != nil {
+		return job, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return job, nil
+}
+
+// ClaimPending переводит самое старое ожидающее задание в работу и возвращает его.
+// Если заданий нет, возвращается mongo.ErrNoDocuments.
+func (r *Repository) ClaimPending(ctx context.Context) (database.ImportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var job database.ImportJob
+	err := r.db.Collection(collection).FindOneAndUpdate(
+		ctx,
+		bson.M{"status": database.ImportPending},
+		bson.M{"$set": bson.M{"status": database.ImportRunning, "updated_at": time.Now()}},
+		opts,
+	).Decode(&job)
+	if err != nil {
+		return job, fmt.Errorf("mongo FindOneAndUpdate: %w", err)
+	}
+
+	return job, nil
+}
+
+// ResetRunning возвращает в очередь задания, прерванные остановкой сервиса.
+func (r *Repository) ResetRunning(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	_, err := r.db.Collection(collection).UpdateMany(
+		ctx,
+		bson.M{"status": database.ImportRunning},
+		bson.M{"$set": bson.M{"status": database.ImportPending, "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateProgress сохраняет счетчики и статус задания.
+func (r *Repository) UpdateProgress(ctx context.Context, job database.ImportJob) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	set := bson.M{
+		"status":     job.Status,
+		"total":      job.Total,
+		"processed":  job.Processed,
+		"created":    job.Created,
+		"duplicates": job.Duplicates,
+		"failed":     job.Failed,
+		"error":      job.Error,
+		"updated_at": time.Now(),
+	}
+	if job.FinishedAt != nil {
+		set["finished_at"] = job.FinishedAt
+	}
+
+	if _, err := r.db.Collection(collection).UpdateOne(ctx, bson.M{"_id": job.ID}, bson.M{"$set": set}); err != nil {
+		return fmt.Errorf("mongo UpdateOne: %w", err)
+	}
+
+	return nil
+}
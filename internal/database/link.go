@@ -6,38 +6,130 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// Visibility определяет, кому кроме владельца доступна ссылка.
+type Visibility string
+
+const (
+	VisibilityPrivate  Visibility = "private"  // только владелец
+	VisibilityUnlisted Visibility = "unlisted" // любой, кто знает ID, но ссылки нет в списках и лентах
+	VisibilityPublic   Visibility = "public"   // все, включая общие списки и ленты
+)
+
+func (v Visibility) Valid() bool {
+	switch v {
+	case VisibilityPrivate, VisibilityUnlisted, VisibilityPublic:
+		return true
+	default:
+		return false
+	}
+}
+
+// Effective возвращает уровень доступа, считая ссылки без него публичными: до появления уровней
+// все ссылки были доступны всем, и скрывать их без ведома владельцев нельзя.
+func (v Visibility) Effective() Visibility {
+	if v == "" {
+		return VisibilityPublic
+	}
+
+	return v
+}
+
 type Link struct {
-	ID        primitive.ObjectID `bson:"_id"`
-	Title     string             `bson:"title,omitempty"`
-	URL       string             `bson:"url"`
-	Images    []string           `bson:"images"`
-	Tags      []string           `bson:"tags"`
-	UserID    string             `bson:"user_id"`
-	CreatedAt time.Time          `bson:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at"`
+	ID              primitive.ObjectID `bson:"_id"`
+	Title           string             `bson:"title,omitempty"`
+	Description     string             `bson:"description,omitempty"` // описание страницы или заметка из импорта
+	URL             string             `bson:"url"`
+	NormalizedURL   string             `bson:"normalized_url,omitempty"` // ключ поиска дубликатов, см. urlnorm
+	Images          []string           `bson:"images"`
+	Tags            []string           `bson:"tags"`      // теги, заданные пользователем
+	AutoTags        []string           `bson:"auto_tags"` // теги, извлеченные из страницы при обогащении
+	UserID          string             `bson:"user_id"`
+	Visibility      Visibility         `bson:"visibility,omitempty"`
+	ReadAt          *time.Time         `bson:"read_at,omitempty"` // когда ссылку впервые отметили прочитанной
+	Favorite        bool               `bson:"favorite,omitempty"`
+	Archived        bool               `bson:"archived,omitempty"`
+	DeletedAt       *time.Time         `bson:"deleted_at,omitempty"`        // когда ссылку переместили в корзину
+	DeletedWithUser bool               `bson:"deleted_with_user,omitempty"` // в корзине вместе с удаленным пользователем
+	CreatedAt       time.Time          `bson:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at"`
 }
 
 type CreateLinkReq struct {
-	ID     primitive.ObjectID
-	URL    string
-	Title  string
-	Tags   []string
-	Images []string
-	UserID string
+	ID            primitive.ObjectID
+	URL           string
+	NormalizedURL string
+	Title         string
+	Description   string
+	Tags          []string
+	AutoTags      []string
+	Images        []string
+	UserID        string
+	Visibility    Visibility
+	CreatedAt     time.Time // дата добавления из импорта, по умолчанию текущее время
+	Revisor       Revisor   // автор правки для истории ссылки
 }
 
 type UpdateLinkReq struct {
-	ID     primitive.ObjectID
-	URL    string
-	Title  string
-	Tags   []string
-	Images []string
-	UserID string
+	ID            primitive.ObjectID
+	URL           string
+	NormalizedURL string
+	Title         string
+	Description   string
+	Tags          []string
+	AutoTags      []string
+	Images        []string
+	UserID        string
+	Visibility    Visibility
+	Revisor       Revisor // автор правки для истории ссылки
+}
+
+// EnrichLinkReq - поля ссылки, которые вычисляет обработчик страницы. Пустые Title, Description и Images
+// не меняются, чтобы не затереть правки пользователя, сделанные во время обработки.
+type EnrichLinkReq struct {
+	ID            primitive.ObjectID
+	Title         string
+	Description   string
+	Images        []string
+	NormalizedURL string
+	AutoTags      []string
+	Revisor       Revisor
 }
 
+type TagsMatchMode int
+
+const (
+	TagsMatchAny TagsMatchMode = iota // ссылка содержит хотя бы один из тегов
+	TagsMatchAll                      // ссылка содержит все теги
+)
+
 type FindLinkCriteria struct {
-	UserID *string
-	Tags   []string
-	Limit  *int64
-	Offset *int64
+	UserID      *string
+	Tags        []string
+	TagsMode    TagsMatchMode
+	ExcludeTags []string
+	Visibility  *Visibility // только ссылки с этим уровнем доступа
+	Read        *bool
+	Favorite    *bool
+	Archived    *bool
+	Query       string               // подстрока в названии, описании или адресе без учета регистра
+	QueryIDs    []primitive.ObjectID // ссылки, которые подходят под Query по другим признакам, например по заметкам
+	NewestFirst bool                 // сортировать по убыванию даты создания
+	Limit       *int64
+	Offset      *int64
+}
+
+type TagCount struct {
+	Tag   string `bson:"_id"`
+	Count int64  `bson:"count"`
+}
+
+// LinkStateChange описывает изменение состояния ссылок, nil оставляет поле как есть.
+type LinkStateChange struct {
+	Read     *bool
+	Favorite *bool
+	Archived *bool
+}
+
+func (c LinkStateChange) Empty() bool {
+	return c.Read == nil && c.Favorite == nil && c.Archived == nil
 }
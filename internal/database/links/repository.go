@@ -3,6 +3,7 @@ package links
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -15,6 +16,26 @@ import (
 
 const collection = "links"
 
+// notDeleted дополняет фильтр условием, исключающим ссылки из корзины.
+func notDeleted(filter bson.M) bson.M {
+	filter["deleted_at"] = bson.M{"$exists": false}
+	return filter
+}
+
+// withVisibility ограничивает выборку ссылками с уровнем доступа v, если он задан.
+// Ссылки без уровня доступа публичные, см. database.Visibility.Effective.
+func withVisibility(filter bson.M, v *database.Visibility) bson.M {
+	switch {
+	case v == nil:
+	case *v == database.VisibilityPublic:
+		// Условие на null находит и отсутствующее поле
+		filter["visibility"] = bson.M{"$in": bson.A{database.VisibilityPublic, nil}}
+	default:
+		filter["visibility"] = *v
+	}
+	return filter
+}
+
 func New(db *mongo.Database, timeout time.Duration) *Repository {
 	return &Repository{db: db, timeout: timeout}
 }
@@ -24,56 +45,192 @@ type Repository struct {
 	timeout time.Duration
 }
 
+// EnsureIndexes создает индексы коллекции ссылок. Уникальный индекс по (user_id, normalized_url)
+// не распространяется на ссылки, сохраненные до появления нормализации. Индекс по visibility
+// обслуживает общий список публичных ссылок, индексы по состоянию - списки для чтения,
+// разреженный индекс по deleted_at - корзину и ее очистку. Также создается индекс истории правок.
+func (r *Repository) EnsureIndexes(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	_, err := r.db.Collection(collection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "normalized_url", Value: 1}},
+			Options: options.Index().
+				SetName("user_id_normalized_url").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"normalized_url": bson.M{"$type": "string"}}),
+		},
+		{
+			Keys:    bson.D{{Key: "visibility", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("visibility_created_at"),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "archived", Value: 1},
+				{Key: "read_at", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+			Options: options.Index().SetName("user_id_archived_read_at_created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "favorite", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("user_id_favorite_created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetName("deleted_at").SetSparse(true),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("mongo CreateIndexes: %w", err)
+	}
+
+	return r.ensureRevisionIndexes(ctx)
+}
+
 func (r *Repository) Create(ctx context.Context, req database.CreateLinkReq) (database.Link, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
 	now := time.Now()
 
+	createdAt := req.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = now
+	}
+
 	l := database.Link{
-		ID:        req.ID,
-		Title:     req.Title,
-		URL:       req.URL,
-		Images:    req.Images,
-		Tags:      req.Tags,
-		UserID:    req.UserID,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:            req.ID,
+		Title:         req.Title,
+		Description:   req.Description,
+		URL:           req.URL,
+		NormalizedURL: req.NormalizedURL,
+		Images:        req.Images,
+		Tags:          req.Tags,
+		AutoTags:      req.AutoTags,
+		UserID:        req.UserID,
+		Visibility:    req.Visibility,
+		CreatedAt:     createdAt,
+		UpdatedAt:     now,
 	}
 	if _, err := r.db.Collection(collection).InsertOne(ctx, l); err != nil {
 		return l, fmt.Errorf("mongo InsertOne: %w", err)
 	}
 
+	if err := r.recordRevision(ctx, l.ID, database.LinkFields{}, l.Fields(), req.Revisor); err != nil {
+		return l, err
+	}
+
 	return l, nil
 }
 
+// Update заменяет поля ссылки, сохраняя дату создания, и записывает правку в историю.
+// Ссылку из корзины изменить нельзя: для нее, как и для отсутствующей, возвращается mongo.ErrNoDocuments.
 func (r *Repository) Update(ctx context.Context, req database.UpdateLinkReq) (database.Link, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
 	now := time.Now()
 
-	l := database.Link{
-		ID:        req.ID,
-		Title:     req.Title,
-		URL:       req.URL,
-		Images:    req.Images,
-		Tags:      req.Tags,
-		UserID:    req.UserID,
-		CreatedAt: now, // обновляем и created_at также. Подумайте как поменять методы так,
-		// чтобы поле created_at не обновлялось, это можно сделать разными способоами
-		UpdatedAt: now,
+	update := bson.M{
+		"$set": bson.M{
+			"title":          req.Title,
+			"description":    req.Description,
+			"url":            req.URL,
+			"normalized_url": req.NormalizedURL,
+			"images":         req.Images,
+			"tags":           req.Tags,
+			"auto_tags":      req.AutoTags,
+			"user_id":        req.UserID,
+			"visibility":     req.Visibility,
+			"updated_at":     now,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+
+	// Прежняя версия нужна для истории правок
+	var before database.Link
+	result := r.db.Collection(collection).FindOneAndUpdate(ctx, notDeleted(bson.M{"_id": req.ID}), update, opts)
+	if err := result.Decode(&before); err != nil {
+		return before, fmt.Errorf("mongo FindOneAndUpdate: %w", err)
+	}
+
+	l := before
+	l.ID = req.ID
+	l.Title = req.Title
+	l.Description = req.Description
+	l.URL = req.URL
+	l.NormalizedURL = req.NormalizedURL
+	l.Images = req.Images
+	l.Tags = req.Tags
+	l.AutoTags = req.AutoTags
+	l.UserID = req.UserID
+	l.Visibility = req.Visibility
+	l.UpdatedAt = now
+
+	if err := r.recordRevision(ctx, l.ID, before.Fields(), l.Fields(), req.Revisor); err != nil {
+		return l, err
+	}
+
+	return l, nil
+}
+
+// Enrich записывает результат обработки страницы в существующую ссылку не из корзины и добавляет правку в историю.
+// Остальные поля не трогаются, поэтому правки пользователя, сделанные во время обработки, сохраняются.
+// Если ссылки нет, возвращается mongo.ErrNoDocuments.
+func (r *Repository) Enrich(ctx context.Context, req database.EnrichLinkReq) (database.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	now := time.Now()
+
+	set := bson.M{
+		"normalized_url": req.NormalizedURL,
+		"auto_tags":      req.AutoTags,
+		"updated_at":     now,
+	}
+	if req.Title != "" {
+		set["title"] = req.Title
+	}
+	if req.Description != "" {
+		set["description"] = req.Description
+	}
+	if len(req.Images) > 0 {
+		set["images"] = req.Images
 	}
 
-	opts := options.Replace().SetUpsert(true)
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
 
-	if _, err := r.db.Collection(collection).ReplaceOne(ctx, bson.M{"_id": req.ID}, l, opts); err != nil {
-		return l, fmt.Errorf("mongo ReplaceOne: %w", err)
+	var before database.Link
+	result := r.db.Collection(collection).FindOneAndUpdate(ctx, notDeleted(bson.M{"_id": req.ID}), bson.M{"$set": set}, opts)
+	if err := result.Decode(&before); err != nil {
+		return before, fmt.Errorf("mongo FindOneAndUpdate: %w", err)
+	}
+
+	l := before
+	l.NormalizedURL = req.NormalizedURL
+	l.AutoTags = req.AutoTags
+	l.UpdatedAt = now
+	if req.Title != "" {
+		l.Title = req.Title
+	}
+	if req.Description != "" {
+		l.Description = req.Description
+	}
+	if len(req.Images) > 0 {
+		l.Images = req.Images
+	}
+
+	if err := r.recordRevision(ctx, l.ID, before.Fields(), l.Fields(), req.Revisor); err != nil {
+		return l, err
 	}
 
 	return l, nil
 }
 
+// Delete удаляет ссылку безвозвратно вместе с историей правок. Обычное удаление перемещает ссылку в корзину, см. SoftDelete.
 func (r *Repository) Delete(ctx context.Context, id primitive.ObjectID) error {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
@@ -82,14 +239,138 @@ func (r *Repository) Delete(ctx context.Context, id primitive.ObjectID) error {
 		return fmt.Errorf("mongo DeletOne: %w", err)
 	}
 
+	return r.deleteRevisions(ctx, id)
+}
+
+// SoftDelete перемещает ссылку в корзину. Связанные данные остаются на месте до очистки корзины.
+func (r *Repository) SoftDelete(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	_, err := r.db.Collection(collection).UpdateOne(ctx, notDeleted(bson.M{"_id": id}), bson.M{
+		"$set": bson.M{"deleted_at": time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("mongo UpdateOne: %w", err)
+	}
+
 	return nil
 }
 
+// CountByUserID возвращает, сколько ссылок хранится у пользователя, включая корзину.
+func (r *Repository) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	n, err := r.db.Collection(collection).CountDocuments(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return 0, fmt.Errorf("mongo CountDocuments: %w", err)
+	}
+
+	return n, nil
+}
+
+// SoftDeleteByUserID перемещает в корзину все ссылки удаленного пользователя и возвращает, сколько их было.
+// Ссылки помечаются, чтобы RestoreByUserID вернул только их, а не то, что пользователь удалил сам.
+func (r *Repository) SoftDeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	res, err := r.db.Collection(collection).UpdateMany(ctx, notDeleted(bson.M{"user_id": userID}), bson.M{
+		"$set": bson.M{"deleted_at": time.Now(), "deleted_with_user": true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// Restore возвращает ссылку пользователя из корзины. Если в корзине ее нет, возвращается mongo.ErrNoDocuments.
+func (r *Repository) Restore(ctx context.Context, id primitive.ObjectID, userID string) (database.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	filter := bson.M{"_id": id, "user_id": userID, "deleted_at": bson.M{"$exists": true}}
+	update := bson.M{
+		"$unset": bson.M{"deleted_at": "", "deleted_with_user": ""},
+		"$set":   bson.M{"updated_at": time.Now()},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var l database.Link
+	result := r.db.Collection(collection).FindOneAndUpdate(ctx, filter, update, opts)
+	if err := result.Err(); err != nil {
+		return l, fmt.Errorf("mongo FindOneAndUpdate: %w", err)
+	}
+
+	if err := result.Decode(&l); err != nil {
+		return l, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return l, nil
+}
+
+// RestoreByUserID возвращает из корзины ссылки, перемещенные туда SoftDeleteByUserID, и возвращает, сколько их было.
+// Ссылки, которые пользователь удалил сам, остаются в корзине.
+func (r *Repository) RestoreByUserID(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "deleted_with_user": true}
+	res, err := r.db.Collection(collection).UpdateMany(ctx, filter, bson.M{
+		"$unset": bson.M{"deleted_at": "", "deleted_with_user": ""},
+		"$set":   bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// FindDeletedByUserID возвращает корзину пользователя, недавно удаленные ссылки первыми.
+func (r *Repository) FindDeletedByUserID(ctx context.Context, userID string) ([]database.Link, error) {
+	filter := bson.M{"user_id": userID, "deleted_at": bson.M{"$exists": true}}
+	opts := options.Find().SetSort(bson.D{{Key: "deleted_at", Value: -1}, {Key: "_id", Value: -1}})
+
+	return r.find(ctx, filter, opts)
+}
+
+// FindDeletedBefore возвращает не больше limit ссылок, попавших в корзину раньше before.
+func (r *Repository) FindDeletedBefore(ctx context.Context, before time.Time, limit int64) ([]database.Link, error) {
+	filter := bson.M{"deleted_at": bson.M{"$lt": before}}
+	opts := options.Find().SetSort(bson.D{{Key: "deleted_at", Value: 1}}).SetLimit(limit)
+
+	return r.find(ctx, filter, opts)
+}
+
+func (r *Repository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]database.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	cursor, err := r.db.Collection(collection).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("mongo Find: %w", err)
+	}
+
+	var links []database.Link
+	for cursor.Next(ctx) {
+		var l database.Link
+		if err := cursor.Decode(&l); err != nil {
+			return nil, fmt.Errorf("mongo Decode: %w", err)
+		}
+		links = append(links, l)
+	}
+
+	return links, nil
+}
+
 func (r *Repository) FindByID(ctx context.Context, id primitive.ObjectID) (database.Link, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 	var l database.Link
-	result := r.db.Collection(collection).FindOne(ctx, bson.M{"_id": id})
+	result := r.db.Collection(collection).FindOne(ctx, notDeleted(bson.M{"_id": id}))
 	if err := result.Err(); err != nil {
 		return l, fmt.Errorf("mongo FindOne: %w", err)
 	}
@@ -101,12 +382,45 @@ func (r *Repository) FindByID(ctx context.Context, id primitive.ObjectID) (datab
 	return l, nil
 }
 
+// FindByIDs возвращает ссылки в том же порядке, что и ids, пропуская отсутствующие.
+func (r *Repository) FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]database.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	cursor, err := r.db.Collection(collection).Find(ctx, notDeleted(bson.M{"_id": bson.M{"$in": ids}}))
+	if err != nil {
+		return nil, fmt.Errorf("mongo Find: %w", err)
+	}
+
+	byID := make(map[primitive.ObjectID]database.Link, len(ids))
+	for cursor.Next(ctx) {
+		var l database.Link
+		if err := cursor.Decode(&l); err != nil {
+			return nil, fmt.Errorf("mongo Decode: %w", err)
+		}
+		byID[l.ID] = l
+	}
+
+	links := make([]database.Link, 0, len(byID))
+	for _, id := range ids {
+		if l, ok := byID[id]; ok {
+			links = append(links, l)
+		}
+	}
+
+	return links, nil
+}
+
 func (r *Repository) FindByUserID(ctx context.Context, userID string) ([]database.Link, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
 	var links []database.Link
-	cursor, err := r.db.Collection(collection).Find(ctx, bson.M{"user_id": userID})
+	cursor, err := r.db.Collection(collection).Find(ctx, notDeleted(bson.M{"user_id": userID}))
 	if err != nil {
 		return nil, fmt.Errorf("mongo Find: %w", err)
 	}
@@ -121,11 +435,42 @@ func (r *Repository) FindByUserID(ctx context.Context, userID string) ([]databas
 	return links, nil
 }
 
-func (r *Repository) FindByUserAndURL(ctx context.Context, link, userID string) (database.Link, error) {
+// ForEachByUserID вызывает fn для каждой ссылки пользователя в порядке создания, читая курсор порциями.
+// Таймаут репозитория не применяется: длительность выгрузки ограничивает контекст вызывающего.
+func (r *Repository) ForEachByUserID(ctx context.Context, userID string, fn func(database.Link) error) error {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.db.Collection(collection).Find(ctx, notDeleted(bson.M{"user_id": userID}), opts)
+	if err != nil {
+		return fmt.Errorf("mongo Find: %w", err)
+	}
+	defer cursor.Close(context.WithoutCancel(ctx))
+
+	for cursor.Next(ctx) {
+		var l database.Link
+		if err := cursor.Decode(&l); err != nil {
+			return fmt.Errorf("mongo Decode: %w", err)
+		}
+
+		if err := fn(l); err != nil {
+			return err
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("mongo cursor: %w", err)
+	}
+
+	return nil
+}
+
+// FindByUserAndURL ищет ссылку пользователя по нормализованному URL, в том числе в корзине:
+// уникальный индекс не даст сохранить второй экземпляр, пока первый не очищен.
+func (r *Repository) FindByUserAndURL(ctx context.Context, normalizedURL, userID string) (database.Link, error) {
 	var l database.Link
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
-	result := r.db.Collection(collection).FindOne(ctx, bson.M{"url": link, "user_id": userID})
+	result := r.db.Collection(collection).FindOne(ctx, bson.M{"normalized_url": normalizedURL, "user_id": userID})
 	if err := result.Err(); err != nil {
 		return l, fmt.Errorf("mongo FindOne: %w", err)
 	}
@@ -147,7 +492,7 @@ func (r *Repository) FindByCriteria(ctx context.Context, criteria database.FindL
 
 	var links []database.Link
 
-	filter := bson.M{}
+	filter := notDeleted(bson.M{})
 	opts := options.Find()
 	if criteria.Limit != nil {
 		opts.SetLimit(*criteria.Limit)
@@ -155,16 +500,36 @@ func (r *Repository) FindByCriteria(ctx context.Context, criteria database.FindL
 	if criteria.Offset != nil {
 		opts.SetSkip(*criteria.Offset)
 	}
+	if criteria.NewestFirst {
+		opts.SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+	}
 	if criteria.UserID != nil {
 		filter["user_id"] = *criteria.UserID
 	}
-	if len(criteria.Tags) > 0 {
-		tagsCriteria := make([]interface{}, 0, len(criteria.Tags))
-		for _, tag := range criteria.Tags {
-			tagsCriteria = append(tagsCriteria, tag)
+	withVisibility(filter, criteria.Visibility)
+	if criteria.Read != nil {
+		// Непрочитанная ссылка не содержит read_at, а условие на null находит и отсутствующее поле
+		filter["read_at"] = bson.M{"$eq": nil}
+		if *criteria.Read {
+			filter["read_at"] = bson.M{"$ne": nil}
 		}
-
-		filter["tags"] = bson.M{"$in": tagsCriteria}
+	}
+	if criteria.Favorite != nil {
+		filter["favorite"] = flagFilter(*criteria.Favorite)
+	}
+	if criteria.Query != "" {
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(criteria.Query), Options: "i"}
+		or := bson.A{bson.M{"title": pattern}, bson.M{"description": pattern}, bson.M{"url": pattern}}
+		if len(criteria.QueryIDs) > 0 {
+			or = append(or, bson.M{"_id": bson.M{"$in": criteria.QueryIDs}})
+		}
+		filter["$or"] = or
+	}
+	if criteria.Archived != nil {
+		filter["archived"] = flagFilter(*criteria.Archived)
+	}
+	if tagsFilter := tagsFilter(criteria); len(tagsFilter) > 0 {
+		filter["$and"] = tagsFilter
 	}
 
 	cursor, err := r.db.Collection(collection).Find(ctx, filter, opts)
@@ -182,3 +547,194 @@ func (r *Repository) FindByCriteria(ctx context.Context, criteria database.FindL
 
 	return links, nil
 }
+
+// flagFilter строит условие по логическому полю, которое хранится только когда выставлено.
+func flagFilter(set bool) bson.M {
+	if set {
+		return bson.M{"$eq": true}
+	}
+
+	return bson.M{"$ne": true}
+}
+
+// UpdateState меняет состояние ссылок пользователя с указанными ID и возвращает, сколько их нашлось.
+// Повторная отметка о прочтении не меняет read_at, поэтому в нем остается время первого прочтения.
+func (r *Repository) UpdateState(
+	ctx context.Context, userID string, ids []primitive.ObjectID, change database.LinkStateChange,
+) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	set := bson.M{}
+	if change.Read != nil {
+		set["read_at"] = "$$REMOVE"
+		if *change.Read {
+			set["read_at"] = bson.M{"$ifNull": bson.A{"$read_at", time.Now()}}
+		}
+	}
+	if change.Favorite != nil {
+		set["favorite"] = flagValue(*change.Favorite)
+	}
+	if change.Archived != nil {
+		set["archived"] = flagValue(*change.Archived)
+	}
+
+	// Обновление конвейером позволяет сослаться на текущее значение read_at
+	update := mongo.Pipeline{{{Key: "$set", Value: set}}}
+	filter := notDeleted(bson.M{"_id": bson.M{"$in": ids}, "user_id": userID})
+
+	result, err := r.db.Collection(collection).UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return result.MatchedCount, nil
+}
+
+// flagValue возвращает значение логического поля: снятый флаг удаляется, как и у новых ссылок.
+func flagValue(set bool) any {
+	if set {
+		return true
+	}
+
+	return "$$REMOVE"
+}
+
+// tagsFilter строит условия по тегам. Теги ищутся и среди пользовательских, и среди извлеченных.
+func tagsFilter(criteria database.FindLinkCriteria) bson.A {
+	var conditions bson.A
+
+	if len(criteria.Tags) > 0 {
+		switch criteria.TagsMode {
+		case database.TagsMatchAll:
+			for _, tag := range criteria.Tags {
+				conditions = append(conditions, bson.M{"$or": bson.A{bson.M{"tags": tag}, bson.M{"auto_tags": tag}}})
+			}
+		case database.TagsMatchAny:
+			conditions = append(conditions, bson.M{"$or": bson.A{
+				bson.M{"tags": bson.M{"$in": criteria.Tags}},
+				bson.M{"auto_tags": bson.M{"$in": criteria.Tags}},
+			}})
+		}
+	}
+
+	if len(criteria.ExcludeTags) > 0 {
+		conditions = append(conditions,
+			bson.M{"tags": bson.M{"$nin": criteria.ExcludeTags}},
+			bson.M{"auto_tags": bson.M{"$nin": criteria.ExcludeTags}},
+		)
+	}
+
+	return conditions
+}
+
+// TagCounts возвращает все теги пользователя с количеством ссылок, начиная с самых популярных.
+// Если visibility задан, учитываются только ссылки с этим уровнем доступа.
+func (r *Repository) TagCounts(
+	ctx context.Context, userID string, visibility *database.Visibility,
+) ([]database.TagCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: withVisibility(notDeleted(bson.M{"user_id": userID}), visibility)}},
+		{{Key: "$project", Value: bson.M{"tags": bson.M{"$setUnion": bson.A{
+			bson.M{"$ifNull": bson.A{"$tags", bson.A{}}},
+			bson.M{"$ifNull": bson.A{"$auto_tags", bson.A{}}},
+		}}}}},
+		{{Key: "$unwind", Value: "$tags"}},
+		{{Key: "$group", Value: bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+
+	cursor, err := r.db.Collection(collection).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("mongo Aggregate: %w", err)
+	}
+
+	var counts []database.TagCount
+	if err := cursor.All(ctx, &counts); err != nil {
+		return nil, fmt.Errorf("mongo cursor All: %w", err)
+	}
+
+	return counts, nil
+}
+
+// RenameTag переименовывает тег во всех ссылках пользователя и возвращает количество изменений.
+func (r *Repository) RenameTag(ctx context.Context, userID, from, to string) (int64, error) {
+	return r.MergeTags(ctx, userID, []string{from}, to)
+}
+
+// MergeTags заменяет теги from на тег to во всех ссылках пользователя и возвращает количество изменений.
+// Пользовательские и извлеченные теги обновляются независимо, поэтому одна ссылка может быть посчитана дважды.
+func (r *Repository) MergeTags(ctx context.Context, userID string, from []string, to string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	remove := make([]string, 0, len(from))
+	for _, tag := range from {
+		if tag != to {
+			remove = append(remove, tag)
+		}
+	}
+	if len(remove) == 0 {
+		return 0, nil
+	}
+
+	before, err := r.find(ctx, bson.M{
+		"user_id": userID,
+		"$or":     bson.A{bson.M{"tags": bson.M{"$in": remove}}, bson.M{"auto_tags": bson.M{"$in": remove}}},
+	}, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var modified int64
+	now := time.Now()
+
+	// Одно поле массива нельзя одновременно дополнить и очистить в одном обновлении,
+	// поэтому сначала добавляем новый тег, а затем убираем старые
+	for _, field := range []string{"tags", "auto_tags"} {
+		filter := bson.M{"user_id": userID, field: bson.M{"$in": remove}}
+
+		res, err := r.db.Collection(collection).UpdateMany(ctx, filter, bson.M{
+			"$addToSet": bson.M{field: to},
+			"$set":      bson.M{"updated_at": now},
+		})
+		if err != nil {
+			return modified, fmt.Errorf("mongo UpdateMany: %w", err)
+		}
+		modified += res.ModifiedCount
+
+		_, err = r.db.Collection(collection).UpdateMany(ctx, filter, bson.M{
+			"$pull": bson.M{field: bson.M{"$in": remove}},
+		})
+		if err != nil {
+			return modified, fmt.Errorf("mongo UpdateMany: %w", err)
+		}
+	}
+
+	return modified, r.recordTagRevisions(ctx, userID, before)
+}
+
+// DeleteTag удаляет тег из всех ссылок пользователя и возвращает количество измененных ссылок.
+func (r *Repository) DeleteTag(ctx context.Context, userID, tag string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "$or": bson.A{bson.M{"tags": tag}, bson.M{"auto_tags": tag}}}
+	before, err := r.find(ctx, filter, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := r.db.Collection(collection).UpdateMany(ctx, filter, bson.M{
+		"$pull": bson.M{"tags": tag, "auto_tags": tag},
+		"$set":  bson.M{"updated_at": time.Now()},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return res.ModifiedCount, r.recordTagRevisions(ctx, userID, before)
+}
@@ -54,7 +54,7 @@ func TestMain(m *testing.M) {
 				},
 			)
 			if err != nil {
-				log.Fatalf("mongo.Connect: %w", err)
+				log.Fatalf("mongo.Connect: %v", err)
 			}
 
 			client = linksDBConn
@@ -104,7 +104,7 @@ func TestRepository_Update(t *testing.T) {
 
 	id := primitive.NewObjectID()
 	userID := uuid.New().String()
-	_, err := linksRepo.Create(
+	created, err := linksRepo.Create(
 		ctx, database.CreateLinkReq{
 			ID:     id,
 			URL:    "https://ya.ru",
@@ -136,6 +136,173 @@ func TestRepository_Update(t *testing.T) {
 	if updated.Title != expectedTitle {
 		assert.Equal(t, updated.Title, expectedTitle)
 	}
+
+	require.WithinDuration(t, created.CreatedAt, updated.CreatedAt, time.Millisecond, "created_at must not change on update")
+
+	// Ссылку из корзины и отсутствующую ссылку изменить нельзя
+	require.NoError(t, linksRepo.SoftDelete(ctx, id))
+	_, err = linksRepo.Update(ctx, database.UpdateLinkReq{ID: id, URL: "https://ya.ru", Title: "trash", UserID: userID})
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+
+	trash, err := linksRepo.FindDeletedByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	assert.Equal(t, expectedTitle, trash[0].Title)
+
+	missing := primitive.NewObjectID()
+	_, err = linksRepo.Update(ctx, database.UpdateLinkReq{ID: missing, URL: "https://ya.ru", UserID: userID})
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+}
+
+func TestRepository_Enrich(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	id, userID := primitive.NewObjectID(), uuid.New().String()
+	_, err := linksRepo.Create(
+		ctx, database.CreateLinkReq{
+			ID:          id,
+			URL:         "https://ya.ru",
+			Title:       "ya",
+			Description: "my search",
+			Tags:        []string{"search"},
+			UserID:      userID,
+			Visibility:  database.VisibilityPublic,
+		},
+	)
+	require.NoError(t, err)
+
+	// Пользователь меняет ссылку, пока страница обрабатывается
+	_, err = linksRepo.Update(
+		ctx, database.UpdateLinkReq{
+			ID:         id,
+			URL:        "https://ya.ru",
+			Title:      "ya",
+			Tags:       []string{"search", "daily"},
+			UserID:     userID,
+			Visibility: database.VisibilityPrivate,
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = linksRepo.Enrich(
+		ctx, database.EnrichLinkReq{
+			ID:            id,
+			Title:         "Яндекс",
+			NormalizedURL: "https://ya.ru",
+			AutoTags:      []string{"portal"},
+		},
+	)
+	require.NoError(t, err)
+
+	enriched, err := linksRepo.FindByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "Яндекс", enriched.Title)
+	assert.Equal(t, []string{"portal"}, enriched.AutoTags)
+	assert.Equal(t, []string{"search", "daily"}, enriched.Tags)
+	assert.Equal(t, database.VisibilityPrivate, enriched.Visibility)
+
+	// Ссылка из корзины и отсутствующая ссылка не создаются заново
+	require.NoError(t, linksRepo.SoftDelete(ctx, id))
+	_, err = linksRepo.Enrich(ctx, database.EnrichLinkReq{ID: id, Title: "deleted"})
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+
+	missing := primitive.NewObjectID()
+	_, err = linksRepo.Enrich(ctx, database.EnrichLinkReq{ID: missing, Title: "missing"})
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+
+	_, err = linksRepo.FindByID(ctx, missing)
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+}
+
+func TestRepository_Revisions(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	id := primitive.NewObjectID()
+	userID := uuid.New().String()
+	_, err := linksRepo.Create(
+		ctx, database.CreateLinkReq{
+			ID:      id,
+			URL:     "https://ya.ru",
+			Title:   "ya",
+			Tags:    []string{"search"},
+			UserID:  userID,
+			Revisor: database.Revisor{Actor: userID, Source: database.RevisionSourceUser},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = linksRepo.Update(
+		ctx, database.UpdateLinkReq{
+			ID:       id,
+			URL:      "https://ya.ru",
+			Title:    "Яндекс",
+			Tags:     []string{"search"},
+			AutoTags: []string{"yandex"},
+			UserID:   userID,
+			Revisor:  database.Revisor{Source: database.RevisionSourceEnricher},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = linksRepo.RenameTag(ctx, userID, "search", "engines")
+	require.NoError(t, err)
+
+	revisions, err := linksRepo.FindRevisions(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+
+	assert.Equal(t, database.RevisionSourceUser, revisions[0].Source)
+	assert.Equal(t, []string{"engines"}, revisions[0].Snapshot.Tags)
+	require.Len(t, revisions[0].Changes, 1)
+	assert.Equal(t, "tags", revisions[0].Changes[0].Field)
+
+	enriched := revisions[1]
+	assert.Equal(t, database.RevisionSourceEnricher, enriched.Source)
+	assert.Equal(t, "", enriched.Actor)
+	fields := make([]string, 0, len(enriched.Changes))
+	for _, c := range enriched.Changes {
+		fields = append(fields, c.Field)
+	}
+	assert.Equal(t, []string{"title", "auto_tags"}, fields)
+
+	found, err := linksRepo.FindRevision(ctx, id, enriched.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Яндекс", found.Snapshot.Title)
+
+	// Повторное сохранение без изменений не создает ревизию
+	_, err = linksRepo.Update(
+		ctx, database.UpdateLinkReq{
+			ID:       id,
+			URL:      "https://ya.ru",
+			Title:    "Яндекс",
+			Tags:     []string{"engines"},
+			AutoTags: []string{"yandex"},
+			UserID:   userID,
+		},
+	)
+	require.NoError(t, err)
+
+	revisions, err = linksRepo.FindRevisions(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+
+	require.NoError(t, linksRepo.Delete(ctx, id))
+
+	revisions, err = linksRepo.FindRevisions(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, revisions, 0)
 }
 
 func TestRepository_FindByUserID(t *testing.T) {
@@ -215,3 +382,280 @@ func TestRepository_Delete(t *testing.T) {
 		}
 	}
 }
+
+func TestRepository_SoftDelete(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	id, userID := primitive.NewObjectID(), uuid.New().String()
+	_, err := linksRepo.Create(
+		ctx, database.CreateLinkReq{
+			ID:     id,
+			URL:    "https://ya.ru",
+			Title:  "ya",
+			UserID: userID,
+		},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, linksRepo.SoftDelete(ctx, id))
+
+	_, err = linksRepo.FindByID(ctx, id)
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+
+	byUser, err := linksRepo.FindByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Empty(t, byUser)
+
+	trash, err := linksRepo.FindDeletedByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	require.NotNil(t, trash[0].DeletedAt)
+
+	// Чужую ссылку восстановить нельзя
+	_, err = linksRepo.Restore(ctx, id, uuid.New().String())
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+
+	restored, err := linksRepo.Restore(ctx, id, userID)
+	require.NoError(t, err)
+	require.Nil(t, restored.DeletedAt)
+
+	_, err = linksRepo.FindByID(ctx, id)
+	require.NoError(t, err)
+
+	// Восстановленной ссылки уже нет в корзине
+	_, err = linksRepo.Restore(ctx, id, userID)
+	require.ErrorIs(t, err, mongo.ErrNoDocuments)
+}
+
+func TestRepository_SoftDeleteByUserID(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.New().String()
+	for _, u := range []string{"https://ya.ru", "https://go.dev"} {
+		_, err := linksRepo.Create(ctx, database.CreateLinkReq{ID: primitive.NewObjectID(), URL: u, UserID: userID})
+		require.NoError(t, err)
+	}
+
+	n, err := linksRepo.SoftDeleteByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+
+	// Повторное событие об удалении пользователя ничего не меняет
+	n, err = linksRepo.SoftDeleteByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	trash, err := linksRepo.FindDeletedByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, trash, 2)
+}
+
+func TestRepository_RestoreByUserID(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.New().String()
+	ids := make([]primitive.ObjectID, 3)
+	for i, u := range []string{"https://ya.ru", "https://go.dev", "https://gb.ru"} {
+		ids[i] = primitive.NewObjectID()
+		_, err := linksRepo.Create(ctx, database.CreateLinkReq{ID: ids[i], URL: u, UserID: userID})
+		require.NoError(t, err)
+	}
+
+	// Одну ссылку пользователь удалил сам до удаления учетной записи
+	require.NoError(t, linksRepo.SoftDelete(ctx, ids[0]))
+
+	n, err := linksRepo.SoftDeleteByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+
+	n, err = linksRepo.RestoreByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+
+	// Повторное событие о восстановлении ничего не меняет
+	n, err = linksRepo.RestoreByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	trash, err := linksRepo.FindDeletedByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	require.Equal(t, ids[0], trash[0].ID)
+
+	links, err := linksRepo.FindByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+}
+
+func TestRepository_CountByUserID(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.New().String()
+	for _, url := range []string{"https://ya.ru", "https://go.dev"} {
+		_, err := linksRepo.Create(ctx, database.CreateLinkReq{ID: primitive.NewObjectID(), URL: url, UserID: userID})
+		require.NoError(t, err)
+	}
+
+	_, err := linksRepo.SoftDeleteByUserID(ctx, userID)
+	require.NoError(t, err)
+
+	// Ссылки в корзине тоже занимают место
+	n, err := linksRepo.CountByUserID(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+}
+
+func TestRepository_TagCounts(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.New().String()
+	for _, l := range []struct {
+		url        string
+		tags       []string
+		visibility database.Visibility
+	}{
+		{"https://go.dev", []string{"go", "docs"}, database.VisibilityPublic},
+		{"https://pkg.go.dev", []string{"go"}, ""}, // сохранена до появления уровней доступа, то есть публичная
+		{"https://secret.example", []string{"go", "secret"}, database.VisibilityPrivate},
+	} {
+		_, err := linksRepo.Create(ctx, database.CreateLinkReq{
+			ID: primitive.NewObjectID(), URL: l.url, Tags: l.tags, UserID: userID, Visibility: l.visibility,
+		})
+		require.NoError(t, err)
+	}
+
+	// Владелец видит теги всех своих ссылок
+	counts, err := linksRepo.TagCounts(ctx, userID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []database.TagCount{{Tag: "go", Count: 3}, {Tag: "docs", Count: 1}, {Tag: "secret", Count: 1}}, counts)
+
+	// Остальным теги приватных ссылок не видны и не учитываются в количестве
+	public := database.VisibilityPublic
+	counts, err = linksRepo.TagCounts(ctx, userID, &public)
+	require.NoError(t, err)
+	assert.Equal(t, []database.TagCount{{Tag: "go", Count: 2}, {Tag: "docs", Count: 1}}, counts)
+}
+
+func TestRepository_FindByUserAndURL(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	require.NoError(t, linksRepo.EnsureIndexes(ctx))
+
+	userID := uuid.New().String()
+	normalizedURL := "https://ya.ru/search?text=go"
+
+	id := primitive.NewObjectID()
+	_, err := linksRepo.Create(
+		ctx, database.CreateLinkReq{
+			ID:            id,
+			URL:           "http://ya.ru/search/?text=go&utm_source=test",
+			NormalizedURL: normalizedURL,
+			Title:         "ya",
+			UserID:        userID,
+		},
+	)
+	require.NoError(t, err)
+
+	found, err := linksRepo.FindByUserAndURL(ctx, normalizedURL, userID)
+	require.NoError(t, err)
+	assert.Equal(t, found.ID, id)
+
+	_, err = linksRepo.Create(
+		ctx, database.CreateLinkReq{
+			ID:            primitive.NewObjectID(),
+			URL:           "https://ya.ru/search?text=go",
+			NormalizedURL: normalizedURL,
+			Title:         "ya",
+			UserID:        userID,
+		},
+	)
+	require.True(t, mongo.IsDuplicateKeyError(err))
+}
+
+func TestRepository_UpdateState(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	userID := uuid.New().String()
+
+	ids := make([]primitive.ObjectID, 2)
+	for i := range ids {
+		ids[i] = primitive.NewObjectID()
+		_, err := linksRepo.Create(
+			ctx, database.CreateLinkReq{
+				ID:     ids[i],
+				URL:    fmt.Sprintf("https://ya.ru/%d", i),
+				UserID: userID,
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	read, favorite := true, true
+	matched, err := linksRepo.UpdateState(ctx, userID, ids[:1], database.LinkStateChange{Read: &read, Favorite: &favorite})
+	require.NoError(t, err)
+	assert.Equal(t, matched, int64(1))
+
+	first, err := linksRepo.FindByID(ctx, ids[0])
+	require.NoError(t, err)
+	require.NotNil(t, first.ReadAt)
+	assert.Equal(t, first.Favorite, true)
+
+	// Повторная отметка не сдвигает время прочтения
+	_, err = linksRepo.UpdateState(ctx, userID, ids[:1], database.LinkStateChange{Read: &read})
+	require.NoError(t, err)
+
+	again, err := linksRepo.FindByID(ctx, ids[0])
+	require.NoError(t, err)
+	require.NotNil(t, again.ReadAt)
+	assert.Equal(t, again.ReadAt.Equal(*first.ReadAt), true)
+
+	unread, err := linksRepo.FindByCriteria(ctx, database.FindLinkCriteria{UserID: &userID, Read: new(bool)})
+	require.NoError(t, err)
+	require.Len(t, unread, 1)
+	assert.Equal(t, unread[0].ID, ids[1])
+
+	// Чужие ссылки не меняются
+	matched, err = linksRepo.UpdateState(ctx, uuid.New().String(), ids, database.LinkStateChange{Read: &read})
+	require.NoError(t, err)
+	assert.Equal(t, matched, int64(0))
+}
@@ -0,0 +1,173 @@
+package links
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const revisionsCollection = "link_revisions"
+
+// ensureRevisionIndexes создает индекс истории правок, по которому она читается для одной ссылки.
+func (r *Repository) ensureRevisionIndexes(ctx context.Context) error {
+	_, err := r.db.Collection(revisionsCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "link_id", Value: 1}, {Key: "created_at", Value: -1}},
+		Options: options.Index().SetName("link_id_created_at"),
+	})
+	if err != nil {
+		return fmt.Errorf("mongo CreateIndex: %w", err)
+	}
+
+	return nil
+}
+
+// FindRevisions возвращает историю правок ссылки, последние правки первыми.
+func (r *Repository) FindRevisions(ctx context.Context, linkID primitive.ObjectID) ([]database.LinkRevision, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+	cursor, err := r.db.Collection(revisionsCollection).Find(ctx, bson.M{"link_id": linkID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("mongo Find: %w", err)
+	}
+
+	var revisions []database.LinkRevision
+	if err := cursor.All(ctx, &revisions); err != nil {
+		return nil, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// FindRevision возвращает ревизию ссылки по ID. Если у ссылки такой ревизии нет,
+// возвращается mongo.ErrNoDocuments.
+func (r *Repository) FindRevision(
+	ctx context.Context, linkID, id primitive.ObjectID,
+) (database.LinkRevision, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var rev database.LinkRevision
+	result := r.db.Collection(revisionsCollection).FindOne(ctx, bson.M{"_id": id, "link_id": linkID})
+	if err := result.Err(); err != nil {
+		return rev, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&rev); err != nil {
+		return rev, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return rev, nil
+}
+
+// recordRevision сохраняет правку ссылки, если она изменила хотя бы одно отслеживаемое поле.
+func (r *Repository) recordRevision(
+	ctx context.Context, linkID primitive.ObjectID, before, after database.LinkFields, revisor database.Revisor,
+) error {
+	changes := diffFields(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	rev := database.LinkRevision{
+		ID:        primitive.NewObjectID(),
+		LinkID:    linkID,
+		Actor:     revisor.Actor,
+		Source:    revisor.Source,
+		Changes:   changes,
+		Snapshot:  after,
+		CreatedAt: time.Now(),
+	}
+	if _, err := r.db.Collection(revisionsCollection).InsertOne(ctx, rev); err != nil {
+		return fmt.Errorf("mongo InsertOne: %w", err)
+	}
+
+	return nil
+}
+
+// recordTagRevisions сохраняет правки ссылок, затронутых массовой операцией над тегами пользователя.
+func (r *Repository) recordTagRevisions(ctx context.Context, userID string, before []database.Link) error {
+	if len(before) == 0 {
+		return nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(before))
+	for _, l := range before {
+		ids = append(ids, l.ID)
+	}
+
+	after, err := r.find(ctx, bson.M{"_id": bson.M{"$in": ids}}, nil)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[primitive.ObjectID]database.Link, len(after))
+	for _, l := range after {
+		byID[l.ID] = l
+	}
+
+	revisor := database.Revisor{Actor: userID, Source: database.RevisionSourceUser}
+	for _, l := range before {
+		cur, ok := byID[l.ID]
+		if !ok {
+			continue
+		}
+		if err := r.recordRevision(ctx, l.ID, l.Fields(), cur.Fields(), revisor); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteRevisions удаляет историю правок ссылки.
+func (r *Repository) deleteRevisions(ctx context.Context, linkID primitive.ObjectID) error {
+	if _, err := r.db.Collection(revisionsCollection).DeleteMany(ctx, bson.M{"link_id": linkID}); err != nil {
+		return fmt.Errorf("mongo DeleteMany: %w", err)
+	}
+
+	return nil
+}
+
+// diffFields возвращает изменения полей ссылки. Пустой и отсутствующий список считаются равными.
+func diffFields(before, after database.LinkFields) []database.FieldChange {
+	var changes []database.FieldChange
+
+	addString := func(field, old, cur string) {
+		if old != cur {
+			changes = append(changes, database.FieldChange{Field: field, Old: old, New: cur})
+		}
+	}
+	addList := func(field string, old, cur []string) {
+		if !slices.Equal(old, cur) {
+			changes = append(changes, database.FieldChange{Field: field, Old: nonNil(old), New: nonNil(cur)})
+		}
+	}
+
+	addString("title", before.Title, after.Title)
+	addString("description", before.Description, after.Description)
+	addString("url", before.URL, after.URL)
+	addList("images", before.Images, after.Images)
+	addList("tags", before.Tags, after.Tags)
+	addList("auto_tags", before.AutoTags, after.AutoTags)
+	addString("visibility", string(before.Visibility), string(after.Visibility))
+
+	return changes
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+
+	return s
+}
@@ -0,0 +1,21 @@
+package database
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Note - заметка пользователя к ссылке. Заметка с цитатой - это выделение в извлеченном тексте статьи,
+// Start и End задают его границы в символах LinkContent.Text на момент создания.
+type Note struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	LinkID    primitive.ObjectID `bson:"link_id"`
+	UserID    string             `bson:"user_id"`
+	Body      string             `bson:"body"` // markdown
+	Quote     string             `bson:"quote,omitempty"`
+	Start     *int               `bson:"start,omitempty"`
+	End       *int               `bson:"end,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
@@ -0,0 +1,164 @@
+package notes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const collection = "link_notes"
+
+func New(db *mongo.Database, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *mongo.Database
+	timeout time.Duration
+}
+
+// EnsureIndexes создает индексы для списка заметок ссылки и поиска по заметкам пользователя.
+func (r *Repository) EnsureIndexes(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	_, err := r.db.Collection(collection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "link_id", Value: 1}, {Key: "created_at", Value: 1}}},
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+	})
+	if err != nil {
+		return fmt.Errorf("mongo CreateIndexes: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Create(ctx context.Context, n database.Note) (database.Note, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	now := time.Now()
+	if n.ID.IsZero() {
+		n.ID = primitive.NewObjectID()
+	}
+	n.CreatedAt, n.UpdatedAt = now, now
+
+	if _, err := r.db.Collection(collection).InsertOne(ctx, n); err != nil {
+		return n, fmt.Errorf("mongo InsertOne: %w", err)
+	}
+
+	return n, nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, id primitive.ObjectID) (database.Note, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var n database.Note
+	result := r.db.Collection(collection).FindOne(ctx, bson.M{"_id": id})
+	if err := result.Err(); err != nil {
+		return n, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&n); err != nil {
+		return n, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return n, nil
+}
+
+// FindByUserID возвращает все заметки пользователя в порядке создания.
+func (r *Repository) FindByUserID(ctx context.Context, userID string) ([]database.Note, error) {
+	return r.find(ctx, bson.M{"user_id": userID})
+}
+
+// FindByLinkID возвращает заметки ссылки в порядке создания.
+func (r *Repository) FindByLinkID(ctx context.Context, linkID primitive.ObjectID) ([]database.Note, error) {
+	return r.find(ctx, bson.M{"link_id": linkID})
+}
+
+// FindByText ищет заметки пользователя, в тексте или цитате которых встречается query без учета регистра.
+// Если linkIDs не пусто, поиск ограничивается заметками этих ссылок.
+func (r *Repository) FindByText(
+	ctx context.Context, userID, query string, linkIDs []primitive.ObjectID,
+) ([]database.Note, error) {
+	pattern := primitive.Regex{Pattern: regexp.QuoteMeta(query), Options: "i"}
+	filter := bson.M{
+		"user_id": userID,
+		"$or":     bson.A{bson.M{"body": pattern}, bson.M{"quote": pattern}},
+	}
+	if len(linkIDs) > 0 {
+		filter["link_id"] = bson.M{"$in": linkIDs}
+	}
+
+	return r.find(ctx, filter)
+}
+
+// Update меняет текст заметки. Границы выделения не меняются: они привязаны к тексту статьи.
+func (r *Repository) Update(ctx context.Context, id primitive.ObjectID, body string) (database.Note, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"body": body, "updated_at": time.Now()}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var n database.Note
+	result := r.db.Collection(collection).FindOneAndUpdate(ctx, bson.M{"_id": id}, update, opts)
+	if err := result.Err(); err != nil {
+		return n, fmt.Errorf("mongo FindOneAndUpdate: %w", err)
+	}
+
+	if err := result.Decode(&n); err != nil {
+		return n, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return n, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if _, err := r.db.Collection(collection).DeleteOne(ctx, bson.M{"_id": id}); err != nil {
+		return fmt.Errorf("mongo DeleteOne: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteByLinkID(ctx context.Context, linkID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if _, err := r.db.Collection(collection).DeleteMany(ctx, bson.M{"link_id": linkID}); err != nil {
+		return fmt.Errorf("mongo DeleteMany: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) find(ctx context.Context, filter bson.M) ([]database.Note, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.db.Collection(collection).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("mongo Find: %w", err)
+	}
+
+	var notes []database.Note
+	if err := cursor.All(ctx, &notes); err != nil {
+		return nil, fmt.Errorf("mongo cursor All: %w", err)
+	}
+
+	return notes, nil
+}
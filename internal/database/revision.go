@@ -0,0 +1,65 @@
+package database
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RevisionSource определяет, кто внес правку в ссылку.
+type RevisionSource string
+
+const (
+	RevisionSourceUser     RevisionSource = "user"     // пользователь через API
+	RevisionSourceEnricher RevisionSource = "enricher" // обогащение метаданными страницы
+	RevisionSourceImport   RevisionSource = "import"   // импорт закладок
+)
+
+// LinkFields - редактируемые поля ссылки, по которым ведется история правок.
+type LinkFields struct {
+	Title       string     `bson:"title"`
+	Description string     `bson:"description"`
+	URL         string     `bson:"url"`
+	Images      []string   `bson:"images"`
+	Tags        []string   `bson:"tags"`
+	AutoTags    []string   `bson:"auto_tags"`
+	Visibility  Visibility `bson:"visibility"`
+}
+
+// Fields возвращает редактируемые поля ссылки.
+func (l Link) Fields() LinkFields {
+	return LinkFields{
+		Title:       l.Title,
+		Description: l.Description,
+		URL:         l.URL,
+		Images:      l.Images,
+		Tags:        l.Tags,
+		AutoTags:    l.AutoTags,
+		Visibility:  l.Visibility,
+	}
+}
+
+// FieldChange - изменение одного поля ссылки. Old и New хранят строку или список строк.
+type FieldChange struct {
+	Field string `bson:"field"`
+	Old   any    `bson:"old"`
+	New   any    `bson:"new"`
+}
+
+// LinkRevision - запись истории правок ссылки. Snapshot хранит поля после правки,
+// по нему ссылку можно вернуть к этой ревизии.
+type LinkRevision struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	LinkID    primitive.ObjectID `bson:"link_id"`
+	Actor     string             `bson:"actor,omitempty"` // ID пользователя, пусто для обогащения
+	Source    RevisionSource     `bson:"source"`
+	Changes   []FieldChange      `bson:"changes"`
+	Snapshot  LinkFields         `bson:"snapshot"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// Revisor описывает автора правки ссылки.
+type Revisor struct {
+	Actor  string
+	Source RevisionSource
+}
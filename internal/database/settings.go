@@ -0,0 +1,16 @@
+package database
+
+import "time"
+
+// LinkSettings хранит настройки пользователя, которые links-srv применяет к его ссылкам.
+type LinkSettings struct {
+	UserID            string     `bson:"_id"`
+	DefaultVisibility Visibility `bson:"default_visibility"`
+	UpdatedAt         time.Time  `bson:"updated_at"`
+}
+
+// DefaultLinkSettings - настройки пользователя, который их еще не менял. Новые ссылки по умолчанию публичные,
+// как и до появления уровней доступа, а скрывать их по умолчанию пользователь включает сам.
+func DefaultLinkSettings(userID string) LinkSettings {
+	return LinkSettings{UserID: userID, DefaultVisibility: VisibilityPublic}
+}
@@ -0,0 +1,60 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const collection = "link_settings"
+
+func New(db *mongo.Database, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *mongo.Database
+	timeout time.Duration
+}
+
+// Get возвращает настройки пользователя или настройки по умолчанию, если он их не сохранял.
+func (r *Repository) Get(ctx context.Context, userID string) (database.LinkSettings, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var s database.LinkSettings
+	result := r.db.Collection(collection).FindOne(ctx, bson.M{"_id": userID})
+	if err := result.Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return database.DefaultLinkSettings(userID), nil
+		}
+		return s, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&s); err != nil {
+		return s, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return s, nil
+}
+
+func (r *Repository) Upsert(ctx context.Context, s database.LinkSettings) (database.LinkSettings, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	s.UpdatedAt = time.Now()
+	opts := options.Replace().SetUpsert(true)
+
+	if _, err := r.db.Collection(collection).ReplaceOne(ctx, bson.M{"_id": s.UserID}, s, opts); err != nil {
+		return s, fmt.Errorf("mongo ReplaceOne: %w", err)
+	}
+
+	return s, nil
+}
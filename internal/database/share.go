@@ -0,0 +1,30 @@
+package database
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Share дает доступ на чтение к коллекции или ссылке по случайному токену без учетной записи.
+// Ровно одно из полей CollectionID и LinkID заполнено.
+type Share struct {
+	ID           primitive.ObjectID  `bson:"_id"`
+	Token        string              `bson:"token"`
+	UserID       string              `bson:"user_id"`
+	CollectionID *primitive.ObjectID `bson:"collection_id,omitempty"`
+	LinkID       *primitive.ObjectID `bson:"link_id,omitempty"`
+	PasswordHash string              `bson:"password_hash,omitempty"`
+	ExpiresAt    *time.Time          `bson:"expires_at,omitempty"`
+	RevokedAt    *time.Time          `bson:"revoked_at,omitempty"`
+	// RevokedWithUser - ссылку доступа отозвала очистка данных удаленного пользователя,
+	// при его восстановлении она снова действует
+	RevokedWithUser bool      `bson:"revoked_with_user,omitempty"`
+	Views           int64     `bson:"views"`
+	CreatedAt       time.Time `bson:"created_at"`
+}
+
+// Active сообщает, можно ли открыть ссылку доступа в момент now.
+func (s Share) Active(now time.Time) bool {
+	return s.RevokedAt == nil && (s.ExpiresAt == nil || now.Before(*s.ExpiresAt))
+}
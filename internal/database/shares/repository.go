@@ -0,0 +1,155 @@
+package shares
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const collection = "shares"
+
+func New(db *mongo.Database, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *mongo.Database
+	timeout time.Duration
+}
+
+func (r *Repository) Create(ctx context.Context, s database.Share) (database.Share, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	s.CreatedAt = time.Now()
+	if _, err := r.db.Collection(collection).InsertOne(ctx, s); err != nil {
+		return s, fmt.Errorf("mongo InsertOne: %w", err)
+	}
+
+	return s, nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, id primitive.ObjectID) (database.Share, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *Repository) FindByToken(ctx context.Context, token string) (database.Share, error) {
+	return r.findOne(ctx, bson.M{"token": token})
+}
+
+// FindByUserID возвращает ссылки доступа пользователя, новые первыми.
+func (r *Repository) FindByUserID(ctx context.Context, userID string) ([]database.Share, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.db.Collection(collection).Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("mongo Find: %w", err)
+	}
+
+	shares := make([]database.Share, 0)
+	if err = cursor.All(ctx, &shares); err != nil {
+		return nil, fmt.Errorf("mongo All: %w", err)
+	}
+
+	return shares, nil
+}
+
+// Revoke отзывает ссылку доступа. Повторный отзыв сохраняет дату первого.
+func (r *Repository) Revoke(ctx context.Context, id primitive.ObjectID) (database.Share, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	filter := bson.M{"_id": id, "revoked_at": bson.M{"$exists": false}}
+	if _, err := r.db.Collection(collection).UpdateOne(ctx, filter, bson.M{"$set": bson.M{"revoked_at": time.Now()}}); err != nil {
+		return database.Share{}, fmt.Errorf("mongo UpdateOne: %w", err)
+	}
+
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// RevokeByUserID отзывает все действующие ссылки доступа удаленного пользователя и возвращает, сколько их было.
+// Ссылки помечаются, чтобы RestoreByUserID вернул только их, а не отозванные самим пользователем.
+func (r *Repository) RevokeByUserID(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "revoked_at": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"revoked_at": time.Now(), "revoked_with_user": true}}
+	res, err := r.db.Collection(collection).UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// RestoreByUserID снова открывает ссылки доступа, отозванные RevokeByUserID, и возвращает, сколько их было.
+func (r *Repository) RestoreByUserID(ctx context.Context, userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "revoked_with_user": true}
+	update := bson.M{"$unset": bson.M{"revoked_at": "", "revoked_with_user": ""}}
+	res, err := r.db.Collection(collection).UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// IncrementViews увеличивает счетчик просмотров и возвращает его новое значение.
+func (r *Repository) IncrementViews(ctx context.Context, id primitive.ObjectID) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var s database.Share
+	result := r.db.Collection(collection).FindOneAndUpdate(ctx, bson.M{"_id": id}, bson.M{"$inc": bson.M{"views": 1}}, opts)
+	if err := result.Decode(&s); err != nil {
+		return 0, fmt.Errorf("mongo FindOneAndUpdate: %w", err)
+	}
+
+	return s.Views, nil
+}
+
+// DeleteByTarget удаляет ссылки доступа к удаленной коллекции или ссылке.
+func (r *Repository) DeleteByTarget(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	filter := bson.M{"$or": bson.A{bson.M{"collection_id": id}, bson.M{"link_id": id}}}
+	if _, err := r.db.Collection(collection).DeleteMany(ctx, filter); err != nil {
+		return fmt.Errorf("mongo DeleteMany: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) findOne(ctx context.Context, filter bson.M) (database.Share, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var s database.Share
+	result := r.db.Collection(collection).FindOne(ctx, filter)
+	if err := result.Err(); err != nil {
+		return s, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&s); err != nil {
+		return s, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return s, nil
+}
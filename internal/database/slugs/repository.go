@@ -0,0 +1,75 @@
+package slugs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const collection = "slugs"
+
+func New(db *mongo.Database, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *mongo.Database
+	timeout time.Duration
+}
+
+// Create сохраняет slug. Если он уже занят, возвращается ошибка дубликата ключа mongo.
+func (r *Repository) Create(ctx context.Context, s database.Slug) (database.Slug, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	s.CreatedAt = time.Now()
+	if _, err := r.db.Collection(collection).InsertOne(ctx, s); err != nil {
+		return s, fmt.Errorf("mongo InsertOne: %w", err)
+	}
+
+	return s, nil
+}
+
+func (r *Repository) FindBySlug(ctx context.Context, slug string) (database.Slug, error) {
+	return r.findOne(ctx, bson.M{"_id": slug})
+}
+
+func (r *Repository) FindByLinkID(ctx context.Context, linkID primitive.ObjectID) (database.Slug, error) {
+	return r.findOne(ctx, bson.M{"link_id": linkID})
+}
+
+// DeleteByLinkID освобождает slug ссылки, кроме except, чтобы при замене не удалить только что созданный.
+func (r *Repository) DeleteByLinkID(ctx context.Context, linkID primitive.ObjectID, except string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	filter := bson.M{"link_id": linkID, "_id": bson.M{"$ne": except}}
+	if _, err := r.db.Collection(collection).DeleteMany(ctx, filter); err != nil {
+		return fmt.Errorf("mongo DeleteMany: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) findOne(ctx context.Context, filter bson.M) (database.Slug, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var s database.Slug
+	result := r.db.Collection(collection).FindOne(ctx, filter)
+	if err := result.Err(); err != nil {
+		return s, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&s); err != nil {
+		return s, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return s, nil
+}
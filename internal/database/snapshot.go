@@ -0,0 +1,18 @@
+package database
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Snapshot описывает сохраненную копию страницы. Само содержимое лежит в хранилище блобов по SHA256.
+type Snapshot struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	LinkID      primitive.ObjectID `bson:"link_id"`
+	UserID      string             `bson:"user_id"`
+	SHA256      string             `bson:"sha256"`
+	Size        int64              `bson:"size"`
+	ContentType string             `bson:"content_type"`
+	CreatedAt   time.Time          `bson:"created_at"`
+}
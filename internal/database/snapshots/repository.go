@@ -0,0 +1,113 @@
+package snapshots
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const collection = "snapshots"
+
+func New(db *mongo.Database, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *mongo.Database
+	timeout time.Duration
+}
+
+func (r *Repository) Create(ctx context.Context, s database.Snapshot) (database.Snapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if s.ID.IsZero() {
+		s.ID = primitive.NewObjectID()
+	}
+	if s.CreatedAt.IsZero() {
+		s.CreatedAt = time.Now()
+	}
+
+	if _, err := r.db.Collection(collection).InsertOne(ctx, s); err != nil {
+		return s, fmt.Errorf("mongo InsertOne: %w", err)
+	}
+
+	return s, nil
+}
+
+// FindLatestByLinkID возвращает самый свежий снимок ссылки.
+func (r *Repository) FindLatestByLinkID(ctx context.Context, linkID primitive.ObjectID) (database.Snapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var s database.Snapshot
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	result := r.db.Collection(collection).FindOne(ctx, bson.M{"link_id": linkID}, opts)
+	if err := result.Err(); err != nil {
+		return s, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&s); err != nil {
+		return s, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return s, nil
+}
+
+func (r *Repository) FindByLinkIDAndSHA(ctx context.Context, linkID primitive.ObjectID, sha string) (database.Snapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var s database.Snapshot
+	result := r.db.Collection(collection).FindOne(ctx, bson.M{"link_id": linkID, "sha256": sha})
+	if err := result.Err(); err != nil {
+		return s, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&s); err != nil {
+		return s, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return s, nil
+}
+
+// FindByLinkID возвращает снимки ссылки, начиная с самого нового.
+func (r *Repository) FindByLinkID(ctx context.Context, linkID primitive.ObjectID) ([]database.Snapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var snapshots []database.Snapshot
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.db.Collection(collection).Find(ctx, bson.M{"link_id": linkID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("mongo Find: %w", err)
+	}
+
+	for cursor.Next(ctx) {
+		var s database.Snapshot
+		if err := cursor.Decode(&s); err != nil {
+			return nil, fmt.Errorf("mongo Decode: %w", err)
+		}
+		snapshots = append(snapshots, s)
+	}
+
+	return snapshots, nil
+}
+
+func (r *Repository) DeleteByLinkID(ctx context.Context, linkID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	if _, err := r.db.Collection(collection).DeleteMany(ctx, bson.M{"link_id": linkID}); err != nil {
+		return fmt.Errorf("mongo DeleteMany: %w", err)
+	}
+
+	return nil
+}
@@ -7,11 +7,12 @@ import (
 )
 
 type User struct {
-	ID        uuid.UUID `db:"id"`
-	Username  string    `db:"username"`
-	Password  string    `db:"password"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID        uuid.UUID  `db:"id"`
+	Username  string     `db:"username"`
+	Password  string     `db:"password"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"` // когда пользователя удалили, до очистки его можно восстановить
 }
 
 type CreateUserReq struct {
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ExportStatus string
+
+const (
+	ExportPending ExportStatus = "pending"
+	ExportRunning ExportStatus = "running"
+	ExportDone    ExportStatus = "done"
+	ExportFailed  ExportStatus = "failed"
+)
+
+// UserExport - выгрузка всех данных пользователя по запросу субъекта данных. Готовый ZIP-архив
+// лежит в хранилище блобов, запись пользователя из users-srv передается при создании задания.
+type UserExport struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	UserID     string             `bson:"user_id"`
+	Profile    ExportedUser       `bson:"profile"`
+	Status     ExportStatus       `bson:"status"`
+	BlobSHA256 string             `bson:"blob_sha256,omitempty"`
+	Size       int64              `bson:"size,omitempty"`
+	Error      string             `bson:"error,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	UpdatedAt  time.Time          `bson:"updated_at"`
+	FinishedAt *time.Time         `bson:"finished_at,omitempty"`
+}
+
+// ExportedUser - запись пользователя в выгрузке. Пароль в выгрузку не попадает.
+type ExportedUser struct {
+	ID        string `bson:"id" json:"id"`
+	Username  string `bson:"username" json:"username"`
+	CreatedAt string `bson:"created_at" json:"created_at"`
+	UpdatedAt string `bson:"updated_at" json:"updated_at"`
+}
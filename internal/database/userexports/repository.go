@@ -0,0 +1,122 @@
+package userexports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+const collection = "user_exports"
+
+func New(db *mongo.Database, timeout time.Duration) *Repository {
+	return &Repository{db: db, timeout: timeout}
+}
+
+type Repository struct {
+	db      *mongo.Database
+	timeout time.Duration
+}
+
+func (r *Repository) Create(ctx context.Context, job database.UserExport) (database.UserExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	now := time.Now()
+	job.Status = database.ExportPending
+	job.CreatedAt = now
+	job.UpdatedAt = now
+
+	if _, err := r.db.Collection(collection).InsertOne(ctx, job); err != nil {
+		return job, fmt.Errorf("mongo InsertOne: %w", err)
+	}
+
+	return job, nil
+}
+
+// FindLatestByUserID возвращает последнюю выгрузку пользователя.
+func (r *Repository) FindLatestByUserID(ctx context.Context, userID string) (database.UserExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	var job database.UserExport
+	result := r.db.Collection(collection).FindOne(ctx, bson.M{"user_id": userID}, opts)
+	if err := result.Err(); err != nil {
+		return job, fmt.Errorf("mongo FindOne: %w", err)
+	}
+
+	if err := result.Decode(&job); err != nil {
+		return job, fmt.Errorf("mongo Decode: %w", err)
+	}
+
+	return job, nil
+}
+
+// ClaimPending переводит самую старую ожидающую выгрузку в работу и возвращает ее.
+// Если выгрузок нет, возвращается mongo.ErrNoDocuments.
+func (r *Repository) ClaimPending(ctx context.Context) (database.UserExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var job database.UserExport
+	err := r.db.Collection(collection).FindOneAndUpdate(
+		ctx,
+		bson.M{"status": database.ExportPending},
+		bson.M{"$set": bson.M{"status": database.ExportRunning, "updated_at": time.Now()}},
+		opts,
+	).Decode(&job)
+	if err != nil {
+		return job, fmt.Errorf("mongo FindOneAndUpdate: %w", err)
+	}
+
+	return job, nil
+}
+
+// ResetRunning возвращает в очередь выгрузки, прерванные остановкой сервиса.
+func (r *Repository) ResetRunning(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	_, err := r.db.Collection(collection).UpdateMany(
+		ctx,
+		bson.M{"status": database.ExportRunning},
+		bson.M{"$set": bson.M{"status": database.ExportPending, "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return fmt.Errorf("mongo UpdateMany: %w", err)
+	}
+
+	return nil
+}
+
+// Finish сохраняет результат выгрузки: архив или ошибку.
+func (r *Repository) Finish(ctx context.Context, job database.UserExport) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	set := bson.M{
+		"status":      job.Status,
+		"blob_sha256": job.BlobSHA256,
+		"size":        job.Size,
+		"error":       job.Error,
+		"updated_at":  time.Now(),
+		"finished_at": job.FinishedAt,
+	}
+
+	if _, err := r.db.Collection(collection).UpdateOne(ctx, bson.M{"_id": job.ID}, bson.M{"$set": set}); err != nil {
+		return fmt.Errorf("mongo UpdateOne: %w", err)
+	}
+
+	return nil
+}
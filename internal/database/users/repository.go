@@ -6,11 +6,15 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
 )
 
+// userColumns перечисляет колонки в порядке, в котором их читает scanUser.
+const userColumns = `id, username, password, created_at, updated_at, deleted_at`
+
 func New(userDB *pgxpool.Pool, timeout time.Duration) *Repository {
 	return &Repository{db: userDB, timeout: timeout}
 }
@@ -39,6 +43,7 @@ func (r *Repository) Create(ctx context.Context, req database.CreateUserReq) (da
 		VALUES ($1, $2, $3, $4, $5)
 		ON CONFLICT (id) DO UPDATE
 		SET username = $2, password = $3, updated_at = $5
+		WHERE users.deleted_at IS NULL
 	`
 	if _, err := r.db.Exec(ctx, query, u.ID, u.Username, u.Password, now, now); err != nil {
 		return u, fmt.Errorf("postgres Exec: %w", err)
@@ -47,27 +52,56 @@ func (r *Repository) Create(ctx context.Context, req database.CreateUserReq) (da
 	return u, nil
 }
 
+// DeleteByUserID помечает пользователя удаленным. До очистки, см. Purge, его можно восстановить.
 func (r *Repository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	query := `DELETE FROM users WHERE id=$1`
+	query := `UPDATE users SET deleted_at = NOW() WHERE id=$1 AND deleted_at IS NULL`
 	if _, err := r.db.Exec(ctx, query, userID); err != nil {
 		return fmt.Errorf("postgres Exec: %w", err)
 	}
 	return nil
 }
 
-func (r *Repository) FindByID(ctx context.Context, userID uuid.UUID) (database.User, error) {
-	var u database.User
+// Restore снимает с пользователя отметку об удалении. Если удаленного пользователя нет, возвращается pgx.ErrNoRows.
+func (r *Repository) Restore(ctx context.Context, userID uuid.UUID) (database.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `
+		UPDATE users SET deleted_at = NULL, updated_at = NOW()
+		WHERE id=$1 AND deleted_at IS NOT NULL
+		RETURNING ` + userColumns
+
+	u, err := scanUser(r.db.QueryRow(ctx, query, userID))
+	if err != nil {
+		return u, fmt.Errorf("postgres QueryRow Decode: %w", err)
+	}
+
+	return u, nil
+}
 
+// Purge безвозвратно удаляет пользователей, удаленных раньше before, и возвращает их количество.
+func (r *Repository) Purge(ctx context.Context, before time.Time) (int64, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	if err := r.db.QueryRow(ctx, `SELECT * FROM users WHERE id=$1`, userID).Scan(
-		&u.ID, &u.Username,
-		&u.Password, &u.CreatedAt, &u.UpdatedAt,
-	); err != nil {
+	tag, err := r.db.Exec(ctx, `DELETE FROM users WHERE deleted_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("postgres Exec: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, userID uuid.UUID) (database.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := `SELECT ` + userColumns + ` FROM users WHERE id=$1 AND deleted_at IS NULL`
+	u, err := scanUser(r.db.QueryRow(ctx, query, userID))
+	if err != nil {
 		return u, fmt.Errorf("postgres QueryRow Decode: %w", err)
 	}
 
@@ -75,22 +109,28 @@ func (r *Repository) FindByID(ctx context.Context, userID uuid.UUID) (database.U
 }
 
 func (r *Repository) FindAll(ctx context.Context) ([]database.User, error) {
+	return r.findMany(ctx, `SELECT `+userColumns+` FROM users WHERE deleted_at IS NULL`)
+}
+
+// FindDeleted возвращает удаленных, но еще не очищенных пользователей, недавно удаленных первыми.
+func (r *Repository) FindDeleted(ctx context.Context) ([]database.User, error) {
+	return r.findMany(ctx, `SELECT `+userColumns+` FROM users WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC`)
+}
+
+func (r *Repository) findMany(ctx context.Context, query string, args ...any) ([]database.User, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
 	var users []database.User
 
-	query := `SELECT id, username, password, created_at, updated_at FROM users`
-
-	rows, err := r.db.Query(ctx, query)
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("postgres Query: %w", err)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var user database.User
-		err := rows.Scan(&user.ID, &user.Username, &user.Password, &user.CreatedAt, &user.UpdatedAt)
+		user, err := scanUser(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
@@ -105,17 +145,20 @@ func (r *Repository) FindAll(ctx context.Context) ([]database.User, error) {
 }
 
 func (r *Repository) FindByUsername(ctx context.Context, username string) (database.User, error) {
-	var u database.User
-
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
 
-	if err := r.db.QueryRow(ctx, `SELECT * FROM users WHERE username=$1`, username).Scan(
-		&u.ID, &u.Username,
-		&u.Password, &u.CreatedAt, &u.UpdatedAt,
-	); err != nil {
+	query := `SELECT ` + userColumns + ` FROM users WHERE username=$1 AND deleted_at IS NULL`
+	u, err := scanUser(r.db.QueryRow(ctx, query, username))
+	if err != nil {
 		return u, fmt.Errorf("postgres QueryRow Decode: %w", err)
 	}
 
 	return u, nil
 }
+
+func scanUser(row pgx.Row) (database.User, error) {
+	var u database.User
+	err := row.Scan(&u.ID, &u.Username, &u.Password, &u.CreatedAt, &u.UpdatedAt, &u.DeletedAt)
+	return u, err
+}
@@ -192,3 +192,76 @@ func TestRepository_DeleteByUserID(t *testing.T) {
 		}
 	}
 }
+
+func TestRepository_Restore(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	u, err := generateUser()
+	require.NoError(t, err)
+
+	_, err = usersRepo.Create(ctx, u)
+	require.NoError(t, err)
+
+	require.NoError(t, usersRepo.DeleteByUserID(ctx, u.ID))
+
+	deleted, err := usersRepo.FindDeleted(ctx)
+	require.NoError(t, err)
+	require.Contains(t, userIDs(deleted), u.ID)
+
+	restored, err := usersRepo.Restore(ctx, u.ID)
+	require.NoError(t, err)
+	require.Nil(t, restored.DeletedAt)
+
+	_, err = usersRepo.FindByID(ctx, u.ID)
+	require.NoError(t, err)
+
+	_, err = usersRepo.Restore(ctx, u.ID)
+	require.ErrorIs(t, err, pgx.ErrNoRows)
+}
+
+// TestRepository_Purge не запускается параллельно: очистка затронула бы пользователей других тестов.
+func TestRepository_Purge(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+
+	u, err := generateUser()
+	require.NoError(t, err)
+
+	_, err = usersRepo.Create(ctx, u)
+	require.NoError(t, err)
+
+	require.NoError(t, usersRepo.DeleteByUserID(ctx, u.ID))
+
+	// Срок хранения еще не истек
+	_, err = usersRepo.Purge(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	deleted, err := usersRepo.FindDeleted(ctx)
+	require.NoError(t, err)
+	require.Contains(t, userIDs(deleted), u.ID)
+
+	purged, err := usersRepo.Purge(ctx, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, purged, int64(1))
+
+	deleted, err = usersRepo.FindDeleted(ctx)
+	require.NoError(t, err)
+	require.NotContains(t, userIDs(deleted), u.ID)
+}
+
+func userIDs(users []database.User) []uuid.UUID {
+	ids := make([]uuid.UUID, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	return ids
+}
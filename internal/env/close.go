@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgx/v4/pgxpool"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
 )
 
 type Closer struct {
@@ -14,14 +15,24 @@ type Closer struct {
 	linksDBConn *mongo.Client
 	amqpConn    *amqp.Connection
 	amqpChannel *amqp.Channel
+	grpcConns   []*grpc.ClientConn
 }
 
-func NewCloser(usersDBConn *pgxpool.Pool, linksDBConn *mongo.Client, amqpConn *amqp.Connection, amqpChannel *amqp.Channel) *Closer {
+// NewCloser собирает подключения, которые закрываются при остановке. grpcConns - подключения к сервисам
+// gRPC, они закрываются первыми.
+func NewCloser(
+	usersDBConn *pgxpool.Pool,
+	linksDBConn *mongo.Client,
+	amqpConn *amqp.Connection,
+	amqpChannel *amqp.Channel,
+	grpcConns ...*grpc.ClientConn,
+) *Closer {
 	return &Closer{
 		usersDBConn: usersDBConn,
 		linksDBConn: linksDBConn,
 		amqpConn:    amqpConn,
 		amqpChannel: amqpChannel,
+		grpcConns:   grpcConns,
 	}
 }
 
@@ -35,4 +46,10 @@ func (c *Closer) Close(ctx context.Context) {
 		}
 	}()
 	defer c.usersDBConn.Close()
+
+	for _, conn := range c.grpcConns {
+		if err := conn.Close(); err != nil {
+			slog.Error("closing", slog.Any("err", err))
+		}
+	}
 }
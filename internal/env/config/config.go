@@ -19,6 +19,11 @@ type AMQPConfig struct {
 	Host      string `env:"HOST,default=localhost"`
 	Port      int16  `env:"PORT,default=5672"`
 	QueueName string `env:"QNAME,default=final-queue"`
+	// UserEventsQueueName - очередь событий users-srv, например об удалении пользователя
+	UserEventsQueueName string `env:"USER_EVENTS_QNAME,default=user-events"`
+	// UserEventsDeadQueueName - очередь событий пользователей, которые не удалось обработать за UserEventsMaxAttempts попыток
+	UserEventsDeadQueueName string `env:"USER_EVENTS_DEAD_QNAME,default=user-events.dead"`
+	UserEventsMaxAttempts   int    `env:"USER_EVENTS_MAX_ATTEMPTS,default=5"`
 }
 
 func (a AMQPConfig) String() string {
@@ -29,6 +34,63 @@ type LinksService struct {
 	Mongo      MongoConfig     `env:",prefix=DB_"`
 	GRPCServer LinksGRPCConfig `env:",prefix=GRPC_"`
 	AMQP       AMQPConfig      `env:",prefix=AMQP_"`
+	Snapshots  SnapshotsConfig `env:",prefix=SNAPSHOTS_"`
+	Tags       TagsConfig      `env:",prefix=TAGS_"`
+	Shortener  ShortenerConfig `env:",prefix=SHORTENER_"`
+	URLs       URLsConfig      `env:",prefix=URLS_"`
+	Import     ImportConfig    `env:",prefix=IMPORT_"`
+	Trash      TrashConfig     `env:",prefix=TRASH_"`
+	Exports    ExportsConfig   `env:",prefix=EXPORTS_"`
+	Users      UsersClient     `env:",prefix=USERS_"`
+	Quota      QuotaConfig     `env:",prefix=QUOTA_"`
+}
+
+// QuotaConfig - ограничения хранилища одного пользователя, 0 снимает ограничение.
+type QuotaConfig struct {
+	MaxLinks int64 `env:"MAX_LINKS,default=10000"` // ссылок вместе с корзиной
+}
+
+// UsersClient - подключение links-srv к users-srv для проверки владельцев ссылок.
+type UsersClient struct {
+	Addr     string        `env:"ADDR,default=:52000"`
+	CacheTTL time.Duration `env:"CACHE_TTL,default=1m"` // сколько помнить, что пользователь существует
+}
+
+type ExportsConfig struct {
+	PollInterval time.Duration `env:"POLL_INTERVAL,default=2s"`
+}
+
+type TrashConfig struct {
+	Retention     time.Duration `env:"RETENTION,default=720h"` // сколько удаленные записи хранятся до очистки
+	PurgeInterval time.Duration `env:"PURGE_INTERVAL,default=1h"`
+}
+
+type ImportConfig struct {
+	MaxSize         int64         `env:"MAX_SIZE,default=33554432"`
+	PollInterval    time.Duration `env:"POLL_INTERVAL,default=2s"`
+	EnqueueInterval time.Duration `env:"ENQUEUE_INTERVAL,default=200ms"` // пауза между отправками ссылок на обогащение
+}
+
+type URLsConfig struct {
+	// TrackingParams удаляются из URL при поиске дубликатов, "*" на конце задает префикс
+	TrackingParams []string `env:"TRACKING_PARAMS,default=utm_*,fbclid,gclid,dclid,yclid,msclkid,mc_cid,mc_eid,_ga,_gl,igshid"`
+}
+
+type ShortenerConfig struct {
+	SlugLength int    `env:"SLUG_LENGTH,default=7"`
+	GeoIPFile  string `env:"GEOIP_FILE"` // CSV диапазонов "начало,конец,страна"; без него страна не определяется
+}
+
+type TagsConfig struct {
+	MaxLength int               `env:"MAX_LENGTH,default=50"`
+	StopWords []string          `env:"STOP_WORDS,default=a,an,and,the,of,in,on,for,to,with,or"`
+	Synonyms  map[string]string `env:"SYNONYMS"`
+}
+
+type SnapshotsConfig struct {
+	Dir          string `env:"DIR,default=./data/snapshots"`
+	Inline       bool   `env:"INLINE,default=false"`
+	InlineBudget int64  `env:"INLINE_BUDGET,default=5242880"`
 }
 
 type LinksGRPCConfig struct {
@@ -54,6 +116,11 @@ func (m MongoConfig) ConnectionString() string {
 type UsersService struct {
 	Postgres   PostgresConfig  `env:",prefix=DB_"`
 	GRPCServer UsersGRPCConfig `env:",prefix=GRPC_"`
+	Trash      TrashConfig     `env:",prefix=TRASH_"`
+	// AdminIDs - пользователи, которым доступны журнал аудита и выгрузки данных всех пользователей.
+	// Их запросы должны нести AdminToken в заголовке X-Admin-Token, без него доступа администраторов нет
+	AdminIDs   []string `env:"ADMIN_IDS"`
+	AdminToken string   `env:"ADMIN_TOKEN"`
 }
 
 type UsersGRPCConfig struct {
@@ -104,9 +171,47 @@ func (c PostgresConfig) ConnectionURL() string {
 }
 
 type APIGWService struct {
-	Addr            string        `env:"ADDR,default=:8080"`
-	ReadTimeout     time.Duration `env:"READ_TIMEOUT,default=30s"`
-	WriteTimeout    time.Duration `env:"WRITE_TIMEOUT,default=30s"`
-	UsersClientAddr string        `env:"USERS_CLIENT_ADDR,default=:52000"`
-	LinksClientAddr string        `env:"LINKS_CLIENT_ADDR,default=:51000"`
+	Addr         string        `env:"ADDR,default=:8080"`
+	ReadTimeout  time.Duration `env:"READ_TIMEOUT,default=30s"`
+	WriteTimeout time.Duration `env:"WRITE_TIMEOUT,default=30s"`
+	// Адреса сервисов: host:port, dns:///host:port или несколько host:port через запятую
+	UsersClientAddr string `env:"USERS_CLIENT_ADDR,default=:52000"`
+	LinksClientAddr string `env:"LINKS_CLIENT_ADDR,default=:51000"`
+	// Сроки отдельных методов сервисов, например GetUser:500ms,ListAuditLog:5s
+	UsersClientTimeouts map[string]time.Duration `env:"USERS_CLIENT_TIMEOUTS"`
+	LinksClientTimeouts map[string]time.Duration `env:"LINKS_CLIENT_TIMEOUTS"`
+	Clients             GRPCClientsConfig        `env:",prefix=CLIENTS_"`
+	RateLimit           RateLimitConfig          `env:",prefix=RATE_LIMIT_"`
+	// TrustedProxies - адреса или подсети балансировщиков перед api-gw, например 10.0.0.0/8,192.0.2.1.
+	// Адрес клиента из X-Forwarded-For берется только у запросов от них
+	TrustedProxies []string `env:"TRUSTED_PROXIES"`
+	// ValidateResponses проверяет ответы по спецификации OpenAPI, включается в тестах
+	ValidateResponses bool `env:"VALIDATE_RESPONSES,default=false"`
+}
+
+// GRPCClientsConfig - общие настройки клиентов api-gw к сервисам.
+type GRPCClientsConfig struct {
+	Timeout time.Duration `env:"TIMEOUT,default=2s"` // срок unary вызова, если для метода не задан свой
+	// Повторы идемпотентных вызовов, если сервис недоступен
+	RetryMaxAttempts    int           `env:"RETRY_MAX_ATTEMPTS,default=3"`
+	RetryInitialBackoff time.Duration `env:"RETRY_INITIAL_BACKOFF,default=100ms"`
+	RetryMaxBackoff     time.Duration `env:"RETRY_MAX_BACKOFF,default=1s"`
+	// Выключатель размыкается после BreakerThreshold отказов подряд и пропускает пробный вызов через BreakerOpenFor
+	BreakerThreshold int           `env:"BREAKER_THRESHOLD,default=5"`
+	BreakerOpenFor   time.Duration `env:"BREAKER_OPEN_FOR,default=10s"`
+}
+
+// RateLimitConfig - сколько запросов клиент может сделать за период в каждой группе маршрутов.
+// Клиент определяется по адресу. Лимит 0 отключает ограничение группы.
+type RateLimitConfig struct {
+	Enabled bool `env:"ENABLED,default=true"`
+	// MaxBuckets - сколько корзин, по одной на клиента и группу маршрутов, api-gw хранит одновременно.
+	// Когда места нет, вытесняется самая давняя
+	MaxBuckets    int           `env:"MAX_BUCKETS,default=100000"`
+	Reads         int           `env:"READS,default=600"`
+	ReadsPeriod   time.Duration `env:"READS_PERIOD,default=1m"`
+	Writes        int           `env:"WRITES,default=120"`
+	WritesPeriod  time.Duration `env:"WRITES_PERIOD,default=1m"`
+	Imports       int           `env:"IMPORTS,default=10"`
+	ImportsPeriod time.Duration `env:"IMPORTS_PERIOD,default=1h"`
 }
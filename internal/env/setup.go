@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v4/pgxpool"
@@ -15,16 +17,45 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/apigw/clients"
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/apigw/routes"
 	v1 "github.com/ptsypyshev/gb-golang-level3-new/internal/apigw/v1"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/audit"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/cleanups"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/clicks"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/collections"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/contents"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/imports"
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/links"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/notes"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/settings"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/shares"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/slugs"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/snapshots"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/userexports"
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/database/users"
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/env/config"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/collectiongrpc"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/importgrpc"
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/linkgrpc"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/sharegrpc"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/shortgrpc"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/stories/importer"
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/stories/linkupdater"
+	linktrash "github.com/ptsypyshev/gb-golang-level3-new/internal/link/stories/trashpurger"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/stories/usercleanup"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/stories/userexport"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/userexportgrpc"
+	usertrash "github.com/ptsypyshev/gb-golang-level3-new/internal/user/stories/trashpurger"
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/user/usergrpc"
 
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/blobstore"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/geoip"
 	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/ratelimit"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/tagnorm"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/urlnorm"
 )
 
 type Env struct {
@@ -33,6 +64,11 @@ type Env struct {
 	LinksGRPCServer *grpc.Server
 	UsersGRPCServer *grpc.Server
 	LinkUpdater     *linkupdater.Story
+	Importer        *importer.Story
+	LinksTrash      *linktrash.Story
+	UserCleanup     *usercleanup.Story
+	UserExport      *userexport.Story
+	UsersTrash      *usertrash.Story
 }
 
 func Setup(ctx context.Context) (*Env, *Closer, error) {
@@ -76,25 +112,139 @@ func Setup(ctx context.Context) (*Env, *Closer, error) {
 		return nil, nil, fmt.Errorf("QueueDeclare: %w", err)
 	}
 
+	// События пользователей не должны теряться при рестарте брокера: по ним links-srv очищает данные
+	_, err = amqpChannel.QueueDeclare(cfg.LinksService.AMQP.UserEventsQueueName, true, false, false, false, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("QueueDeclare: %w", err)
+	}
+	_, err = amqpChannel.QueueDeclare(cfg.LinksService.AMQP.UserEventsDeadQueueName, true, false, false, false, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("QueueDeclare: %w", err)
+	}
+
 	usersRepository := users.New(usersDBConn, 5*time.Second) // вынести в конфиг duration
 	linksRepository := links.New(
 		linksDBConn.Database(cfg.LinksService.Mongo.Name),
 		5*time.Second, // вынести в конфиг duration
 	)
+	if err := linksRepository.EnsureIndexes(ctx); err != nil {
+		return nil, nil, fmt.Errorf("links EnsureIndexes: %w", err)
+	}
 
-	{
-		handler := linkgrpc.New(linksRepository, cfg.LinksService.GRPCServer.Timeout, amqpChannel, cfg.LinksService.AMQP.QueueName)
+	contentsRepository := contents.New(linksDBConn.Database(cfg.LinksService.Mongo.Name), 5*time.Second)
+	snapshotsRepository := snapshots.New(linksDBConn.Database(cfg.LinksService.Mongo.Name), 5*time.Second)
+	collectionsRepository := collections.New(linksDBConn.Database(cfg.LinksService.Mongo.Name), 5*time.Second)
+	sharesRepository := shares.New(linksDBConn.Database(cfg.LinksService.Mongo.Name), 5*time.Second)
+	slugsRepository := slugs.New(linksDBConn.Database(cfg.LinksService.Mongo.Name), 5*time.Second)
+	clicksRepository := clicks.New(linksDBConn.Database(cfg.LinksService.Mongo.Name), 5*time.Second)
+	importsRepository := imports.New(linksDBConn.Database(cfg.LinksService.Mongo.Name), 5*time.Second)
+	settingsRepository := settings.New(linksDBConn.Database(cfg.LinksService.Mongo.Name), 5*time.Second)
+	notesRepository := notes.New(linksDBConn.Database(cfg.LinksService.Mongo.Name), 5*time.Second)
+	if err := notesRepository.EnsureIndexes(ctx); err != nil {
+		return nil, nil, fmt.Errorf("notes EnsureIndexes: %w", err)
+	}
+	userExportsRepository := userexports.New(linksDBConn.Database(cfg.LinksService.Mongo.Name), 5*time.Second)
+	cleanupsRepository := cleanups.New(linksDBConn.Database(cfg.LinksService.Mongo.Name), 5*time.Second)
+
+	blobs, err := blobstore.NewFS(cfg.LinksService.Snapshots.Dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("blobstore NewFS: %w", err)
+	}
+
+	var geo *geoip.DB
+	if cfg.LinksService.Shortener.GeoIPFile != "" {
+		if geo, err = geoip.Open(cfg.LinksService.Shortener.GeoIPFile); err != nil {
+			return nil, nil, fmt.Errorf("geoip Open: %w", err)
+		}
+	}
 
+	urlNormalizer := urlnorm.New(cfg.LinksService.URLs.TrackingParams)
+
+	// Администраторы общие для users-srv и links-srv: журнал аудита и выгрузки чужих данных
+	admins := reqmeta.NewAdmins(cfg.UsersService.AdminIDs, cfg.UsersService.AdminToken)
+
+	tagNormalizer := tagnorm.New(
+		cfg.LinksService.Tags.MaxLength, cfg.LinksService.Tags.StopWords, cfg.LinksService.Tags.Synonyms,
+	)
+
+	// Клиент links service для проверки владельцев ссылок в users service
+	ownersClientConn, err := grpc.DialContext(
+		ctx, cfg.LinksService.Users.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("grpc DialContext: %w", err)
+	}
+
+	linkHandler := linkgrpc.New(
+		linksRepository,
+		contentsRepository,
+		snapshotsRepository,
+		collectionsRepository,
+		sharesRepository,
+		slugsRepository,
+		clicksRepository,
+		settingsRepository,
+		notesRepository,
+		cleanupsRepository,
+		blobs,
+		tagNormalizer,
+		urlNormalizer,
+		pb.NewUserServiceClient(ownersClientConn),
+		cfg.LinksService.Users.CacheTTL,
+		cfg.LinksService.Quota.MaxLinks,
+		cfg.LinksService.GRPCServer.Timeout,
+		amqpChannel,
+		cfg.LinksService.AMQP.QueueName,
+	)
+
+	{
 		s := grpc.NewServer()
 		reflection.Register(s) // этот код нужен для дебаггинга
-		pb.RegisterLinkServiceServer(s, handler)
+		pb.RegisterLinkServiceServer(s, linkHandler)
+		pb.RegisterCollectionServiceServer(
+			s, collectiongrpc.New(
+				collectionsRepository, linksRepository, sharesRepository, cfg.LinksService.GRPCServer.Timeout,
+			),
+		)
+		pb.RegisterShareServiceServer(
+			s, sharegrpc.New(
+				sharesRepository, collectionsRepository, linksRepository, cfg.LinksService.GRPCServer.Timeout,
+			),
+		)
+		pb.RegisterShortenerServiceServer(
+			s, shortgrpc.New(
+				slugsRepository,
+				clicksRepository,
+				linksRepository,
+				geo,
+				cfg.LinksService.Shortener.SlugLength,
+				cfg.LinksService.GRPCServer.Timeout,
+			),
+		)
+		pb.RegisterImportServiceServer(
+			s, importgrpc.New(
+				importsRepository, blobs, cfg.LinksService.Import.MaxSize, cfg.LinksService.GRPCServer.Timeout,
+			),
+		)
+		pb.RegisterUserExportServiceServer(
+			s, userexportgrpc.New(
+				userExportsRepository, blobs, admins, cfg.LinksService.GRPCServer.Timeout,
+			),
+		)
 
 		// grpc server start function
 		env.LinksGRPCServer = s
 	}
 
 	{
-		handler := usergrpc.New(usersRepository, cfg.LinksService.GRPCServer.Timeout)
+		handler := usergrpc.New(
+			usersRepository,
+			audit.New(usersDBConn, cfg.UsersService.Postgres.DBTimeout),
+			amqpChannel,
+			cfg.LinksService.AMQP.UserEventsQueueName,
+			admins,
+			cfg.LinksService.GRPCServer.Timeout,
+		)
 
 		s := grpc.NewServer()
 		reflection.Register(s) // этот код нужен для дебаггинга
@@ -107,29 +257,60 @@ func Setup(ctx context.Context) (*Env, *Closer, error) {
 	// Инициализируем клиенты GRPC
 
 	// Клиент для осуществления запросов в users service
-	usersClientConn, err := grpc.DialContext(
-		ctx, cfg.APIGWService.UsersClientAddr, grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	usersClientConn, err := clients.Dial(ctx, gatewayClientOptions(
+		cfg.APIGWService, "users-srv", cfg.APIGWService.UsersClientAddr, cfg.APIGWService.UsersClientTimeouts,
+		pb.UserService_ServiceDesc,
+	))
 	if err != nil {
-		return nil, nil, fmt.Errorf("grpc DialContext: %w", err)
+		return nil, nil, err
 	}
 
 	usersClient := pb.NewUserServiceClient(usersClientConn)
 
 	// Клиент для осуществления запросов в links service
-	linksClientConn, err := grpc.DialContext(
-		ctx, cfg.APIGWService.LinksClientAddr, grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	linksClientConn, err := clients.Dial(ctx, gatewayClientOptions(
+		cfg.APIGWService, "links-srv", cfg.APIGWService.LinksClientAddr, cfg.APIGWService.LinksClientTimeouts,
+		pb.LinkService_ServiceDesc,
+		pb.CollectionService_ServiceDesc,
+		pb.ShareService_ServiceDesc,
+		pb.ShortenerService_ServiceDesc,
+		pb.ImportService_ServiceDesc,
+		pb.UserExportService_ServiceDesc,
+	))
 	if err != nil {
-		return nil, nil, fmt.Errorf("grpc DialContext: %w", err)
+		return nil, nil, err
 	}
 
 	linksClient := pb.NewLinkServiceClient(linksClientConn)
+	collectionsClient := pb.NewCollectionServiceClient(linksClientConn)
+	sharesClient := pb.NewShareServiceClient(linksClientConn)
+	shortenerClient := pb.NewShortenerServiceClient(linksClientConn)
+	importsClient := pb.NewImportServiceClient(linksClientConn)
+	userExportsClient := pb.NewUserExportServiceClient(linksClientConn)
 
 	// API GW handler
 	// В роуйтере пакета v1 нужно использовать клиенты и запрашивать данные с сервисов links и users
-	handler := v1.New(usersClient, linksClient)
-	router := routes.Router(handler)
+	handler := v1.New(
+		usersClient, linksClient, collectionsClient, sharesClient, shortenerClient, importsClient, userExportsClient,
+		cfg.LinksService.Import.MaxSize,
+	)
+	proxies, err := parsePrefixes(cfg.APIGWService.TrustedProxies)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse trusted proxies: %w", err)
+	}
+	routerOpts := routes.Options{ValidateResponses: cfg.APIGWService.ValidateResponses, TrustedProxies: proxies}
+	if rl := cfg.APIGWService.RateLimit; rl.Enabled {
+		routerOpts.RateLimits = &routes.RateLimits{
+			Store:   ratelimit.NewMemory(rl.MaxBuckets),
+			Reads:   ratelimit.Limit{Requests: rl.Reads, Per: rl.ReadsPeriod},
+			Writes:  ratelimit.Limit{Requests: rl.Writes, Per: rl.WritesPeriod},
+			Imports: ratelimit.Limit{Requests: rl.Imports, Per: rl.ImportsPeriod},
+		}
+	}
+	router, err := routes.Router(handler, routerOpts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("routes Router: %w", err)
+	}
 
 	apiGWServer := &http.Server{
 		Addr:              cfg.APIGWService.Addr,
@@ -140,11 +321,120 @@ func Setup(ctx context.Context) (*Env, *Closer, error) {
 		IdleTimeout:       cfg.APIGWService.ReadTimeout,
 	}
 
-	linkUpdaterStory := linkupdater.New(linksRepository, amqpChannel, cfg.LinksService.AMQP.QueueName)
+	var inlineBudget int64
+	if cfg.LinksService.Snapshots.Inline {
+		inlineBudget = cfg.LinksService.Snapshots.InlineBudget
+	}
+
+	linkUpdaterStory := linkupdater.New(
+		linksRepository,
+		contentsRepository,
+		snapshotsRepository,
+		blobs,
+		tagNormalizer,
+		urlNormalizer,
+		amqpChannel,
+		cfg.LinksService.AMQP.QueueName,
+		inlineBudget,
+	)
+
+	importerStory := importer.New(
+		importsRepository,
+		linksRepository,
+		collectionsRepository,
+		settingsRepository,
+		blobs,
+		tagNormalizer,
+		urlNormalizer,
+		amqpChannel,
+		cfg.LinksService.AMQP.QueueName,
+		cfg.LinksService.Import.PollInterval,
+		cfg.LinksService.Import.EnqueueInterval,
+		cfg.LinksService.Quota.MaxLinks,
+	)
 
 	env.APIGWHTTPServer = apiGWServer
 	env.Config = cfg
 	env.LinkUpdater = linkUpdaterStory
+	env.Importer = importerStory
+	env.LinksTrash = linktrash.New(
+		linksRepository, collectionsRepository, linkHandler, cfg.LinksService.Trash.Retention, cfg.LinksService.Trash.PurgeInterval,
+	)
+	env.UserCleanup = usercleanup.New(
+		cleanupsRepository,
+		linksRepository,
+		collectionsRepository,
+		sharesRepository,
+		linkHandler,
+		amqpChannel,
+		amqpChannel,
+		cfg.LinksService.AMQP.UserEventsQueueName,
+		cfg.LinksService.AMQP.UserEventsDeadQueueName,
+		cfg.LinksService.AMQP.UserEventsMaxAttempts,
+	)
+	env.UserExport = userexport.New(
+		userExportsRepository,
+		linksRepository,
+		notesRepository,
+		collectionsRepository,
+		clicksRepository,
+		blobs,
+		cfg.LinksService.Exports.PollInterval,
+	)
+	env.UsersTrash = usertrash.New(
+		usersRepository, cfg.UsersService.Trash.Retention, cfg.UsersService.Trash.PurgeInterval,
+	)
+
+	return env, NewCloser(
+		usersDBConn, linksDBConn, amqpConn, amqpChannel, ownersClientConn, usersClientConn, linksClientConn,
+	), nil
+}
+
+// parsePrefixes разбирает подсети вида 10.0.0.0/8, одиночный адрес считается подсетью из одного адреса.
+func parsePrefixes(list []string) ([]netip.Prefix, error) {
+	res := make([]netip.Prefix, 0, len(list))
+	for _, s := range list {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		if strings.Contains(s, "/") {
+			p, err := netip.ParsePrefix(s)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, p.Masked())
+			continue
+		}
 
-	return env, NewCloser(usersDBConn, linksDBConn, amqpConn, amqpChannel), nil
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+
+	return res, nil
+}
+
+// gatewayClientOptions собирает настройки клиента api-gw к сервису по адресу addr.
+func gatewayClientOptions(
+	cfg config.APIGWService, name, addr string, timeouts map[string]time.Duration, services ...grpc.ServiceDesc,
+) clients.Options {
+	return clients.Options{
+		Name:           name,
+		Addr:           addr,
+		Services:       services,
+		Timeout:        cfg.Clients.Timeout,
+		MethodTimeouts: timeouts,
+		Retry: clients.RetryOptions{
+			MaxAttempts:    cfg.Clients.RetryMaxAttempts,
+			InitialBackoff: cfg.Clients.RetryInitialBackoff,
+			MaxBackoff:     cfg.Clients.RetryMaxBackoff,
+		},
+		Breaker: clients.BreakerOptions{
+			Threshold: cfg.Clients.BreakerThreshold,
+			OpenFor:   cfg.Clients.BreakerOpenFor,
+		},
+	}
 }
@@ -0,0 +1,29 @@
+package collectiongrpc
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+type collectionsRepository interface {
+	Create(ctx context.Context, req database.CreateCollectionReq) (database.Collection, error)
+	Update(ctx context.Context, req database.UpdateCollectionReq) (database.Collection, error)
+	Delete(ctx context.Context, id primitive.ObjectID) error
+	FindByID(ctx context.Context, id primitive.ObjectID) (database.Collection, error)
+	FindByUserID(ctx context.Context, userID string) ([]database.Collection, error)
+	AddLink(ctx context.Context, id, linkID primitive.ObjectID) error
+	RemoveLink(ctx context.Context, id, linkID primitive.ObjectID) error
+	SetLinks(ctx context.Context, id primitive.ObjectID, linkIDs []primitive.ObjectID) error
+}
+
+type linksRepository interface {
+	FindByID(ctx context.Context, id primitive.ObjectID) (database.Link, error)
+	FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]database.Link, error)
+}
+
+type sharesRepository interface {
+	DeleteByTarget(ctx context.Context, id primitive.ObjectID) error
+}
@@ -0,0 +1,358 @@
+package collectiongrpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/linkgrpc"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
+)
+
+// maxDepth ограничивает вложенность коллекций и заодно защищает от циклов в данных.
+const maxDepth = 16
+
+var _ pb.CollectionServiceServer = (*Handler)(nil)
+
+func New(
+	collectionsRepository collectionsRepository,
+	linksRepository linksRepository,
+	sharesRepository sharesRepository,
+	timeout time.Duration,
+) *Handler {
+	return &Handler{
+		collectionsRepository: collectionsRepository,
+		linksRepository:       linksRepository,
+		sharesRepository:      sharesRepository,
+		timeout:               timeout,
+	}
+}
+
+type Handler struct {
+	pb.UnimplementedCollectionServiceServer
+	collectionsRepository collectionsRepository
+	linksRepository       linksRepository
+	sharesRepository      sharesRepository
+	timeout               time.Duration
+}
+
+func (h Handler) CreateCollection(ctx context.Context, request *pb.CreateCollectionRequest) (*pb.Collection, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" || request.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and name are required")
+	}
+
+	id := primitive.NewObjectID()
+	if request.Id != "" {
+		var err error
+		if id, err = primitive.ObjectIDFromHex(request.Id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	parentID, err := h.parentID(ctx, request.UserId, id, request.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := h.collectionsRepository.Create(ctx, database.CreateCollectionReq{
+		ID:          id,
+		UserID:      request.UserId,
+		Name:        request.Name,
+		Description: request.Description,
+		ParentID:    parentID,
+		Position:    request.Position,
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "collection %s already exists", id.Hex())
+		}
+		return nil, err
+	}
+
+	return CollectionToPB(c), nil
+}
+
+func (h Handler) GetCollection(ctx context.Context, request *pb.GetCollectionRequest) (*pb.Collection, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	c, err := h.find(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return CollectionToPB(c), nil
+}
+
+func (h Handler) UpdateCollection(ctx context.Context, request *pb.UpdateCollectionRequest) (*pb.Collection, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	current, err := h.find(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	parentID, err := h.parentID(ctx, current.UserID, current.ID, request.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := h.collectionsRepository.Update(ctx, database.UpdateCollectionReq{
+		ID:          current.ID,
+		Name:        request.Name,
+		Description: request.Description,
+		ParentID:    parentID,
+		Position:    request.Position,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return CollectionToPB(c), nil
+}
+
+func (h Handler) DeleteCollection(ctx context.Context, request *pb.DeleteCollectionRequest) (*pb.Empty, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	c, err := h.find(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ссылки живут отдельно от коллекций, поэтому удаляется только сама коллекция
+	if err := h.collectionsRepository.Delete(ctx, c.ID); err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, h.sharesRepository.DeleteByTarget(ctx, c.ID)
+}
+
+func (h Handler) ListCollections(ctx context.Context, request *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	collections, err := h.collectionsRepository.FindByUserID(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Collection, len(collections))
+	for i, c := range collections {
+		res[i] = CollectionToPB(c)
+	}
+
+	return &pb.ListCollectionsResponse{Collections: res}, nil
+}
+
+func (h Handler) ListCollectionLinks(ctx context.Context, request *pb.GetCollectionRequest) (*pb.ListLinkResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	c, err := h.find(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := h.linksRepository.FindByIDs(ctx, c.LinkIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Как и в списках ссылок пользователя, остальным отдаем только публичные ссылки владельца коллекции
+	owner := c.UserID != "" && reqmeta.FromIncoming(ctx).ActorID == c.UserID
+
+	res := make([]*pb.Link, 0, len(links))
+	for _, l := range links {
+		if owner || l.Visibility.Effective() == database.VisibilityPublic {
+			res = append(res, linkgrpc.LinkToPB(l))
+		}
+	}
+
+	return &pb.ListLinkResponse{Links: res}, nil
+}
+
+func (h Handler) AddLinkToCollection(ctx context.Context, request *pb.CollectionLinkRequest) (*pb.Empty, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	c, linkID, err := h.findWithLink(ctx, request.CollectionId, request.LinkId)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := h.linksRepository.FindByID(ctx, linkID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Errorf(codes.NotFound, "link %s is not found", request.LinkId)
+		}
+		return nil, err
+	}
+
+	if l.UserID != c.UserID {
+		return nil, status.Error(codes.PermissionDenied, "link and collection belong to different users")
+	}
+
+	return &pb.Empty{}, h.collectionsRepository.AddLink(ctx, c.ID, linkID)
+}
+
+func (h Handler) RemoveLinkFromCollection(ctx context.Context, request *pb.CollectionLinkRequest) (*pb.Empty, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	c, linkID, err := h.findWithLink(ctx, request.CollectionId, request.LinkId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, h.collectionsRepository.RemoveLink(ctx, c.ID, linkID)
+}
+
+// ReorderCollectionLinks принимает полный список ссылок коллекции в новом порядке.
+func (h Handler) ReorderCollectionLinks(ctx context.Context, request *pb.ReorderCollectionLinksRequest) (*pb.Empty, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	c, err := h.find(ctx, request.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[primitive.ObjectID]struct{}, len(c.LinkIDs))
+	for _, id := range c.LinkIDs {
+		current[id] = struct{}{}
+	}
+
+	ordered := make([]primitive.ObjectID, 0, len(request.LinkIds))
+	for _, hex := range request.LinkIds {
+		id, err := primitive.ObjectIDFromHex(hex)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if _, ok := current[id]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "link %s is not in the collection or is repeated", hex)
+		}
+		delete(current, id)
+
+		ordered = append(ordered, id)
+	}
+
+	if len(current) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "link_ids must contain every link of the collection")
+	}
+
+	return &pb.Empty{}, h.collectionsRepository.SetLinks(ctx, c.ID, ordered)
+}
+
+func (h Handler) find(ctx context.Context, hex string) (database.Collection, error) {
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return database.Collection{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	c, err := h.collectionsRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return c, status.Errorf(codes.NotFound, "collection %s is not found", hex)
+		}
+		return c, err
+	}
+
+	return c, nil
+}
+
+func (h Handler) findWithLink(ctx context.Context, collectionHex, linkHex string) (database.Collection, primitive.ObjectID, error) {
+	linkID, err := primitive.ObjectIDFromHex(linkHex)
+	if err != nil {
+		return database.Collection{}, linkID, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	c, err := h.find(ctx, collectionHex)
+
+	return c, linkID, err
+}
+
+// parentID проверяет, что родительская коллекция принадлежит тому же пользователю
+// и что перенос не создаст цикл или слишком глубокую вложенность.
+func (h Handler) parentID(ctx context.Context, userID string, id primitive.ObjectID, parentHex string) (*primitive.ObjectID, error) {
+	if parentHex == "" {
+		return nil, nil //nolint:nilnil // коллекция верхнего уровня
+	}
+
+	parentID, err := primitive.ObjectIDFromHex(parentHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	next := &parentID
+	for depth := 0; next != nil; depth++ {
+		if *next == id {
+			return nil, status.Error(codes.InvalidArgument, "collection cannot be nested into itself")
+		}
+
+		if depth >= maxDepth {
+			return nil, status.Errorf(codes.InvalidArgument, "collections cannot be nested deeper than %d levels", maxDepth)
+		}
+
+		parent, err := h.find(ctx, next.Hex())
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Errorf(codes.InvalidArgument, "parent collection %s is not found", next.Hex())
+			}
+			return nil, err
+		}
+
+		if parent.UserID != userID {
+			return nil, status.Error(codes.PermissionDenied, "parent collection belongs to another user")
+		}
+
+		next = parent.ParentID
+	}
+
+	return &parentID, nil
+}
+
+// CollectionToPB конвертирует коллекцию в модель gRPC, используется и выгрузкой данных пользователя.
+func CollectionToPB(c database.Collection) *pb.Collection {
+	linkIDs := make([]string, len(c.LinkIDs))
+	for i, id := range c.LinkIDs {
+		linkIDs[i] = id.Hex()
+	}
+
+	var parentID string
+	if c.ParentID != nil {
+		parentID = c.ParentID.Hex()
+	}
+
+	return &pb.Collection{
+		Id:          c.ID.Hex(),
+		UserId:      c.UserID,
+		Name:        c.Name,
+		Description: c.Description,
+		ParentId:    parentID,
+		Position:    c.Position,
+		LinkIds:     linkIDs,
+		CreatedAt:   c.CreatedAt.String(),
+		UpdatedAt:   c.UpdatedAt.String(),
+	}
+}
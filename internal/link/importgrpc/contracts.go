@@ -0,0 +1,18 @@
+package importgrpc
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+type jobsRepository interface {
+	Create(ctx context.Context, job database.ImportJob) (database.ImportJob, error)
+	FindByID(ctx context.Context, id primitive.ObjectID) (database.ImportJob, error)
+}
+
+type blobStore interface {
+	Put(ctx context.Context, data []byte) (string, error)
+}
@@ -0,0 +1,164 @@
+package importgrpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/bookmarks"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
+)
+
+var _ pb.ImportServiceServer = (*Handler)(nil)
+
+// New создает обработчик импорта. Файлы больше maxSize байт отклоняются.
+func New(jobsRepository jobsRepository, blobs blobStore, maxSize int64, timeout time.Duration) *Handler {
+	return &Handler{
+		jobsRepository: jobsRepository,
+		blobs:          blobs,
+		maxSize:        maxSize,
+		timeout:        timeout,
+	}
+}
+
+type Handler struct {
+	pb.UnimplementedImportServiceServer
+	jobsRepository jobsRepository
+	blobs          blobStore
+	maxSize        int64
+	timeout        time.Duration
+}
+
+// ImportLinks сохраняет загруженный файл и ставит задание импорта в очередь.
+// Сами закладки разбираются в фоне историей importer.
+func (h Handler) ImportLinks(stream pb.ImportService_ImportLinksServer) error {
+	var (
+		userID string
+		format bookmarks.Format
+		data   bytes.Buffer
+	)
+
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if first {
+			userID, format = req.UserId, bookmarks.Format(req.Format)
+		}
+
+		if int64(data.Len()+len(req.Chunk)) > h.maxSize {
+			return status.Errorf(codes.ResourceExhausted, "import file is larger than %d bytes", h.maxSize)
+		}
+		data.Write(req.Chunk)
+	}
+
+	if userID == "" {
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := validateFormat(format, data.Bytes()); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(stream.Context(), h.timeout)
+	defer cancel()
+
+	sum, err := h.blobs.Put(ctx, data.Bytes())
+	if err != nil {
+		return err
+	}
+
+	job, err := h.jobsRepository.Create(ctx, database.ImportJob{
+		ID:         primitive.NewObjectID(),
+		UserID:     userID,
+		Format:     string(format),
+		BlobSHA256: sum,
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(jobToPB(job))
+}
+
+func (h Handler) GetImportJob(ctx context.Context, request *pb.GetImportJobRequest) (*pb.ImportJob, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	actor := reqmeta.FromIncoming(ctx).ActorID
+	if actor == "" {
+		return nil, status.Error(codes.Unauthenticated, "actor is required")
+	}
+
+	id, err := primitive.ObjectIDFromHex(request.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	job, err := h.jobsRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Errorf(codes.NotFound, "import job %s is not found", request.Id)
+		}
+		return nil, err
+	}
+
+	// Чужие задания неотличимы от несуществующих
+	if job.UserID != actor {
+		return nil, status.Errorf(codes.NotFound, "import job %s is not found", request.Id)
+	}
+
+	return jobToPB(job), nil
+}
+
+// validateFormat отклоняет неизвестный формат сразу, а не в фоновом задании.
+func validateFormat(format bookmarks.Format, data []byte) error {
+	switch format {
+	case bookmarks.FormatNetscape, bookmarks.FormatPocketCSV, bookmarks.FormatPinboard:
+		return nil
+	case bookmarks.FormatAuto:
+		if bookmarks.Detect(data) == bookmarks.FormatAuto {
+			return status.Error(codes.InvalidArgument, "cannot detect bookmarks format")
+		}
+		return nil
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown bookmarks format %q", format)
+	}
+}
+
+func jobToPB(job database.ImportJob) *pb.ImportJob {
+	res := &pb.ImportJob{
+		Id:         job.ID.Hex(),
+		UserId:     job.UserID,
+		Format:     job.Format,
+		Status:     string(job.Status),
+		Total:      job.Total,
+		Processed:  job.Processed,
+		Created:    job.Created,
+		Duplicates: job.Duplicates,
+		Failed:     job.Failed,
+		Error:      job.Error,
+		CreatedAt:  job.CreatedAt.String(),
+		UpdatedAt:  job.UpdatedAt.String(),
+	}
+
+	if job.FinishedAt != nil {
+		res.FinishedAt = job.FinishedAt.String()
+	}
+
+	return res
+}
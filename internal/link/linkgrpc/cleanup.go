@@ -0,0 +1,44 @@
+package linkgrpc
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func (h Handler) GetUserCleanup(ctx context.Context, request *pb.GetLinksByUserId) (*pb.UserCleanup, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	c, err := h.cleanupsRepository.FindByUserID(ctx, request.UserId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// Событие об удалении еще не обработано или пользователь не удалялся
+			return nil, status.Errorf(codes.NotFound, "cleanup of user %s is not found", request.UserId)
+		}
+		return nil, err
+	}
+
+	return &pb.UserCleanup{
+		UserId:      c.UserID,
+		Status:      string(c.Status),
+		Links:       c.Links,
+		Collections: c.Collections,
+		Shares:      c.Shares,
+		Error:       c.Error,
+		DeletedAt:   c.DeletedAt.String(),
+		UpdatedAt:   c.UpdatedAt.String(),
+		FinishedAt:  optionalTime(c.FinishedAt),
+		Restored:    c.Restored,
+		RestoredAt:  optionalTime(c.RestoredAt),
+	}, nil
+}
@@ -5,19 +5,96 @@ import (
 
 	amqp "github.com/rabbitmq/amqp091-go"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
 
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
 )
 
 type linksRepository interface {
 	Create(ctx context.Context, req database.CreateLinkReq) (database.Link, error)
 	Update(ctx context.Context, req database.UpdateLinkReq) (database.Link, error)
 	Delete(ctx context.Context, id primitive.ObjectID) error
+	SoftDelete(ctx context.Context, id primitive.ObjectID) error
+	Restore(ctx context.Context, id primitive.ObjectID, userID string) (database.Link, error)
 	FindByID(ctx context.Context, id primitive.ObjectID) (database.Link, error)
-	FindByUserID(ctx context.Context, userID string) ([]database.Link, error)
-	FindAll(ctx context.Context) ([]database.Link, error)
+	FindByUserAndURL(ctx context.Context, normalizedURL, userID string) (database.Link, error)
+	CountByUserID(ctx context.Context, userID string) (int64, error)
+	FindDeletedByUserID(ctx context.Context, userID string) ([]database.Link, error)
+	ForEachByUserID(ctx context.Context, userID string, fn func(database.Link) error) error
+	FindByCriteria(ctx context.Context, criteria database.FindLinkCriteria) ([]database.Link, error)
+	TagCounts(ctx context.Context, userID string, visibility *database.Visibility) ([]database.TagCount, error)
+	RenameTag(ctx context.Context, userID, from, to string) (int64, error)
+	MergeTags(ctx context.Context, userID string, from []string, to string) (int64, error)
+	DeleteTag(ctx context.Context, userID, tag string) (int64, error)
+	UpdateState(ctx context.Context, userID string, ids []primitive.ObjectID, change database.LinkStateChange) (int64, error)
+	FindRevisions(ctx context.Context, linkID primitive.ObjectID) ([]database.LinkRevision, error)
+	FindRevision(ctx context.Context, linkID, id primitive.ObjectID) (database.LinkRevision, error)
+}
+
+type usersClient interface {
+	GetUser(ctx context.Context, in *pb.GetUserRequest, opts ...grpc.CallOption) (*pb.User, error)
+}
+
+type contentsRepository interface {
+	FindByLinkID(ctx context.Context, linkID primitive.ObjectID) (database.LinkContent, error)
+	Delete(ctx context.Context, linkID primitive.ObjectID) error
+}
+
+type snapshotsRepository interface {
+	FindByLinkID(ctx context.Context, linkID primitive.ObjectID) ([]database.Snapshot, error)
+	FindByLinkIDAndSHA(ctx context.Context, linkID primitive.ObjectID, sha string) (database.Snapshot, error)
+	DeleteByLinkID(ctx context.Context, linkID primitive.ObjectID) error
+}
+
+type collectionsRepository interface {
+	RemoveLinkEverywhere(ctx context.Context, linkID primitive.ObjectID) error
+}
+
+type sharesRepository interface {
+	DeleteByTarget(ctx context.Context, id primitive.ObjectID) error
+}
+
+type slugsRepository interface {
+	DeleteByLinkID(ctx context.Context, linkID primitive.ObjectID, except string) error
+}
+
+type clicksRepository interface {
+	DeleteByLinkID(ctx context.Context, linkID primitive.ObjectID) error
+}
+
+type blobStore interface {
+	Get(ctx context.Context, sum string) ([]byte, error)
+}
+
+type tagNormalizer interface {
+	Normalize(tag string) string
+	NormalizeAll(tags []string) []string
+}
+
+type urlNormalizer interface {
+	Normalize(raw string) (string, error)
 }
 
 type amqpPublisher interface {
 	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
 }
+
+type settingsRepository interface {
+	Get(ctx context.Context, userID string) (database.LinkSettings, error)
+	Upsert(ctx context.Context, s database.LinkSettings) (database.LinkSettings, error)
+}
+
+type notesRepository interface {
+	Create(ctx context.Context, n database.Note) (database.Note, error)
+	FindByID(ctx context.Context, id primitive.ObjectID) (database.Note, error)
+	FindByLinkID(ctx context.Context, linkID primitive.ObjectID) ([]database.Note, error)
+	FindByText(ctx context.Context, userID, query string, linkIDs []primitive.ObjectID) ([]database.Note, error)
+	Update(ctx context.Context, id primitive.ObjectID, body string) (database.Note, error)
+	Delete(ctx context.Context, id primitive.ObjectID) error
+	DeleteByLinkID(ctx context.Context, linkID primitive.ObjectID) error
+}
+
+type cleanupsRepository interface {
+	FindByUserID(ctx context.Context, userID string) (database.UserCleanup, error)
+}
@@ -0,0 +1,25 @@
+package linkgrpc
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+// ExportLinks отправляет ссылки пользователя по мере чтения курсора, поэтому выгрузка не держит их все в памяти.
+// Время выгрузки ограничивает клиент: таймаут обработчика рассчитан на одиночные запросы.
+func (h Handler) ExportLinks(request *pb.GetLinksByUserId, stream pb.LinkService_ExportLinksServer) error {
+	if request.UserId == "" {
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := requireOwner(stream.Context(), request.UserId); err != nil {
+		return err
+	}
+
+	return h.linksRepository.ForEachByUserID(stream.Context(), request.UserId, func(l database.Link) error {
+		return stream.Send(LinkToPB(l))
+	})
+}
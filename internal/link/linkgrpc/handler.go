@@ -3,106 +3,205 @@ package linkgrpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/models"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/blobstore"
 	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/tagnorm"
 )
 
 const ContentTypeJSON = "application/json"
 
 var _ pb.LinkServiceServer = (*Handler)(nil)
 
-func New(linksRepository linksRepository, timeout time.Duration, publisher amqpPublisher, queueName string) *Handler {
+func New(
+	linksRepository linksRepository,
+	contentsRepository contentsRepository,
+	snapshotsRepository snapshotsRepository,
+	collectionsRepository collectionsRepository,
+	sharesRepository sharesRepository,
+	slugsRepository slugsRepository,
+	clicksRepository clicksRepository,
+	settingsRepository settingsRepository,
+	notesRepository notesRepository,
+	cleanupsRepository cleanupsRepository,
+	blobs blobStore,
+	tags tagNormalizer,
+	urls urlNormalizer,
+	users usersClient,
+	ownersTTL time.Duration,
+	maxLinks int64,
+	timeout time.Duration,
+	publisher amqpPublisher,
+	queueName string,
+) *Handler {
 	return &Handler{
-		linksRepository: linksRepository,
-		pub:             publisher,
-		queueName:       queueName,
-		timeout:         timeout,
+		linksRepository:       linksRepository,
+		contentsRepository:    contentsRepository,
+		snapshotsRepository:   snapshotsRepository,
+		collectionsRepository: collectionsRepository,
+		sharesRepository:      sharesRepository,
+		slugsRepository:       slugsRepository,
+		clicksRepository:      clicksRepository,
+		settingsRepository:    settingsRepository,
+		notesRepository:       notesRepository,
+		cleanupsRepository:    cleanupsRepository,
+		blobs:                 blobs,
+		tags:                  tags,
+		urls:                  urls,
+		owners:                newOwnerCache(users, ownersTTL),
+		maxLinks:              maxLinks,
+		pub:                   publisher,
+		queueName:             queueName,
+		timeout:               timeout,
 	}
 }
 
 type Handler struct {
 	pb.UnimplementedLinkServiceServer
-	linksRepository linksRepository
-	pub             amqpPublisher
-	queueName       string
-	timeout         time.Duration
+	linksRepository       linksRepository
+	contentsRepository    contentsRepository
+	snapshotsRepository   snapshotsRepository
+	collectionsRepository collectionsRepository
+	sharesRepository      sharesRepository
+	slugsRepository       slugsRepository
+	clicksRepository      clicksRepository
+	settingsRepository    settingsRepository
+	notesRepository       notesRepository
+	cleanupsRepository    cleanupsRepository
+	blobs                 blobStore
+	tags                  tagNormalizer
+	urls                  urlNormalizer
+	owners                *ownerCache
+	maxLinks              int64
+	pub                   amqpPublisher
+	queueName             string
+	timeout               time.Duration
 }
 
+// GetLinkByUserID возвращает ссылки пользователя: владельцу все, остальным только публичные.
 func (h Handler) GetLinkByUserID(ctx context.Context, id *pb.GetLinksByUserId) (*pb.ListLinkResponse, error) {
 	// TODO implement me - implemented
-	links, err := h.linksRepository.FindByUserID(ctx, id.UserId)
+	links, err := h.linksRepository.FindByCriteria(ctx, database.FindLinkCriteria{
+		UserID:     &id.UserId,
+		Visibility: listedVisibility(id.UserId, viewer(ctx)),
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	res := make([]*pb.Link, len(links))
 	for i, l := range links {
-		res[i] = &pb.Link{
-			Id:        l.ID.Hex(),
-			Title:     l.Title,
-			Url:       l.URL,
-			Images:    l.Images,
-			Tags:      l.Tags,
-			UserId:    l.UserID,
-			CreatedAt: l.CreatedAt.String(),
-			UpdatedAt: l.UpdatedAt.String(),
-		}
+		res[i] = LinkToPB(l)
 	}
 	return &pb.ListLinkResponse{Links: res}, err
 }
 
-func (h Handler) CreateLink(ctx context.Context, request *pb.CreateLinkRequest) (*pb.Empty, error) {
+// CreateLink сохраняет ссылку, если у пользователя еще нет ссылки с тем же нормализованным URL.
+// Для дубликата возвращается AlreadyExists или, если выставлен return_existing, сохраненная ссылка.
+// Ссылка в корзине тоже считается дубликатом: ее возвращают из корзины через RestoreLink.
+func (h Handler) CreateLink(ctx context.Context, request *pb.CreateLinkRequest) (*pb.CreateLinkResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, h.timeout)
 	defer cancel()
 
 	// TODO implement me - implemented
-	var (
-		id  primitive.ObjectID
-		err error
-	)
+	err := validateLink(linkInput{
+		UserID:      request.UserId,
+		URL:         request.Url,
+		Title:       request.Title,
+		Description: request.Description,
+		Tags:        request.Tags,
+		Images:      request.Images,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := h.owners.check(ctx, request.UserId); err != nil {
+		return nil, err
+	}
+
+	var id primitive.ObjectID
 	if request.Id == "" {
 		id = primitive.NewObjectID()
-	} else {
-		id, err = primitive.ObjectIDFromHex(request.Id)
+	} else if id, err = primitive.ObjectIDFromHex(request.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	normalizedURL, err := h.urls.Normalize(request.Url)
 	if err != nil {
-		return &pb.Empty{}, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	visibility, err := h.visibility(ctx, request.Visibility, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := h.linksRepository.FindByUserAndURL(ctx, normalizedURL, request.UserId)
+	switch {
+	case err == nil:
+		return existingLink(existing, request.ReturnExisting)
+	case !errors.Is(err, mongo.ErrNoDocuments):
+		return nil, err
+	}
+
+	if err := h.checkQuota(ctx, request.UserId); err != nil {
+		return nil, err
 	}
 
 	req := database.CreateLinkReq{
-		ID:     id,
-		Title:  request.Title,
-		URL:    request.Url,
-		Images: request.Images,
-		Tags:   request.Tags,
-		UserID: request.UserId,
+		ID:            id,
+		Title:         request.Title,
+		Description:   request.Description,
+		URL:           request.Url,
+		NormalizedURL: normalizedURL,
+		Images:        request.Images,
+		Tags:          h.tags.NormalizeAll(request.Tags),
+		UserID:        request.UserId,
+		Visibility:    visibility,
+		Revisor:       database.Revisor{Actor: request.UserId, Source: database.RevisionSourceUser},
 	}
 
 	link, err := h.linksRepository.Create(ctx, req)
 	if err != nil {
-		return &pb.Empty{}, err
+		// Ту же ссылку могли сохранить параллельно, тогда ее найдет уникальный индекс
+		if mongo.IsDuplicateKeyError(err) {
+			if existing, findErr := h.linksRepository.FindByUserAndURL(ctx, normalizedURL, request.UserId); findErr == nil {
+				return existingLink(existing, request.ReturnExisting)
+			}
+		}
+		return nil, err
+	}
+
+	if err := h.enqueue(link); err != nil {
+		return nil, err
 	}
 
+	return &pb.CreateLinkResponse{Link: LinkToPB(link)}, nil
+}
+
+// enqueue ставит новую ссылку в очередь на обработку страницы.
+func (h Handler) enqueue(l database.Link) error {
 	// Сообщение которое отправляем в очередь
-	data, err := json.Marshal(models.Message{ID: link.ID.Hex()})
+	data, err := json.Marshal(models.Message{ID: l.ID.Hex()})
 	if err != nil {
-		return &pb.Empty{}, err
+		return err
 	}
 
-	err = h.pub.Publish("", h.queueName, false, false, amqp.Publishing{
+	return h.pub.Publish("", h.queueName, false, false, amqp.Publishing{
 		ContentType: ContentTypeJSON,
 		Body:        data,
 		Timestamp:   time.Now(),
 	})
-
-	return &pb.Empty{}, err
 }
 
 func (h Handler) GetLink(ctx context.Context, request *pb.GetLinkRequest) (*pb.Link, error) {
@@ -110,25 +209,12 @@ func (h Handler) GetLink(ctx context.Context, request *pb.GetLinkRequest) (*pb.L
 	defer cancel()
 
 	// TODO implement me - implemented
-	id, err := primitive.ObjectIDFromHex(request.Id)
-	if err != nil {
-		return nil, err
-	}
-	l, err := h.linksRepository.FindByID(ctx, id)
+	l, err := h.findVisibleLink(ctx, request.Id, viewer(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.Link{
-		Id:        l.ID.Hex(),
-		Title:     l.Title,
-		Url:       l.URL,
-		Images:    l.Images,
-		Tags:      l.Tags,
-		UserId:    l.UserID,
-		CreatedAt: l.CreatedAt.String(),
-		UpdatedAt: l.UpdatedAt.String(),
-	}, nil
+	return LinkToPB(l), nil
 }
 
 func (h Handler) UpdateLink(ctx context.Context, request *pb.UpdateLinkRequest) (*pb.Empty, error) {
@@ -138,19 +224,118 @@ func (h Handler) UpdateLink(ctx context.Context, request *pb.UpdateLinkRequest)
 	// TODO implement me - implemented
 	id, err := primitive.ObjectIDFromHex(request.Id)
 	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = validateLink(linkInput{
+		UserID:      request.UserId,
+		URL:         request.Url,
+		Title:       request.Title,
+		Description: request.Description,
+		Tags:        request.Tags,
+		Images:      request.Images,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := h.owners.check(ctx, request.UserId); err != nil {
+		return nil, err
+	}
+
+	tags := h.tags.NormalizeAll(request.Tags)
+
+	normalizedURL, err := h.urls.Normalize(request.Url)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	current, err := h.linksRepository.FindByID(ctx, id)
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		// Ссылки еще нет, PUT создает ее как CreateLink, с той же квотой
+		return &pb.Empty{}, h.createOnUpdate(ctx, id, request, tags, normalizedURL)
+	case err != nil:
 		return nil, err
+	case current.UserID != request.UserId:
+		// Чужая ссылка не найдена, как и в остальных методах владельца
+		return nil, status.Errorf(codes.NotFound, "link %s is not found", request.Id)
+	}
+
+	// Теги, извлеченные при обогащении, не приходят в запросе, поэтому переносим их из текущей версии ссылки
+	autoTags := tagnorm.Subtract(current.AutoTags, tags)
+	description := request.Description
+	if description == "" {
+		description = current.Description
+	}
+	visibility, err := visibilityOrDefault(request.Visibility, current.Visibility)
+	if err != nil {
+		return nil, err
+	}
+	// Канонический адрес страницы, найденный при обогащении, сохраняется, пока не меняется URL
+	if current.URL == request.Url && current.NormalizedURL != "" {
+		normalizedURL = current.NormalizedURL
 	}
 
 	req := database.UpdateLinkReq{
-		ID:     id,
-		Title:  request.Title,
-		URL:    request.Url,
-		Images: request.Images,
-		Tags:   request.Tags,
-		UserID: request.UserId,
+		ID:            id,
+		Title:         request.Title,
+		Description:   description,
+		URL:           request.Url,
+		NormalizedURL: normalizedURL,
+		Images:        request.Images,
+		Tags:          tags,
+		AutoTags:      autoTags,
+		UserID:        request.UserId,
+		Visibility:    visibility,
+		Revisor:       database.Revisor{Actor: request.UserId, Source: database.RevisionSourceUser},
+	}
+	if _, err = h.linksRepository.Update(ctx, req); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Errorf(codes.NotFound, "link %s is not found", request.Id)
+		}
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "another link with the same url already exists")
+		}
+		return nil, err
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// createOnUpdate создает ссылку с ID из запроса PUT, если ее еще нет.
+func (h Handler) createOnUpdate(
+	ctx context.Context, id primitive.ObjectID, request *pb.UpdateLinkRequest, tags []string, normalizedURL string,
+) error {
+	if err := h.checkQuota(ctx, request.UserId); err != nil {
+		return err
+	}
+
+	visibility, err := h.visibility(ctx, request.Visibility, request.UserId)
+	if err != nil {
+		return err
+	}
+
+	link, err := h.linksRepository.Create(ctx, database.CreateLinkReq{
+		ID:            id,
+		Title:         request.Title,
+		Description:   request.Description,
+		URL:           request.Url,
+		NormalizedURL: normalizedURL,
+		Images:        request.Images,
+		Tags:          tags,
+		UserID:        request.UserId,
+		Visibility:    visibility,
+		Revisor:       database.Revisor{Actor: request.UserId, Source: database.RevisionSourceUser},
+	})
+	if err != nil {
+		// Ссылку с тем же ID могли создать параллельно, а с тем же URL она могла уже быть у пользователя
+		if mongo.IsDuplicateKeyError(err) {
+			return status.Errorf(codes.AlreadyExists, "link %s or another link with the same url already exists", request.Id)
+		}
+		return err
 	}
-	_, err = h.linksRepository.Update(ctx, req)
-	return &pb.Empty{}, err
+
+	return h.enqueue(link)
 }
 
 func (h Handler) DeleteLink(ctx context.Context, request *pb.DeleteLinkRequest) (*pb.Empty, error) {
@@ -158,12 +343,23 @@ func (h Handler) DeleteLink(ctx context.Context, request *pb.DeleteLinkRequest)
 	defer cancel()
 
 	// TODO implement me - implemented
-	id, err := primitive.ObjectIDFromHex(request.Id)
+	// Автор запроса удаляет только свои ссылки. Анонимный запрос, как и раньше, удаляет любую доступную по ID,
+	// но не приватную
+	var (
+		l   database.Link
+		err error
+	)
+	if actor := viewer(ctx); actor != "" {
+		l, err = h.findOwnLink(ctx, request.Id, actor)
+	} else {
+		l, err = h.findVisibleLink(ctx, request.Id, "")
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.Empty{}, h.linksRepository.Delete(ctx, id)
+	// Ссылка уходит в корзину, связанные данные удаляются вместе с ней при очистке, см. PurgeLink
+	return &pb.Empty{}, h.linksRepository.SoftDelete(ctx, l.ID)
 }
 
 func (h Handler) ListLinks(ctx context.Context, request *pb.Empty) (*pb.ListLinkResponse, error) {
@@ -171,23 +367,136 @@ func (h Handler) ListLinks(ctx context.Context, request *pb.Empty) (*pb.ListLink
 	defer cancel()
 
 	// TODO implement me - implemented
-	links, err := h.linksRepository.FindAll(ctx)
+	// Запрос не содержит, кто спрашивает, поэтому отдаем только публичные ссылки
+	links, err := h.linksRepository.FindByCriteria(ctx, database.FindLinkCriteria{
+		Visibility: listedVisibility("", ""),
+	})
 	if err != nil {
 		return &pb.ListLinkResponse{}, err
 	}
 
 	res := make([]*pb.Link, len(links))
 	for i, l := range links {
-		res[i] = &pb.Link{
-			Id:        l.ID.Hex(),
-			Title:     l.Title,
-			Url:       l.URL,
-			Images:    l.Images,
-			Tags:      l.Tags,
-			UserId:    l.UserID,
-			CreatedAt: l.CreatedAt.String(),
-			UpdatedAt: l.UpdatedAt.String(),
-		}
+		res[i] = LinkToPB(l)
 	}
 	return &pb.ListLinkResponse{Links: res}, err
 }
+
+func (h Handler) GetLinkContent(ctx context.Context, request *pb.GetLinkRequest) (*pb.LinkContent, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	l, err := h.findVisibleLink(ctx, request.Id, viewer(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := h.contentsRepository.FindByLinkID(ctx, l.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Errorf(codes.NotFound, "content of link %s is not extracted yet", request.Id)
+		}
+		return nil, err
+	}
+
+	return &pb.LinkContent{
+		LinkId:             c.LinkID.Hex(),
+		Text:               c.Text,
+		WordCount:          int32(c.WordCount),
+		ReadingTimeMinutes: int32(c.ReadingTime / time.Minute),
+		ExtractedAt:        c.ExtractedAt.String(),
+	}, nil
+}
+
+func (h Handler) ListSnapshots(ctx context.Context, request *pb.GetLinkRequest) (*pb.ListSnapshotsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	l, err := h.findVisibleLink(ctx, request.Id, viewer(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := h.snapshotsRepository.FindByLinkID(ctx, l.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Snapshot, len(snapshots))
+	for i, s := range snapshots {
+		res[i] = snapshotToPB(s)
+	}
+
+	return &pb.ListSnapshotsResponse{Snapshots: res}, nil
+}
+
+func (h Handler) GetSnapshot(ctx context.Context, request *pb.GetSnapshotRequest) (*pb.SnapshotData, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	l, err := h.findVisibleLink(ctx, request.LinkId, viewer(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// Проверяем принадлежность снимка ссылке, чтобы по ID одной ссылки нельзя было читать чужие снимки
+	s, err := h.snapshotsRepository.FindByLinkIDAndSHA(ctx, l.ID, request.Sha256)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Errorf(codes.NotFound, "snapshot %s of link %s is not found", request.Sha256, request.LinkId)
+		}
+		return nil, err
+	}
+
+	data, err := h.blobs.Get(ctx, s.SHA256)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "snapshot %s data is missing", request.Sha256)
+		}
+		return nil, err
+	}
+
+	return &pb.SnapshotData{Snapshot: snapshotToPB(s), Data: data}, nil
+}
+
+func existingLink(l database.Link, returnExisting bool) (*pb.CreateLinkResponse, error) {
+	if !returnExisting {
+		if l.DeletedAt != nil {
+			return nil, status.Errorf(codes.AlreadyExists, "link %s with the same url is in trash, restore it", l.ID.Hex())
+		}
+		return nil, status.Errorf(codes.AlreadyExists, "link %s with the same url already exists", l.ID.Hex())
+	}
+
+	return &pb.CreateLinkResponse{Link: LinkToPB(l), Existing: true}, nil
+}
+
+// LinkToPB конвертирует ссылку в модель gRPC, используется и другими сервисами links-srv.
+func LinkToPB(l database.Link) *pb.Link {
+	return &pb.Link{
+		Id:          l.ID.Hex(),
+		Title:       l.Title,
+		Description: l.Description,
+		Url:         l.URL,
+		Images:      l.Images,
+		Tags:        l.Tags,
+		AutoTags:    l.AutoTags,
+		UserId:      l.UserID,
+		Visibility:  string(l.Visibility.Effective()),
+		ReadAt:      optionalTime(l.ReadAt),
+		Favorite:    l.Favorite,
+		Archived:    l.Archived,
+		DeletedAt:   optionalTime(l.DeletedAt),
+		CreatedAt:   l.CreatedAt.String(),
+		UpdatedAt:   l.UpdatedAt.String(),
+	}
+}
+
+func snapshotToPB(s database.Snapshot) *pb.Snapshot {
+	return &pb.Snapshot{
+		Sha256:      s.SHA256,
+		LinkId:      s.LinkID.Hex(),
+		Size:        s.Size,
+		ContentType: s.ContentType,
+		CreatedAt:   s.CreatedAt.String(),
+	}
+}
@@ -0,0 +1,212 @@
+package linkgrpc
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+const (
+	maxNoteBodyLength  = 64 << 10
+	maxNoteQuoteLength = 8 << 10
+)
+
+func (h Handler) CreateNote(ctx context.Context, request *pb.CreateNoteRequest) (*pb.Note, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	l, err := h.findOwnLink(ctx, request.LinkId, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	n := database.Note{LinkID: l.ID, UserID: l.UserID, Body: request.Body, Quote: request.Quote}
+	if (request.Start == nil) != (request.End == nil) {
+		return nil, status.Error(codes.InvalidArgument, "start and end must be set together")
+	}
+	if request.Start != nil {
+		start, end := int(*request.Start), int(*request.End)
+		if n.Quote, err = h.highlight(ctx, l.ID, start, end, n.Quote); err != nil {
+			return nil, err
+		}
+		n.Start, n.End = &start, &end
+	}
+
+	if err := validateNote(n); err != nil {
+		return nil, err
+	}
+
+	n, err = h.notesRepository.Create(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return NoteToPB(n), nil
+}
+
+func (h Handler) ListNotes(ctx context.Context, request *pb.ListNotesRequest) (*pb.ListNotesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	l, err := h.findOwnLink(ctx, request.LinkId, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := h.notesRepository.FindByLinkID(ctx, l.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Note, len(notes))
+	for i, n := range notes {
+		res[i] = NoteToPB(n)
+	}
+
+	return &pb.ListNotesResponse{Notes: res}, nil
+}
+
+func (h Handler) UpdateNote(ctx context.Context, request *pb.UpdateNoteRequest) (*pb.Note, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	n, err := h.findOwnNote(ctx, request.Id, request.LinkId, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	n.Body = request.Body
+	if err := validateNote(n); err != nil {
+		return nil, err
+	}
+
+	n, err = h.notesRepository.Update(ctx, n.ID, n.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return NoteToPB(n), nil
+}
+
+func (h Handler) DeleteNote(ctx context.Context, request *pb.DeleteNoteRequest) (*pb.Empty, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	n, err := h.findOwnNote(ctx, request.Id, request.LinkId, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Empty{}, h.notesRepository.Delete(ctx, n.ID)
+}
+
+// findOwnLink ищет ссылку пользователя. Чужая ссылка считается ненайденной, чтобы не раскрывать ее существование.
+func (h Handler) findOwnLink(ctx context.Context, hex, userID string) (database.Link, error) {
+	if userID == "" {
+		return database.Link{}, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	l, err := h.findVisibleLink(ctx, hex, userID)
+	if err != nil {
+		return l, err
+	}
+
+	if l.UserID != userID {
+		return database.Link{}, status.Errorf(codes.NotFound, "link %s is not found", hex)
+	}
+
+	return l, nil
+}
+
+func (h Handler) findOwnNote(ctx context.Context, hex, linkHex, userID string) (database.Note, error) {
+	l, err := h.findOwnLink(ctx, linkHex, userID)
+	if err != nil {
+		return database.Note{}, err
+	}
+
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return database.Note{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	n, err := h.notesRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return n, status.Errorf(codes.NotFound, "note %s is not found", hex)
+		}
+		return n, err
+	}
+
+	if n.LinkID != l.ID {
+		return database.Note{}, status.Errorf(codes.NotFound, "note %s is not found", hex)
+	}
+
+	return n, nil
+}
+
+// highlight проверяет границы выделения по извлеченному тексту и возвращает выделенный фрагмент.
+// Переданная цитата должна с ним совпадать.
+func (h Handler) highlight(ctx context.Context, linkID primitive.ObjectID, start, end int, quote string) (string, error) {
+	if start < 0 || end <= start {
+		return "", status.Error(codes.InvalidArgument, "highlight must satisfy 0 <= start < end")
+	}
+
+	c, err := h.contentsRepository.FindByLinkID(ctx, linkID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", status.Error(codes.FailedPrecondition, "content of the link is not extracted yet")
+		}
+		return "", err
+	}
+
+	text := []rune(c.Text)
+	if end > len(text) {
+		return "", status.Errorf(codes.OutOfRange, "highlight end %d is beyond content length %d", end, len(text))
+	}
+
+	fragment := string(text[start:end])
+	if quote != "" && quote != fragment {
+		return "", status.Error(codes.InvalidArgument, "quote does not match content at the given offsets")
+	}
+
+	return fragment, nil
+}
+
+func validateNote(n database.Note) error {
+	switch {
+	case n.Body == "" && n.Quote == "":
+		return status.Error(codes.InvalidArgument, "note must have a body or a quote")
+	case len(n.Body) > maxNoteBodyLength:
+		return status.Errorf(codes.InvalidArgument, "note body is longer than %d bytes", maxNoteBodyLength)
+	case len(n.Quote) > maxNoteQuoteLength:
+		return status.Errorf(codes.InvalidArgument, "note quote is longer than %d bytes", maxNoteQuoteLength)
+	default:
+		return nil
+	}
+}
+
+// NoteToPB конвертирует заметку в модель gRPC, используется и выгрузкой данных пользователя.
+func NoteToPB(n database.Note) *pb.Note {
+	res := &pb.Note{
+		Id:        n.ID.Hex(),
+		LinkId:    n.LinkID.Hex(),
+		UserId:    n.UserID,
+		Body:      n.Body,
+		Quote:     n.Quote,
+		CreatedAt: n.CreatedAt.String(),
+		UpdatedAt: n.UpdatedAt.String(),
+	}
+	if n.Start != nil && n.End != nil {
+		start, end := int32(*n.Start), int32(*n.End)
+		res.Start, res.End = &start, &end
+	}
+
+	return res
+}
@@ -0,0 +1,86 @@
+package linkgrpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+// maxKnownOwners - размер кэша, после которого из него вычищаются устаревшие записи.
+const maxKnownOwners = 10000
+
+// ownerCache проверяет в users-srv, что владелец ссылки существует, и помнит найденных пользователей ttl,
+// чтобы не обращаться к users-srv при каждом сохранении ссылки. Отсутствующие пользователи не кэшируются:
+// только что созданный пользователь должен сразу получить возможность сохранять ссылки.
+type ownerCache struct {
+	users usersClient
+	ttl   time.Duration
+
+	mu    sync.Mutex
+	known map[string]time.Time // ID пользователя -> когда запись устареет
+}
+
+func newOwnerCache(users usersClient, ttl time.Duration) *ownerCache {
+	return &ownerCache{users: users, ttl: ttl, known: make(map[string]time.Time)}
+}
+
+// check возвращает FailedPrecondition, если пользователя userID нет в users-srv.
+func (c *ownerCache) check(ctx context.Context, userID string) error {
+	if c.cached(userID) {
+		return nil
+	}
+
+	_, err := c.users.GetUser(ctx, &pb.GetUserRequest{Id: userID})
+	switch status.Code(err) {
+	case codes.OK:
+		c.remember(userID)
+		return nil
+	case codes.NotFound:
+		return status.Errorf(codes.FailedPrecondition, "owner %s does not exist", userID)
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return status.Error(codes.Unavailable, "cannot check link owner: users service is unavailable")
+	default:
+		return status.Errorf(codes.Internal, "cannot check link owner: %v", err)
+	}
+}
+
+func (c *ownerCache) cached(userID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires, ok := c.known[userID]
+	return ok && time.Now().Before(expires)
+}
+
+func (c *ownerCache) remember(userID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.known) >= maxKnownOwners {
+		for id, expires := range c.known {
+			if !now.Before(expires) {
+				delete(c.known, id)
+			}
+		}
+	}
+
+	c.known[userID] = now.Add(c.ttl)
+}
+
+// ForgetOwner убирает пользователя из кэша владельцев после его удаления в users-srv.
+func (h Handler) ForgetOwner(userID string) {
+	h.owners.forget(userID)
+}
+
+func (c *ownerCache) forget(userID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.known, userID)
+}
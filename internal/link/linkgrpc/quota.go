@@ -0,0 +1,39 @@
+package linkgrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkQuota возвращает ResourceExhausted с деталями QuotaFailure, если пользователь уже хранит maxLinks ссылок.
+// Ссылки в корзине тоже считаются: они занимают место до очистки.
+func (h Handler) checkQuota(ctx context.Context, userID string) error {
+	if h.maxLinks <= 0 {
+		return nil
+	}
+
+	n, err := h.linksRepository.CountByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if n < h.maxLinks {
+		return nil
+	}
+
+	msg := fmt.Sprintf("link quota exceeded: user %s already stores %d of %d links", userID, n, h.maxLinks)
+	st, err := status.New(codes.ResourceExhausted, msg).WithDetails(&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     "user:" + userID,
+			Description: fmt.Sprintf("at most %d links including trash", h.maxLinks),
+		}},
+	})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, msg)
+	}
+
+	return st.Err()
+}
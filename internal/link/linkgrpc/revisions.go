@@ -0,0 +1,121 @@
+package linkgrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func (h Handler) ListLinkRevisions(
+	ctx context.Context, request *pb.ListLinkRevisionsRequest,
+) (*pb.ListLinkRevisionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	l, err := h.findOwnLink(ctx, request.LinkId, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := h.linksRepository.FindRevisions(ctx, l.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.LinkRevision, len(revisions))
+	for i, rev := range revisions {
+		if res[i], err = revisionToPB(rev); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListLinkRevisionsResponse{Revisions: res}, nil
+}
+
+func (h Handler) RevertLink(ctx context.Context, request *pb.RevertLinkRequest) (*pb.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	l, err := h.findOwnLink(ctx, request.LinkId, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	revID, err := primitive.ObjectIDFromHex(request.RevisionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rev, err := h.linksRepository.FindRevision(ctx, l.ID, revID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Errorf(codes.NotFound, "revision %s is not found", request.RevisionId)
+		}
+		return nil, err
+	}
+
+	// Канонический адрес из обогащения сохраняется, если ревизия не меняет URL
+	normalizedURL := l.NormalizedURL
+	if rev.Snapshot.URL != l.URL || normalizedURL == "" {
+		if normalizedURL, err = h.urls.Normalize(rev.Snapshot.URL); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	link, err := h.linksRepository.Update(ctx, database.UpdateLinkReq{
+		ID:            l.ID,
+		Title:         rev.Snapshot.Title,
+		Description:   rev.Snapshot.Description,
+		URL:           rev.Snapshot.URL,
+		NormalizedURL: normalizedURL,
+		Images:        rev.Snapshot.Images,
+		Tags:          rev.Snapshot.Tags,
+		AutoTags:      rev.Snapshot.AutoTags,
+		UserID:        l.UserID,
+		Visibility:    rev.Snapshot.Visibility,
+		Revisor:       database.Revisor{Actor: request.UserId, Source: database.RevisionSourceUser},
+	})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Errorf(codes.NotFound, "link %s is not found", request.LinkId)
+		}
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, status.Error(codes.AlreadyExists, "another link with the same url already exists")
+		}
+		return nil, err
+	}
+
+	return LinkToPB(link), nil
+}
+
+func revisionToPB(rev database.LinkRevision) (*pb.LinkRevision, error) {
+	changes := make([]*pb.FieldChange, len(rev.Changes))
+	for i, c := range rev.Changes {
+		oldValue, err := json.Marshal(c.Old)
+		if err != nil {
+			return nil, err
+		}
+		newValue, err := json.Marshal(c.New)
+		if err != nil {
+			return nil, err
+		}
+		changes[i] = &pb.FieldChange{Field: c.Field, Old: string(oldValue), New: string(newValue)}
+	}
+
+	return &pb.LinkRevision{
+		Id:        rev.ID.Hex(),
+		LinkId:    rev.LinkID.Hex(),
+		Actor:     rev.Actor,
+		Source:    string(rev.Source),
+		Changes:   changes,
+		CreatedAt: rev.CreatedAt.String(),
+	}, nil
+}
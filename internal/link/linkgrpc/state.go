@@ -0,0 +1,62 @@
+package linkgrpc
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+// maxStateIDs ограничивает число ссылок в одном запросе на смену состояния.
+const maxStateIDs = 1000
+
+// UpdateLinksState отмечает ссылки пользователя прочитанными, избранными или архивными.
+// Ссылки других пользователей и несуществующие ID пропускаются, их видно по matched.
+func (h Handler) UpdateLinksState(
+	ctx context.Context, request *pb.UpdateLinksStateRequest,
+) (*pb.UpdateLinksStateResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if len(request.Ids) == 0 || len(request.Ids) > maxStateIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "ids must contain from 1 to %d items", maxStateIDs)
+	}
+
+	change := database.LinkStateChange{Read: request.Read, Favorite: request.Favorite, Archived: request.Archived}
+	if change.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "nothing to change")
+	}
+
+	ids := make([]primitive.ObjectID, len(request.Ids))
+	for i, hex := range request.Ids {
+		id, err := primitive.ObjectIDFromHex(hex)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid id %q", hex)
+		}
+		ids[i] = id
+	}
+
+	matched, err := h.linksRepository.UpdateState(ctx, request.UserId, ids, change)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateLinksStateResponse{Matched: matched}, nil
+}
+
+// optionalTime форматирует необязательную отметку времени, пустая строка означает, что ее нет.
+func optionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.String()
+}
@@ -0,0 +1,141 @@
+package linkgrpc
+
+import (
+	"context"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func (h Handler) FindLinks(ctx context.Context, request *pb.FindLinksRequest) (*pb.ListLinkResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	criteria := database.FindLinkCriteria{
+		Tags:        h.tags.NormalizeAll(request.Tags),
+		ExcludeTags: h.tags.NormalizeAll(request.ExcludeTags),
+	}
+	if request.UserId != "" {
+		criteria.UserID = &request.UserId
+	}
+	criteria.Visibility = listedVisibility(request.UserId, viewer(ctx))
+	criteria.Read, criteria.Favorite, criteria.Archived = request.Read, request.Favorite, request.Archived
+	if request.MatchAllTags {
+		criteria.TagsMode = database.TagsMatchAll
+	}
+	if request.Limit > 0 {
+		criteria.Limit = &request.Limit
+	}
+	if request.Offset > 0 {
+		criteria.Offset = &request.Offset
+	}
+	criteria.NewestFirst = request.NewestFirst
+
+	// Заметки личные, поэтому по ним ищет только владелец
+	var notes map[primitive.ObjectID][]*pb.Note
+	if criteria.Query = strings.TrimSpace(request.Query); criteria.Query != "" && criteria.Visibility == nil {
+		found, err := h.notesRepository.FindByText(ctx, request.UserId, criteria.Query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		notes = make(map[primitive.ObjectID][]*pb.Note, len(found))
+		for _, n := range found {
+			if _, ok := notes[n.LinkID]; !ok {
+				criteria.QueryIDs = append(criteria.QueryIDs, n.LinkID)
+			}
+			notes[n.LinkID] = append(notes[n.LinkID], NoteToPB(n))
+		}
+	}
+
+	links, err := h.linksRepository.FindByCriteria(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Link, len(links))
+	for i, l := range links {
+		res[i] = LinkToPB(l)
+		res[i].Notes = notes[l.ID]
+	}
+
+	return &pb.ListLinkResponse{Links: res}, nil
+}
+
+func (h Handler) ListTags(ctx context.Context, request *pb.GetLinksByUserId) (*pb.ListTagsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	counts, err := h.linksRepository.TagCounts(ctx, request.UserId, listedVisibility(request.UserId, viewer(ctx)))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.TagCount, len(counts))
+	for i, c := range counts {
+		res[i] = &pb.TagCount{Tag: c.Tag, Count: c.Count}
+	}
+
+	return &pb.ListTagsResponse{Tags: res}, nil
+}
+
+func (h Handler) RenameTag(ctx context.Context, request *pb.RenameTagRequest) (*pb.UpdateTagsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	from, to := h.tags.Normalize(request.From), h.tags.Normalize(request.To)
+	if request.UserId == "" || from == "" || to == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id, from and to are required")
+	}
+
+	modified, err := h.linksRepository.RenameTag(ctx, request.UserId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateTagsResponse{Modified: modified}, nil
+}
+
+func (h Handler) MergeTags(ctx context.Context, request *pb.MergeTagsRequest) (*pb.UpdateTagsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	from, to := h.tags.NormalizeAll(request.From), h.tags.Normalize(request.To)
+	if request.UserId == "" || len(from) == 0 || to == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id, from and to are required")
+	}
+
+	modified, err := h.linksRepository.MergeTags(ctx, request.UserId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateTagsResponse{Modified: modified}, nil
+}
+
+func (h Handler) DeleteTag(ctx context.Context, request *pb.DeleteTagRequest) (*pb.UpdateTagsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	tag := h.tags.Normalize(request.Tag)
+	if request.UserId == "" || tag == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and tag are required")
+	}
+
+	modified, err := h.linksRepository.DeleteTag(ctx, request.UserId, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateTagsResponse{Modified: modified}, nil
+}
@@ -0,0 +1,89 @@
+package linkgrpc
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func (h Handler) ListDeletedLinks(ctx context.Context, request *pb.GetLinksByUserId) (*pb.ListLinkResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	links, err := h.linksRepository.FindDeletedByUserID(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Link, len(links))
+	for i, l := range links {
+		res[i] = LinkToPB(l)
+	}
+
+	return &pb.ListLinkResponse{Links: res}, nil
+}
+
+func (h Handler) RestoreLink(ctx context.Context, request *pb.RestoreLinkRequest) (*pb.Link, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	id, err := primitive.ObjectIDFromHex(request.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	link, err := h.linksRepository.Restore(ctx, id, request.UserId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Errorf(codes.NotFound, "link %s is not in the trash", request.Id)
+		}
+		return nil, err
+	}
+
+	return LinkToPB(link), nil
+}
+
+// PurgeLink безвозвратно удаляет ссылку вместе со всем, что к ней относится. Вызывается при очистке корзины.
+func (h Handler) PurgeLink(ctx context.Context, id primitive.ObjectID) error {
+	if err := h.contentsRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	if err := h.notesRepository.DeleteByLinkID(ctx, id); err != nil {
+		return err
+	}
+
+	// Блобы не удаляем: одинаковые снимки могут принадлежать разным ссылкам
+	if err := h.snapshotsRepository.DeleteByLinkID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := h.collectionsRepository.RemoveLinkEverywhere(ctx, id); err != nil {
+		return err
+	}
+
+	if err := h.sharesRepository.DeleteByTarget(ctx, id); err != nil {
+		return err
+	}
+
+	if err := h.slugsRepository.DeleteByLinkID(ctx, id, ""); err != nil {
+		return err
+	}
+
+	if err := h.clicksRepository.DeleteByLinkID(ctx, id); err != nil {
+		return err
+	}
+
+	// Сама ссылка удаляется последней, чтобы прерванная очистка повторилась при следующем проходе
+	return h.linksRepository.Delete(ctx, id)
+}
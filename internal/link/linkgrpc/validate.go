@@ -0,0 +1,57 @@
+package linkgrpc
+
+import (
+	"fmt"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/validation"
+)
+
+// Ограничения полей ссылки совпадают со спецификацией api-gw, но проверяются и здесь,
+// потому что к links-srv можно обратиться по gRPC напрямую.
+const (
+	maxURLLength         = 2048
+	maxTitleLength       = 1024
+	maxDescriptionLength = 8192
+	maxTags              = 50
+	maxTagLength         = 50
+	maxImages            = 20
+)
+
+// linkInput - поля ссылки из запросов на создание и изменение.
+type linkInput struct {
+	UserID      string
+	URL         string
+	Title       string
+	Description string
+	Tags        []string
+	Images      []string
+}
+
+func validateLink(in linkInput) error {
+	var errs validation.Errors
+
+	errs.UUID("user_id", in.UserID)
+	errs.HTTPURL("url", in.URL, maxURLLength)
+	errs.MaxLength("title", in.Title, maxTitleLength)
+	errs.MaxLength("description", in.Description, maxDescriptionLength)
+
+	if len(in.Tags) > maxTags {
+		errs.Add("tags", "must contain at most %d tags", maxTags)
+	}
+	for i, tag := range in.Tags {
+		field := fmt.Sprintf("tags.%d", i)
+		if tag == "" {
+			errs.Add(field, "must not be empty")
+		}
+		errs.MaxLength(field, tag, maxTagLength)
+	}
+
+	if len(in.Images) > maxImages {
+		errs.Add("images", "must contain at most %d images", maxImages)
+	}
+	for i, image := range in.Images {
+		errs.HTTPURL(fmt.Sprintf("images.%d", i), image, maxURLLength)
+	}
+
+	return errs.Err()
+}
@@ -0,0 +1,186 @@
+package linkgrpc
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
+)
+
+const (
+	publicLinksLimit    = 20
+	publicLinksMaxLimit = 100
+)
+
+// visibleTo сообщает, доступна ли ссылка по ID: приватная только владельцу, остальные всем.
+func visibleTo(l database.Link, viewerID string) bool {
+	return (viewerID != "" && l.UserID == viewerID) || l.Visibility.Effective() != database.VisibilityPrivate
+}
+
+// viewer возвращает автора запроса из метаданных вызова, пустая строка означает анонимный запрос.
+func viewer(ctx context.Context) string {
+	return reqmeta.FromIncoming(ctx).ActorID
+}
+
+// listedVisibility возвращает ограничение для списков ссылок ownerID: владелец видит все свои ссылки,
+// остальные только публичные.
+func listedVisibility(ownerID, viewerID string) *database.Visibility {
+	if ownerID != "" && ownerID == viewerID {
+		return nil
+	}
+
+	public := database.VisibilityPublic
+
+	return &public
+}
+
+// findVisibleLink ищет ссылку и скрывает недоступную так же, как отсутствующую, чтобы не раскрывать ее существование.
+func (h Handler) findVisibleLink(ctx context.Context, hex, viewerID string) (database.Link, error) {
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return database.Link{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	l, err := h.linksRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return l, status.Errorf(codes.NotFound, "link %s is not found", hex)
+		}
+		return l, err
+	}
+
+	if !visibleTo(l, viewerID) {
+		return database.Link{}, status.Errorf(codes.NotFound, "link %s is not found", hex)
+	}
+
+	return l, nil
+}
+
+// visibilityOrDefault проверяет уровень доступа из запроса, а пустой заменяет на fallback.
+func visibilityOrDefault(raw string, fallback database.Visibility) (database.Visibility, error) {
+	if raw == "" {
+		return fallback.Effective(), nil
+	}
+
+	v := database.Visibility(raw)
+	if !v.Valid() {
+		return "", status.Errorf(codes.InvalidArgument, "unknown visibility %q", raw)
+	}
+
+	return v, nil
+}
+
+// visibility возвращает уровень доступа новой ссылки: из запроса или по умолчанию из настроек владельца.
+func (h Handler) visibility(ctx context.Context, raw, userID string) (database.Visibility, error) {
+	if raw != "" {
+		return visibilityOrDefault(raw, "")
+	}
+
+	s, err := h.settingsRepository.Get(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.DefaultVisibility.Effective(), nil
+}
+
+func (h Handler) ListPublicLinks(ctx context.Context, request *pb.ListPublicLinksRequest) (*pb.ListLinkResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	limit := request.Limit
+	switch {
+	case limit <= 0:
+		limit = publicLinksLimit
+	case limit > publicLinksMaxLimit:
+		limit = publicLinksMaxLimit
+	}
+	offset := max(request.Offset, 0)
+
+	links, err := h.linksRepository.FindByCriteria(ctx, database.FindLinkCriteria{
+		Visibility:  listedVisibility("", ""),
+		NewestFirst: true,
+		Limit:       &limit,
+		Offset:      &offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Link, len(links))
+	for i, l := range links {
+		res[i] = LinkToPB(l)
+	}
+
+	return &pb.ListLinkResponse{Links: res}, nil
+}
+
+// requireOwner пропускает только запросы самого пользователя userID: настройки и выгрузка ссылок доступны лишь ему.
+func requireOwner(ctx context.Context, userID string) error {
+	actor := viewer(ctx)
+	if actor == "" {
+		return status.Error(codes.Unauthenticated, "actor is required")
+	}
+
+	if actor != userID {
+		return status.Errorf(codes.PermissionDenied, "data of user %s is available to the user only", userID)
+	}
+
+	return nil
+}
+
+func (h Handler) GetLinkSettings(ctx context.Context, request *pb.GetLinksByUserId) (*pb.LinkSettings, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := requireOwner(ctx, request.UserId); err != nil {
+		return nil, err
+	}
+
+	s, err := h.settingsRepository.Get(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return settingsToPB(s), nil
+}
+
+func (h Handler) UpdateLinkSettings(ctx context.Context, request *pb.LinkSettings) (*pb.LinkSettings, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := requireOwner(ctx, request.UserId); err != nil {
+		return nil, err
+	}
+
+	v := database.Visibility(request.DefaultVisibility)
+	if !v.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown visibility %q", request.DefaultVisibility)
+	}
+
+	s, err := h.settingsRepository.Upsert(ctx, database.LinkSettings{UserID: request.UserId, DefaultVisibility: v})
+	if err != nil {
+		return nil, err
+	}
+
+	return settingsToPB(s), nil
+}
+
+func settingsToPB(s database.LinkSettings) *pb.LinkSettings {
+	return &pb.LinkSettings{UserId: s.UserID, DefaultVisibility: string(s.DefaultVisibility)}
+}
@@ -0,0 +1,27 @@
+package sharegrpc
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+type sharesRepository interface {
+	Create(ctx context.Context, s database.Share) (database.Share, error)
+	FindByID(ctx context.Context, id primitive.ObjectID) (database.Share, error)
+	FindByToken(ctx context.Context, token string) (database.Share, error)
+	FindByUserID(ctx context.Context, userID string) ([]database.Share, error)
+	Revoke(ctx context.Context, id primitive.ObjectID) (database.Share, error)
+	IncrementViews(ctx context.Context, id primitive.ObjectID) (int64, error)
+}
+
+type collectionsRepository interface {
+	FindByID(ctx context.Context, id primitive.ObjectID) (database.Collection, error)
+}
+
+type linksRepository interface {
+	FindByID(ctx context.Context, id primitive.ObjectID) (database.Link, error)
+	FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]database.Link, error)
+}
@@ -0,0 +1,344 @@
+package sharegrpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
+)
+
+// tokenSize задает число случайных байт токена: 192 бита не перебрать.
+const tokenSize = 24
+
+var _ pb.ShareServiceServer = (*Handler)(nil)
+
+func New(
+	sharesRepository sharesRepository,
+	collectionsRepository collectionsRepository,
+	linksRepository linksRepository,
+	timeout time.Duration,
+) *Handler {
+	return &Handler{
+		sharesRepository:      sharesRepository,
+		collectionsRepository: collectionsRepository,
+		linksRepository:       linksRepository,
+		timeout:               timeout,
+	}
+}
+
+type Handler struct {
+	pb.UnimplementedShareServiceServer
+	sharesRepository      sharesRepository
+	collectionsRepository collectionsRepository
+	linksRepository       linksRepository
+	timeout               time.Duration
+}
+
+func (h Handler) CreateShare(ctx context.Context, request *pb.CreateShareRequest) (*pb.Share, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if (request.CollectionId == "") == (request.LinkId == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of collection_id and link_id is required")
+	}
+
+	if request.TtlSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "ttl_seconds must not be negative")
+	}
+
+	s := database.Share{ID: primitive.NewObjectID(), UserID: request.UserId}
+
+	owner, err := h.target(ctx, &s, request.CollectionId, request.LinkId)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner != request.UserId {
+		return nil, status.Error(codes.PermissionDenied, "shared object belongs to another user")
+	}
+
+	if s.Token, err = newToken(); err != nil {
+		return nil, err
+	}
+
+	if request.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.PasswordHash = string(hash)
+	}
+
+	if request.TtlSeconds > 0 {
+		expiresAt := time.Now().Add(time.Duration(request.TtlSeconds) * time.Second)
+		s.ExpiresAt = &expiresAt
+	}
+
+	s, err = h.sharesRepository.Create(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return shareToPB(s), nil
+}
+
+func (h Handler) ListShares(ctx context.Context, request *pb.ListSharesRequest) (*pb.ListSharesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	actor, err := requireActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if actor != request.UserId {
+		return nil, status.Error(codes.PermissionDenied, "shares belong to another user")
+	}
+
+	shares, err := h.sharesRepository.FindByUserID(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Share, len(shares))
+	for i, s := range shares {
+		res[i] = shareToPB(s)
+	}
+
+	return &pb.ListSharesResponse{Shares: res}, nil
+}
+
+// RevokeShare отзывает ссылку доступа владельца. Чужие ссылки доступа неотличимы от несуществующих.
+func (h Handler) RevokeShare(ctx context.Context, request *pb.RevokeShareRequest) (*pb.Share, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	id, err := primitive.ObjectIDFromHex(request.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	actor, err := requireActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notFound := status.Errorf(codes.NotFound, "share %s is not found", request.Id)
+
+	// Владелец ссылки доступа - владелец объекта, это проверяется при ее создании
+	s, err := h.sharesRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, notFound
+		}
+		return nil, err
+	}
+
+	if s.UserID != actor {
+		return nil, notFound
+	}
+
+	if s, err = h.sharesRepository.Revoke(ctx, id); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, notFound
+		}
+		return nil, err
+	}
+
+	return shareToPB(s), nil
+}
+
+// OpenShare отдает содержимое по токену. Отозванные, просроченные и несуществующие ссылки доступа
+// неотличимы друг от друга, чтобы токены нельзя было проверять перебором.
+func (h Handler) OpenShare(ctx context.Context, request *pb.OpenShareRequest) (*pb.SharedContent, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	notFound := status.Error(codes.NotFound, "share is not found")
+
+	if request.Token == "" {
+		return nil, notFound
+	}
+
+	s, err := h.sharesRepository.FindByToken(ctx, request.Token)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, notFound
+		}
+		return nil, err
+	}
+
+	if !s.Active(time.Now()) {
+		return nil, notFound
+	}
+
+	if s.PasswordHash != "" {
+		if request.Password == "" {
+			return nil, status.Error(codes.Unauthenticated, "share is protected by password")
+		}
+
+		if bcrypt.CompareHashAndPassword([]byte(s.PasswordHash), []byte(request.Password)) != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid password")
+		}
+	}
+
+	res, err := h.content(ctx, s)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, notFound
+		}
+		return nil, err
+	}
+
+	if res.Views, err = h.sharesRepository.IncrementViews(ctx, s.ID); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// requireActor возвращает пользователя, от имени которого api-gw выполняет запрос.
+func requireActor(ctx context.Context) (string, error) {
+	actor := reqmeta.FromIncoming(ctx).ActorID
+	if actor == "" {
+		return "", status.Error(codes.Unauthenticated, "actor is required")
+	}
+
+	return actor, nil
+}
+
+// target заполняет объект ссылки доступа и возвращает его владельца.
+func (h Handler) target(ctx context.Context, s *database.Share, collectionHex, linkHex string) (string, error) {
+	if collectionHex != "" {
+		id, err := primitive.ObjectIDFromHex(collectionHex)
+		if err != nil {
+			return "", status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		c, err := h.collectionsRepository.FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return "", status.Errorf(codes.NotFound, "collection %s is not found", collectionHex)
+			}
+			return "", err
+		}
+
+		s.CollectionID = &id
+
+		return c.UserID, nil
+	}
+
+	id, err := primitive.ObjectIDFromHex(linkHex)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	l, err := h.linksRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", status.Errorf(codes.NotFound, "link %s is not found", linkHex)
+		}
+		return "", err
+	}
+
+	s.LinkID = &id
+
+	return l.UserID, nil
+}
+
+func (h Handler) content(ctx context.Context, s database.Share) (*pb.SharedContent, error) {
+	if s.LinkID != nil {
+		l, err := h.linksRepository.FindByID(ctx, *s.LinkID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.SharedContent{Name: l.Title, Links: []*pb.SharedLink{sharedLinkToPB(l)}}, nil
+	}
+
+	c, err := h.collectionsRepository.FindByID(ctx, *s.CollectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := h.linksRepository.FindByIDs(ctx, c.LinkIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.SharedLink, len(links))
+	for i, l := range links {
+		res[i] = sharedLinkToPB(l)
+	}
+
+	return &pb.SharedContent{Name: c.Name, Description: c.Description, Links: res}, nil
+}
+
+func newToken() (string, error) {
+	b := make([]byte, tokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("rand Read: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func shareToPB(s database.Share) *pb.Share {
+	res := &pb.Share{
+		Id:          s.ID.Hex(),
+		Token:       s.Token,
+		UserId:      s.UserID,
+		HasPassword: s.PasswordHash != "",
+		Views:       s.Views,
+		CreatedAt:   s.CreatedAt.String(),
+	}
+
+	if s.CollectionID != nil {
+		res.CollectionId = s.CollectionID.Hex()
+	}
+
+	if s.LinkID != nil {
+		res.LinkId = s.LinkID.Hex()
+	}
+
+	if s.ExpiresAt != nil {
+		res.ExpiresAt = s.ExpiresAt.String()
+	}
+
+	if s.RevokedAt != nil {
+		res.RevokedAt = s.RevokedAt.String()
+	}
+
+	return res
+}
+
+// sharedLinkToPB отбрасывает поля, раскрывающие владельца ссылки.
+func sharedLinkToPB(l database.Link) *pb.SharedLink {
+	return &pb.SharedLink{
+		Title:     l.Title,
+		Url:       l.URL,
+		Images:    l.Images,
+		Tags:      l.Tags,
+		CreatedAt: l.CreatedAt.String(),
+	}
+}
@@ -0,0 +1,29 @@
+package shortgrpc
+
+import (
+	"context"
+	"net/netip"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+type slugsRepository interface {
+	Create(ctx context.Context, s database.Slug) (database.Slug, error)
+	FindBySlug(ctx context.Context, slug string) (database.Slug, error)
+	DeleteByLinkID(ctx context.Context, linkID primitive.ObjectID, except string) error
+}
+
+type clicksRepository interface {
+	Create(ctx context.Context, c database.Click) error
+	Stats(ctx context.Context, linkID primitive.ObjectID) (database.LinkStats, error)
+}
+
+type linksRepository interface {
+	FindByID(ctx context.Context, id primitive.ObjectID) (database.Link, error)
+}
+
+type geoResolver interface {
+	Country(addr netip.Addr) string
+}
@@ -0,0 +1,240 @@
+package shortgrpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/netip"
+	"net/url"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/slug"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/useragent"
+)
+
+// generateAttempts ограничивает число попыток подобрать свободный случайный slug.
+const generateAttempts = 5
+
+// directReferrer обозначает переходы без заголовка Referer.
+const directReferrer = "direct"
+
+var _ pb.ShortenerServiceServer = (*Handler)(nil)
+
+func New(
+	slugsRepository slugsRepository,
+	clicksRepository clicksRepository,
+	linksRepository linksRepository,
+	geo geoResolver,
+	slugLength int,
+	timeout time.Duration,
+) *Handler {
+	return &Handler{
+		slugsRepository:  slugsRepository,
+		clicksRepository: clicksRepository,
+		linksRepository:  linksRepository,
+		geo:              geo,
+		slugLength:       slugLength,
+		timeout:          timeout,
+	}
+}
+
+type Handler struct {
+	pb.UnimplementedShortenerServiceServer
+	slugsRepository  slugsRepository
+	clicksRepository clicksRepository
+	linksRepository  linksRepository
+	geo              geoResolver
+	slugLength       int
+	timeout          time.Duration
+}
+
+// SetLinkSlug назначает ссылке автора запроса slug, заменяя прежний. Пустой slug генерируется случайно.
+func (h Handler) SetLinkSlug(ctx context.Context, request *pb.SetLinkSlugRequest) (*pb.LinkSlug, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	if request.Slug != "" && !slug.Valid(request.Slug) {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "slug must be %d-%d latin letters, digits, '-' or '_'", slug.MinLength, slug.MaxLength,
+		)
+	}
+
+	l, err := h.findOwnLink(ctx, request.LinkId)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := h.createSlug(ctx, l, request.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.slugsRepository.DeleteByLinkID(ctx, l.ID, s.Slug); err != nil {
+		return nil, err
+	}
+
+	return &pb.LinkSlug{LinkId: l.ID.Hex(), Slug: s.Slug}, nil
+}
+
+// ResolveSlug возвращает адрес для перехода и записывает клик. Ошибка записи клика не мешает переходу.
+// Короткие ссылки работают для unlisted и public ссылок.
+func (h Handler) ResolveSlug(ctx context.Context, request *pb.ResolveSlugRequest) (*pb.ResolveSlugResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	s, err := h.slugsRepository.FindBySlug(ctx, request.Slug)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Errorf(codes.NotFound, "slug %s is not found", request.Slug)
+		}
+		return nil, err
+	}
+
+	l, err := h.linksRepository.FindByID(ctx, s.LinkID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Errorf(codes.NotFound, "slug %s is not found", request.Slug)
+		}
+		return nil, err
+	}
+
+	// Переход по короткой ссылке анонимный, поэтому приватная ссылка ведет себя как удаленная
+	if l.Visibility.Effective() == database.VisibilityPrivate {
+		return nil, status.Errorf(codes.NotFound, "slug %s is not found", request.Slug)
+	}
+
+	click := database.Click{
+		ID:        primitive.NewObjectID(),
+		LinkID:    l.ID,
+		At:        time.Now().UTC(),
+		Referrer:  referrerHost(request.Referrer),
+		UserAgent: useragent.Family(request.UserAgent),
+	}
+	if addr, err := netip.ParseAddr(request.RemoteIp); err == nil {
+		click.Country = h.geo.Country(addr)
+	}
+
+	if err := h.clicksRepository.Create(ctx, click); err != nil {
+		slog.Error("cannot record click", slog.String("slug", request.Slug), slog.Any("err", err))
+	}
+
+	return &pb.ResolveSlugResponse{Url: l.URL}, nil
+}
+
+func (h Handler) GetLinkStats(ctx context.Context, request *pb.GetLinkRequest) (*pb.LinkStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	l, err := h.findOwnLink(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := h.clicksRepository.Stats(ctx, l.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return StatsToPB(l.ID, stats), nil
+}
+
+// StatsToPB конвертирует статистику переходов в модель gRPC, используется и выгрузкой данных пользователя.
+func StatsToPB(linkID primitive.ObjectID, stats database.LinkStats) *pb.LinkStats {
+	return &pb.LinkStats{
+		LinkId:      linkID.Hex(),
+		Total:       stats.Total,
+		ByDay:       bucketsToPB(stats.ByDay),
+		ByReferrer:  bucketsToPB(stats.ByReferrer),
+		ByUserAgent: bucketsToPB(stats.ByUserAgent),
+		ByCountry:   bucketsToPB(stats.ByCountry),
+	}
+}
+
+func (h Handler) findLink(ctx context.Context, hex string) (database.Link, error) {
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return database.Link{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	l, err := h.linksRepository.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return l, status.Errorf(codes.NotFound, "link %s is not found", hex)
+		}
+		return l, err
+	}
+
+	return l, nil
+}
+
+// findOwnLink ищет ссылку автора запроса. Чужие ссылки неотличимы от несуществующих.
+func (h Handler) findOwnLink(ctx context.Context, hex string) (database.Link, error) {
+	actor := reqmeta.FromIncoming(ctx).ActorID
+	if actor == "" {
+		return database.Link{}, status.Error(codes.Unauthenticated, "actor is required")
+	}
+
+	l, err := h.findLink(ctx, hex)
+	if err != nil {
+		return l, err
+	}
+
+	if l.UserID != actor {
+		return database.Link{}, status.Errorf(codes.NotFound, "link %s is not found", hex)
+	}
+
+	return l, nil
+}
+
+func (h Handler) createSlug(ctx context.Context, l database.Link, custom string) (database.Slug, error) {
+	if custom != "" {
+		s, err := h.slugsRepository.Create(ctx, database.Slug{Slug: custom, LinkID: l.ID, UserID: l.UserID})
+		if mongo.IsDuplicateKeyError(err) {
+			return s, status.Errorf(codes.AlreadyExists, "slug %s is already taken", custom)
+		}
+		return s, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		generated, err := slug.Generate(h.slugLength)
+		if err != nil {
+			return database.Slug{}, err
+		}
+
+		s, err := h.slugsRepository.Create(ctx, database.Slug{Slug: generated, LinkID: l.ID, UserID: l.UserID})
+		if !mongo.IsDuplicateKeyError(err) {
+			return s, err
+		}
+
+		if attempt == generateAttempts-1 {
+			return s, status.Error(codes.Aborted, "cannot generate unique slug, try a custom one")
+		}
+	}
+}
+
+// referrerHost оставляет от Referer только хост: полный адрес может содержать приватные данные.
+func referrerHost(referrer string) string {
+	u, err := url.Parse(referrer)
+	if err != nil || u.Host == "" {
+		return directReferrer
+	}
+
+	return u.Hostname()
+}
+
+func bucketsToPB(buckets []database.StatBucket) []*pb.StatBucket {
+	res := make([]*pb.StatBucket, len(buckets))
+	for i, b := range buckets {
+		res[i] = &pb.StatBucket{Key: b.Key, Count: b.Count}
+	}
+
+	return res
+}
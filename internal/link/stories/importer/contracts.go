@@ -0,0 +1,48 @@
+package importer
+
+import (
+	"context"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+type jobsRepository interface {
+	ClaimPending(ctx context.Context) (database.ImportJob, error)
+	ResetRunning(ctx context.Context) error
+	UpdateProgress(ctx context.Context, job database.ImportJob) error
+}
+
+type linksRepository interface {
+	Create(ctx context.Context, req database.CreateLinkReq) (database.Link, error)
+	FindByUserAndURL(ctx context.Context, normalizedURL, userID string) (database.Link, error)
+	CountByUserID(ctx context.Context, userID string) (int64, error)
+}
+
+type collectionsRepository interface {
+	Create(ctx context.Context, req database.CreateCollectionReq) (database.Collection, error)
+	FindByUserID(ctx context.Context, userID string) ([]database.Collection, error)
+	AddLink(ctx context.Context, id, linkID primitive.ObjectID) error
+}
+
+type settingsRepository interface {
+	Get(ctx context.Context, userID string) (database.LinkSettings, error)
+}
+
+type blobStore interface {
+	Get(ctx context.Context, sum string) ([]byte, error)
+}
+
+type tagNormalizer interface {
+	NormalizeAll(tags []string) []string
+}
+
+type urlNormalizer interface {
+	Normalize(raw string) (string, error)
+}
+
+type amqpPublisher interface {
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+}
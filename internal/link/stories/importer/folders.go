@@ -0,0 +1,80 @@
+package importer
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+// folders сопоставляет папкам закладок коллекции пользователя, создавая недостающие.
+// Коллекция ищется по имени среди детей родительской, поэтому повторный импорт не плодит копии.
+type folders struct {
+	collections collectionsRepository
+	userID      string
+	byKey       map[folderKey]primitive.ObjectID
+}
+
+type folderKey struct {
+	parent primitive.ObjectID // нулевой для коллекций верхнего уровня
+	name   string
+}
+
+func newFolders(ctx context.Context, collections collectionsRepository, userID string) (*folders, error) {
+	existing, err := collections.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &folders{
+		collections: collections,
+		userID:      userID,
+		byKey:       make(map[folderKey]primitive.ObjectID, len(existing)),
+	}
+
+	for _, c := range existing {
+		key := folderKey{name: c.Name}
+		if c.ParentID != nil {
+			key.parent = *c.ParentID
+		}
+		f.byKey[key] = c.ID
+	}
+
+	return f, nil
+}
+
+func (f *folders) resolve(ctx context.Context, path []string) (primitive.ObjectID, error) {
+	var (
+		id     primitive.ObjectID
+		parent *primitive.ObjectID
+	)
+
+	for _, name := range path {
+		key := folderKey{name: name}
+		if parent != nil {
+			key.parent = *parent
+		}
+
+		var ok bool
+		if id, ok = f.byKey[key]; !ok {
+			c, err := f.collections.Create(ctx, database.CreateCollectionReq{
+				ID:       primitive.NewObjectID(),
+				UserID:   f.userID,
+				Name:     name,
+				ParentID: parent,
+			})
+			if err != nil {
+				return id, err
+			}
+
+			id = c.ID
+			f.byKey[key] = id
+		}
+
+		parentID := id
+		parent = &parentID
+	}
+
+	return id, nil
+}
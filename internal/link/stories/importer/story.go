@@ -0,0 +1,316 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/linkgrpc"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/models"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/bookmarks"
+)
+
+// progressEvery задает, через сколько закладок сохраняется прогресс задания.
+const progressEvery = 100
+
+type result int
+
+const (
+	resultCreated result = iota
+	resultDuplicate
+	resultFailed
+)
+
+// New создает историю фонового импорта закладок. Новые задания ищутся раз в pollInterval,
+// а ссылки отправляются на обогащение не чаще одной за enqueueInterval, чтобы импорт
+// не забивал очередь и не обходил сайты слишком часто. Импорт не создает ссылок сверх квоты maxLinks,
+// 0 снимает ограничение.
+func New(
+	jobs jobsRepository,
+	links linksRepository,
+	collections collectionsRepository,
+	settings settingsRepository,
+	blobs blobStore,
+	tags tagNormalizer,
+	urls urlNormalizer,
+	publisher amqpPublisher,
+	queueName string,
+	pollInterval time.Duration,
+	enqueueInterval time.Duration,
+	maxLinks int64,
+) *Story {
+	return &Story{
+		jobs:            jobs,
+		links:           links,
+		collections:     collections,
+		settings:        settings,
+		blobs:           blobs,
+		tags:            tags,
+		urls:            urls,
+		pub:             publisher,
+		queueName:       queueName,
+		pollInterval:    pollInterval,
+		enqueueInterval: enqueueInterval,
+		maxLinks:        maxLinks,
+	}
+}
+
+type Story struct {
+	jobs            jobsRepository
+	links           linksRepository
+	collections     collectionsRepository
+	settings        settingsRepository
+	blobs           blobStore
+	tags            tagNormalizer
+	urls            urlNormalizer
+	pub             amqpPublisher
+	queueName       string
+	pollInterval    time.Duration
+	enqueueInterval time.Duration
+	maxLinks        int64
+}
+
+func (s *Story) Run(ctx context.Context) error {
+	// Задания, прерванные прошлой остановкой, выполняются заново: дубликаты все равно пропускаются
+	if err := s.jobs.ResetRunning(ctx); err != nil {
+		return err
+	}
+
+	for {
+		job, err := s.jobs.ClaimPending(ctx)
+		switch {
+		case err == nil:
+			s.process(ctx, job)
+			continue
+		case !errors.Is(err, mongo.ErrNoDocuments):
+			slog.Error("cannot claim import job", slog.Any("err", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+func (s *Story) process(ctx context.Context, job database.ImportJob) {
+	err := s.importJob(ctx, &job)
+
+	now := time.Now()
+	job.FinishedAt = &now
+	job.Status = database.ImportDone
+	if err != nil {
+		// При остановке сервиса задание останется в работе и будет перезапущено
+		if ctx.Err() != nil {
+			return
+		}
+
+		slog.Error("import job failed", slog.String("job_id", job.ID.Hex()), slog.Any("err", err))
+		job.Status = database.ImportFailed
+		job.Error = err.Error()
+	}
+
+	if err := s.jobs.UpdateProgress(ctx, job); err != nil {
+		slog.Error("cannot save import job", slog.String("job_id", job.ID.Hex()), slog.Any("err", err))
+	}
+}
+
+func (s *Story) importJob(ctx context.Context, job *database.ImportJob) error {
+	data, err := s.blobs.Get(ctx, job.BlobSHA256)
+	if err != nil {
+		return err
+	}
+
+	items, err := bookmarks.Parse(bytes.NewReader(data), bookmarks.Format(job.Format))
+	if err != nil {
+		return err
+	}
+
+	job.Total = int64(len(items))
+	job.Processed, job.Created, job.Duplicates, job.Failed = 0, 0, 0, 0
+	job.Error = ""
+	if err := s.jobs.UpdateProgress(ctx, *job); err != nil {
+		return err
+	}
+
+	folders, err := newFolders(ctx, s.collections, job.UserID)
+	if err != nil {
+		return err
+	}
+
+	// Импортированные ссылки получают уровень доступа по умолчанию из настроек пользователя
+	settings, err := s.settings.Get(ctx, job.UserID)
+	if err != nil {
+		return err
+	}
+	visibility := settings.DefaultVisibility.Effective()
+
+	room, err := s.room(ctx, job.UserID)
+	if err != nil {
+		return err
+	}
+
+	throttle := time.NewTicker(s.enqueueInterval)
+	defer throttle.Stop()
+
+	for i, item := range items {
+		switch s.importOne(ctx, job.UserID, visibility, item, folders, room, throttle) {
+		case resultCreated:
+			job.Created++
+		case resultDuplicate:
+			job.Duplicates++
+		case resultFailed:
+			job.Failed++
+		}
+		job.Processed++
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if (i+1)%progressEvery == 0 {
+			if err := s.jobs.UpdateProgress(ctx, *job); err != nil {
+				slog.Warn("cannot save import progress", slog.String("job_id", job.ID.Hex()), slog.Any("err", err))
+			}
+		}
+	}
+
+	if room.skipped > 0 {
+		// Задание выполнено, но пользователь должен узнать, почему часть закладок не попала в ссылки
+		job.Error = fmt.Sprintf("link quota of %d links exceeded: %d bookmarks were not imported", s.maxLinks, room.skipped)
+	}
+
+	return nil
+}
+
+// linkRoom - сколько ссылок пользователь еще может создать при импорте.
+type linkRoom struct {
+	left    int64 // отрицательное значение - без ограничений
+	skipped int64
+}
+
+// take занимает место под новую ссылку.
+func (r *linkRoom) take() bool {
+	switch {
+	case r.left < 0:
+		return true
+	case r.left == 0:
+		r.skipped++
+		return false
+	default:
+		r.left--
+		return true
+	}
+}
+
+func (s *Story) room(ctx context.Context, userID string) (*linkRoom, error) {
+	if s.maxLinks <= 0 {
+		return &linkRoom{left: -1}, nil
+	}
+
+	n, err := s.links.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &linkRoom{left: max(s.maxLinks-n, 0)}, nil
+}
+
+func (s *Story) importOne(
+	ctx context.Context,
+	userID string,
+	visibility database.Visibility,
+	item bookmarks.Bookmark,
+	folders *folders,
+	room *linkRoom,
+	throttle *time.Ticker,
+) result {
+	normalizedURL, err := s.urls.Normalize(item.URL)
+	if err != nil {
+		return resultFailed
+	}
+
+	res := resultCreated
+	link, err := s.links.FindByUserAndURL(ctx, normalizedURL, userID)
+	switch {
+	case err == nil && link.DeletedAt != nil:
+		// Ссылка в корзине - такой же дубликат, как и живая: импорт не восстанавливает ее молча
+		// и не кладет в папки, вернуть ее пользователь может сам через корзину
+		return resultDuplicate
+	case err == nil:
+		res = resultDuplicate
+	case errors.Is(err, mongo.ErrNoDocuments):
+		if !room.take() {
+			return resultFailed
+		}
+
+		link, err = s.links.Create(ctx, database.CreateLinkReq{
+			ID:            primitive.NewObjectID(),
+			URL:           item.URL,
+			NormalizedURL: normalizedURL,
+			Title:         item.Title,
+			Description:   item.Description,
+			Tags:          s.tags.NormalizeAll(item.Tags),
+			UserID:        userID,
+			Visibility:    visibility,
+			CreatedAt:     item.AddedAt,
+			Revisor:       database.Revisor{Actor: userID, Source: database.RevisionSourceImport},
+		})
+		if err != nil {
+			slog.Warn("cannot import link", slog.String("url", item.URL), slog.Any("err", err))
+			return resultFailed
+		}
+
+		s.enqueue(ctx, link.ID, throttle)
+	default:
+		slog.Warn("cannot check duplicate link", slog.String("url", item.URL), slog.Any("err", err))
+		return resultFailed
+	}
+
+	// Дубликат тоже кладем в папку: ту же закладку могли хранить в нескольких папках
+	if len(item.Folder) > 0 {
+		collectionID, err := folders.resolve(ctx, item.Folder)
+		if err == nil {
+			err = s.collections.AddLink(ctx, collectionID, link.ID)
+		}
+		if err != nil {
+			slog.Warn("cannot add imported link to collection", slog.String("url", item.URL), slog.Any("err", err))
+		}
+	}
+
+	return res
+}
+
+// enqueue отправляет ссылку на обогащение, дожидаясь своей очереди по throttle.
+func (s *Story) enqueue(ctx context.Context, id primitive.ObjectID, throttle *time.Ticker) {
+	select {
+	case <-ctx.Done():
+		return
+	case <-throttle.C:
+	}
+
+	data, err := json.Marshal(models.Message{ID: id.Hex()})
+	if err != nil {
+		slog.Error("cannot marshal link message", slog.Any("err", err))
+		return
+	}
+
+	err = s.pub.Publish("", s.queueName, false, false, amqp.Publishing{
+		ContentType: linkgrpc.ContentTypeJSON,
+		Body:        data,
+		Timestamp:   time.Now(),
+	})
+	if err != nil {
+		slog.Error("cannot enqueue imported link", slog.String("id", id.Hex()), slog.Any("err", err))
+	}
+}
@@ -11,7 +11,29 @@ import (
 
 type repository interface {
 	FindByID(ctx context.Context, id primitive.ObjectID) (database.Link, error)
-	Update(ctx context.Context, req database.UpdateLinkReq) (database.Link, error)
+	FindByUserAndURL(ctx context.Context, normalizedURL, userID string) (database.Link, error)
+	Enrich(ctx context.Context, req database.EnrichLinkReq) (database.Link, error)
+}
+
+type contentsRepository interface {
+	Upsert(ctx context.Context, c database.LinkContent) error
+}
+
+type snapshotsRepository interface {
+	Create(ctx context.Context, s database.Snapshot) (database.Snapshot, error)
+	FindLatestByLinkID(ctx context.Context, linkID primitive.ObjectID) (database.Snapshot, error)
+}
+
+type blobStore interface {
+	Put(ctx context.Context, data []byte) (string, error)
+}
+
+type tagNormalizer interface {
+	NormalizeAll(tags []string) []string
+}
+
+type urlNormalizer interface {
+	Normalize(raw string) (string, error)
 }
 
 type amqpConsumer interface {
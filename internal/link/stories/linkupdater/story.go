@@ -1,32 +1,63 @@
 package linkupdater
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/rabbitmq/amqp091-go"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/models"
 
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/htmlmeta"
 	"github.com/ptsypyshev/gb-golang-level3-new/pkg/scrape"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/tagnorm"
 )
 
-func New(repository repository, consumer amqpConsumer, queueName string) *Story {
+// New создает историю обогащения ссылок. Если inlineBudget больше нуля, в снимки страниц
+// встраиваются стили и изображения суммарным размером не более inlineBudget байт.
+func New(
+	repository repository,
+	contents contentsRepository,
+	snapshots snapshotsRepository,
+	blobs blobStore,
+	tags tagNormalizer,
+	urls urlNormalizer,
+	consumer amqpConsumer,
+	queueName string,
+	inlineBudget int64,
+) *Story {
 	return &Story{
-		repository: repository,
-		consumer:   consumer,
-		queueName:  queueName,
+		repository:   repository,
+		contents:     contents,
+		snapshots:    snapshots,
+		blobs:        blobs,
+		tags:         tags,
+		urls:         urls,
+		consumer:     consumer,
+		queueName:    queueName,
+		inlineBudget: inlineBudget,
 	}
 }
 
 type Story struct {
-	repository repository
-	consumer   amqpConsumer
-	queueName  string
+	repository   repository
+	contents     contentsRepository
+	snapshots    snapshotsRepository
+	blobs        blobStore
+	tags         tagNormalizer
+	urls         urlNormalizer
+	consumer     amqpConsumer
+	queueName    string
+	inlineBudget int64
 }
 
 func (s *Story) Run(ctx context.Context) error {
@@ -70,30 +101,142 @@ func (s *Story) processMsg(ctx context.Context, msg amqp091.Delivery) error {
 		return err
 	}
 
-	// Добавляем данные из scrape
-	parsed, err := scrape.Parse(ctx, link.URL)
+	// Загружаем страницу один раз и разбираем ее и на метаданные, и на текст статьи
+	page, err := scrape.Fetch(ctx, link.URL)
 	if err != nil {
 		return err
 	}
 
-	if parsed.Title != "" {
-		link.Title = parsed.Title
+	parsed, err := htmlmeta.Parse(ctx, bytes.NewReader(page.Body))
+	if err != nil {
+		return err
+	}
+
+	article, err := htmlmeta.ExtractArticle(ctx, bytes.NewReader(page.Body))
+	if err != nil {
+		return err
+	}
+
+	err = s.contents.Upsert(ctx, database.LinkContent{
+		LinkID:      id,
+		UserID:      link.UserID,
+		Text:        article.Text,
+		WordCount:   article.WordCount,
+		ReadingTime: article.ReadingTime,
+		ExtractedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.saveSnapshot(ctx, link, page); err != nil {
+		return err
+	}
+
+	// Описание из импорта или заданное пользователем важнее описания страницы
+	description := ""
+	if link.Description == "" {
+		description = parsed.Description
+	}
+
+	// Извлеченные теги заменяют прежние, чтобы повторная обработка не плодила дубликаты,
+	// а пользовательские теги не трогаем и не повторяем среди извлеченных
+	autoTags := tagnorm.Subtract(s.tags.NormalizeAll(parsed.Tags), link.Tags)
+
+	// Записываем только вычисленные поля: пока страница загружалась, пользователь мог изменить ссылку
+	// или переместить ее в корзину
+	_, err = s.repository.Enrich(ctx, database.EnrichLinkReq{
+		ID:            id,
+		Title:         parsed.Title,
+		Description:   description,
+		NormalizedURL: s.normalizedURL(ctx, link, page, parsed.Canonical),
+		AutoTags:      autoTags,
+		Revisor:       database.Revisor{Source: database.RevisionSourceEnricher},
+	})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		slog.Info("link is deleted during enrichment", slog.String("id", m.ID))
+		return nil
+	}
+
+	return err
+}
+
+// normalizedURL предпочитает канонический адрес, объявленный страницей, если он не совпадает
+// с другой ссылкой пользователя. Ссылкам, сохраненным до нормализации, ключ вычисляется по URL.
+func (s *Story) normalizedURL(ctx context.Context, link database.Link, page *scrape.Page, canonical string) string {
+	current := link.NormalizedURL
+	if current == "" {
+		current, _ = s.urls.Normalize(link.URL)
 	}
 
-	if len(parsed.Tags) > 0 {
-		link.Tags = append(link.Tags, parsed.Tags...)
+	if canonical == "" {
+		return current
 	}
 
-	req := database.UpdateLinkReq{
-		ID:     id,
-		Title:  link.Title,
-		URL:    link.URL,
-		Images: link.Images,
-		Tags:   link.Tags,
-		UserID: link.UserID,
+	base, err := url.Parse(page.URL)
+	if err != nil {
+		return current
+	}
+
+	ref, err := base.Parse(canonical)
+	if err != nil {
+		return current
+	}
+
+	normalized, err := s.urls.Normalize(ref.String())
+	if err != nil || normalized == current {
+		return current
+	}
+
+	other, err := s.repository.FindByUserAndURL(ctx, normalized, link.UserID)
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return normalized
+	case err == nil && other.ID == link.ID:
+		return normalized
+	default:
+		return current
+	}
+}
+
+// saveSnapshot сохраняет копию страницы. Новый снимок не создается, если содержимое
+// не изменилось с последнего снимка, а одинаковые данные хранятся в хранилище блобов один раз.
+func (s *Story) saveSnapshot(ctx context.Context, link database.Link, page *scrape.Page) error {
+	body := page.Body
+	if s.inlineBudget > 0 {
+		inlined, err := scrape.Inline(ctx, page, s.inlineBudget)
+		if err != nil {
+			slog.Warn("cannot inline page resources", slog.String("url", link.URL), slog.Any("err", err))
+		} else {
+			body = inlined
+		}
+	}
+
+	sum, err := s.blobs.Put(ctx, body)
+	if err != nil {
+		return err
 	}
 
-	// Обновляем данные в DB
-	_, err = s.repository.Update(ctx, req)
+	latest, err := s.snapshots.FindLatestByLinkID(ctx, link.ID)
+	switch {
+	case err == nil && latest.SHA256 == sum:
+		return nil
+	case err != nil && !errors.Is(err, mongo.ErrNoDocuments):
+		return err
+	}
+
+	contentType := page.ContentType
+	if contentType == "" {
+		contentType = http.DetectContentType(body)
+	}
+
+	_, err = s.snapshots.Create(ctx, database.Snapshot{
+		LinkID:      link.ID,
+		UserID:      link.UserID,
+		SHA256:      sum,
+		Size:        int64(len(body)),
+		ContentType: contentType,
+	})
+
 	return err
 }
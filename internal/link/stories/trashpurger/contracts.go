@@ -0,0 +1,22 @@
+package trashpurger
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+type linksRepository interface {
+	FindDeletedBefore(ctx context.Context, before time.Time, limit int64) ([]database.Link, error)
+}
+
+type collectionsRepository interface {
+	DeleteDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type linkPurger interface {
+	PurgeLink(ctx context.Context, id primitive.ObjectID) error
+}
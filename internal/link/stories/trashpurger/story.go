@@ -0,0 +1,80 @@
+package trashpurger
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// batchSize задает, сколько ссылок очищается за один запрос к корзине.
+const batchSize = 100
+
+// New создает историю очистки корзины: раз в interval ссылки, пролежавшие в корзине дольше retention,
+// удаляются безвозвратно вместе с содержимым, снимками, заметками и короткими ссылками.
+// Так же удаляются коллекции, скрытые при удалении пользователя.
+func New(
+	links linksRepository, collections collectionsRepository, purger linkPurger, retention, interval time.Duration,
+) *Story {
+	return &Story{links: links, collections: collections, purger: purger, retention: retention, interval: interval}
+}
+
+type Story struct {
+	links       linksRepository
+	collections collectionsRepository
+	purger      linkPurger
+	retention   time.Duration
+	interval    time.Duration
+}
+
+func (s *Story) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Story) purge(ctx context.Context) {
+	before := time.Now().Add(-s.retention)
+
+	var purged int
+	defer func() {
+		if purged > 0 {
+			slog.Info("trash purged", slog.Int("links", purged))
+		}
+	}()
+
+	if n, err := s.collections.DeleteDeletedBefore(ctx, before); err != nil {
+		slog.Error("cannot purge collections", slog.Any("err", err))
+	} else if n > 0 {
+		slog.Info("trash purged", slog.Int64("collections", n))
+	}
+
+	for {
+		links, err := s.links.FindDeletedBefore(ctx, before, batchSize)
+		if err != nil {
+			slog.Error("cannot find links to purge", slog.Any("err", err))
+			return
+		}
+
+		for _, l := range links {
+			// Ссылка, которую не удалось очистить, остается в корзине до следующего прохода
+			if err := s.purger.PurgeLink(ctx, l.ID); err != nil {
+				slog.Error("cannot purge link", slog.String("id", l.ID.Hex()), slog.Any("err", err))
+				return
+			}
+			purged++
+		}
+
+		if len(links) < batchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package usercleanup
+
+import (
+	"context"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+type cleanupsRepository interface {
+	FindByUserID(ctx context.Context, userID string) (database.UserCleanup, error)
+	Upsert(ctx context.Context, c database.UserCleanup) (database.UserCleanup, error)
+}
+
+type linksRepository interface {
+	SoftDeleteByUserID(ctx context.Context, userID string) (int64, error)
+	RestoreByUserID(ctx context.Context, userID string) (int64, error)
+}
+
+type collectionsRepository interface {
+	SoftDeleteByUserID(ctx context.Context, userID string) (int64, error)
+	RestoreByUserID(ctx context.Context, userID string) (int64, error)
+}
+
+type sharesRepository interface {
+	RevokeByUserID(ctx context.Context, userID string) (int64, error)
+	RestoreByUserID(ctx context.Context, userID string) (int64, error)
+}
+
+// ownerCache - кэш владельцев ссылок links-srv, из которого удаленный пользователь убирается сразу,
+// не дожидаясь истечения срока записи.
+type ownerCache interface {
+	ForgetOwner(userID string)
+}
+
+type amqpPublisher interface {
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+}
+
+type amqpConsumer interface {
+	Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (
+		<-chan amqp.Delivery,
+		error,
+	)
+}
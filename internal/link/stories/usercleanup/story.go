@@ -0,0 +1,283 @@
+package usercleanup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/user/models"
+)
+
+// New создает историю очистки данных удаленных пользователей. Она слушает очередь событий users-srv
+// и по событию user.deleted перемещает ссылки и коллекции пользователя в корзину и отзывает ссылки доступа,
+// а по событию user.restored возвращает их.
+// Все шаги можно повторять, поэтому повторная доставка события безопасна. Событие, которое не удалось
+// обработать, возвращается в конец очереди, а после maxAttempts попыток перекладывается в deadQueueName.
+func New(
+	cleanups cleanupsRepository,
+	links linksRepository,
+	collections collectionsRepository,
+	shares sharesRepository,
+	owners ownerCache,
+	consumer amqpConsumer,
+	publisher amqpPublisher,
+	queueName string,
+	deadQueueName string,
+	maxAttempts int,
+) *Story {
+	return &Story{
+		cleanups:      cleanups,
+		links:         links,
+		collections:   collections,
+		shares:        shares,
+		owners:        owners,
+		consumer:      consumer,
+		pub:           publisher,
+		queueName:     queueName,
+		deadQueueName: deadQueueName,
+		maxAttempts:   maxAttempts,
+	}
+}
+
+type Story struct {
+	cleanups      cleanupsRepository
+	links         linksRepository
+	collections   collectionsRepository
+	shares        sharesRepository
+	owners        ownerCache
+	consumer      amqpConsumer
+	pub           amqpPublisher
+	queueName     string
+	deadQueueName string
+	maxAttempts   int
+}
+
+const (
+	// headerAttempts - сколько раз событие уже не удалось обработать
+	headerAttempts = "x-attempts"
+	// headerError - ошибка последней попытки, по ней разбирают очередь недоставленных событий
+	headerError = "x-last-error"
+)
+
+// errMalformed - событие не разбирается, повторять его бесполезно.
+var errMalformed = errors.New("malformed user event")
+
+func (s *Story) Run(ctx context.Context) error {
+	// Подтверждаем событие только после обработки: прерванная очистка повторится после рестарта
+	ch, err := s.consumer.Consume(s.queueName, "", false, false, false, false, nil)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case m, ok := <-ch:
+			if !ok {
+				return errors.New("rabbitmq queue is closed")
+			}
+
+			err := s.processMsg(ctx, m)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				slog.Error("process user event error", slog.Any("err", err))
+				s.retry(m, err)
+				continue
+			}
+
+			if err := m.Ack(false); err != nil {
+				slog.Error("cannot ack user event", slog.Any("err", err))
+			}
+		}
+	}
+}
+
+// retry публикует копию события с увеличенным счетчиком попыток в конец очереди, а после maxAttempts
+// попыток или неразборчивое событие - в очередь недоставленных. Исходное сообщение подтверждается только
+// после публикации копии, иначе брокер доставит его снова.
+func (s *Story) retry(m amqp.Delivery, cause error) {
+	attempts := attemptsOf(m.Headers) + 1
+	queue := s.queueName
+	if attempts >= s.maxAttempts || errors.Is(cause, errMalformed) {
+		queue = s.deadQueueName
+		slog.Error("user event is dead-lettered", slog.Int("attempts", attempts), slog.Any("err", cause))
+	}
+
+	headers := amqp.Table{}
+	for k, v := range m.Headers {
+		headers[k] = v
+	}
+	headers[headerAttempts] = int32(attempts)
+	headers[headerError] = cause.Error()
+
+	err := s.pub.Publish("", queue, false, false, amqp.Publishing{
+		Headers:      headers,
+		ContentType:  m.ContentType,
+		DeliveryMode: amqp.Persistent,
+		Type:         m.Type,
+		Body:         m.Body,
+		Timestamp:    m.Timestamp,
+	})
+	if err != nil {
+		slog.Error("cannot requeue user event", slog.String("queue", queue), slog.Any("err", err))
+		if err := m.Nack(false, true); err != nil {
+			slog.Error("cannot nack user event", slog.Any("err", err))
+		}
+		return
+	}
+
+	if err := m.Ack(false); err != nil {
+		slog.Error("cannot ack user event", slog.Any("err", err))
+	}
+}
+
+// attemptsOf читает счетчик попыток. Тип числа в заголовке зависит от того, кто опубликовал событие.
+func attemptsOf(headers amqp.Table) int {
+	switch v := headers[headerAttempts].(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case int:
+		return v
+	default:
+		return 0
+	}
+}
+
+func (s *Story) processMsg(ctx context.Context, msg amqp.Delivery) error {
+	var e models.Event
+	if err := json.Unmarshal(msg.Body, &e); err != nil {
+		return fmt.Errorf("%w: %w", errMalformed, err)
+	}
+
+	switch {
+	case e.UserID == "":
+	case e.Type == models.EventUserDeleted:
+		return s.userDeleted(ctx, e)
+	case e.Type == models.EventUserRestored:
+		return s.userRestored(ctx, e)
+	}
+
+	slog.Warn("skip unknown user event", slog.String("type", e.Type))
+	return nil
+}
+
+func (s *Story) userDeleted(ctx context.Context, e models.Event) error {
+	s.owners.ForgetOwner(e.UserID)
+
+	cleanup, err := s.cleanups.FindByUserID(ctx, e.UserID)
+	switch {
+	case err == nil:
+		// Очистка уже завершена, событие доставлено повторно
+		if cleanup.Status == database.CleanupDone && !e.OccurredAt.After(cleanup.DeletedAt) {
+			return nil
+		}
+		// Пользователя уже восстановили, событие об удалении доставлено после события о восстановлении
+		if cleanup.RestoredAt != nil && !e.OccurredAt.After(*cleanup.RestoredAt) {
+			return nil
+		}
+	case errors.Is(err, mongo.ErrNoDocuments):
+		cleanup = database.UserCleanup{UserID: e.UserID}
+	default:
+		return err
+	}
+
+	cleanup.Status = database.CleanupRunning
+	cleanup.DeletedAt = e.OccurredAt
+	cleanup.Error = ""
+	cleanup.FinishedAt = nil
+	cleanup.Restored = 0
+	cleanup.RestoredAt = nil
+	if cleanup, err = s.cleanups.Upsert(ctx, cleanup); err != nil {
+		return err
+	}
+
+	if err := s.cleanup(ctx, &cleanup); err != nil {
+		cleanup.Status = database.CleanupFailed
+		cleanup.Error = err.Error()
+		if _, saveErr := s.cleanups.Upsert(ctx, cleanup); saveErr != nil {
+			slog.Error("cannot save user cleanup", slog.String("user_id", e.UserID), slog.Any("err", saveErr))
+		}
+		return err
+	}
+
+	now := time.Now()
+	cleanup.Status = database.CleanupDone
+	cleanup.FinishedAt = &now
+	_, err = s.cleanups.Upsert(ctx, cleanup)
+
+	return err
+}
+
+// userRestored возвращает из корзины ссылки и коллекции, перемещенные туда очисткой, и снова открывает
+// отозванные ею ссылки доступа. Ссылки, которые пользователь удалил или отозвал сам, не возвращаются.
+func (s *Story) userRestored(ctx context.Context, e models.Event) error {
+	cleanup, err := s.cleanups.FindByUserID(ctx, e.UserID)
+	switch {
+	case err == nil:
+		// Событие доставлено повторно или относится к прошлому удалению пользователя
+		if !e.OccurredAt.After(cleanup.DeletedAt) || cleanup.RestoredAt != nil && !e.OccurredAt.After(*cleanup.RestoredAt) {
+			return nil
+		}
+	case errors.Is(err, mongo.ErrNoDocuments):
+		// Событие об удалении еще не обработано. Восстановление запоминается, чтобы пропустить его
+		cleanup = database.UserCleanup{UserID: e.UserID}
+	default:
+		return err
+	}
+
+	n, err := s.links.RestoreByUserID(ctx, e.UserID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.collections.RestoreByUserID(ctx, e.UserID); err != nil {
+		return err
+	}
+	if _, err := s.shares.RestoreByUserID(ctx, e.UserID); err != nil {
+		return err
+	}
+
+	cleanup.Status = database.CleanupRestored
+	cleanup.Restored += n
+	cleanup.RestoredAt = &e.OccurredAt
+	_, err = s.cleanups.Upsert(ctx, cleanup)
+
+	return err
+}
+
+// cleanup выполняет шаги очистки, сохраняя счетчики после каждого, чтобы по статусу был виден ход работы.
+func (s *Story) cleanup(ctx context.Context, c *database.UserCleanup) error {
+	steps := []struct {
+		run     func(ctx context.Context, userID string) (int64, error)
+		counter *int64
+	}{
+		{s.links.SoftDeleteByUserID, &c.Links},
+		{s.collections.SoftDeleteByUserID, &c.Collections},
+		{s.shares.RevokeByUserID, &c.Shares},
+	}
+
+	for _, step := range steps {
+		n, err := step.run(ctx, c.UserID)
+		if err != nil {
+			return err
+		}
+		*step.counter += n
+
+		if _, err := s.cleanups.Upsert(ctx, *c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
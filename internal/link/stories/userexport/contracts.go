@@ -0,0 +1,36 @@
+package userexport
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+type exportsRepository interface {
+	ClaimPending(ctx context.Context) (database.UserExport, error)
+	ResetRunning(ctx context.Context) error
+	Finish(ctx context.Context, job database.UserExport) error
+}
+
+type linksRepository interface {
+	ForEachByUserID(ctx context.Context, userID string, fn func(database.Link) error) error
+	FindDeletedByUserID(ctx context.Context, userID string) ([]database.Link, error)
+}
+
+type notesRepository interface {
+	FindByUserID(ctx context.Context, userID string) ([]database.Note, error)
+}
+
+type collectionsRepository interface {
+	FindByUserID(ctx context.Context, userID string) ([]database.Collection, error)
+}
+
+type clicksRepository interface {
+	Stats(ctx context.Context, linkID primitive.ObjectID) (database.LinkStats, error)
+}
+
+type blobStore interface {
+	Put(ctx context.Context, data []byte) (string, error)
+}
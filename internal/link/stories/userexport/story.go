@@ -0,0 +1,194 @@
+package userexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/collectiongrpc"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/linkgrpc"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/link/shortgrpc"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+// New создает историю выгрузки данных пользователя. Новые задания ищутся раз в pollInterval.
+// Архив содержит user.json, links.json (вместе с корзиной), notes.json, collections.json и clicks.json.
+func New(
+	exports exportsRepository,
+	links linksRepository,
+	notes notesRepository,
+	collections collectionsRepository,
+	clicks clicksRepository,
+	blobs blobStore,
+	pollInterval time.Duration,
+) *Story {
+	return &Story{
+		exports:      exports,
+		links:        links,
+		notes:        notes,
+		collections:  collections,
+		clicks:       clicks,
+		blobs:        blobs,
+		pollInterval: pollInterval,
+	}
+}
+
+type Story struct {
+	exports      exportsRepository
+	links        linksRepository
+	notes        notesRepository
+	collections  collectionsRepository
+	clicks       clicksRepository
+	blobs        blobStore
+	pollInterval time.Duration
+}
+
+func (s *Story) Run(ctx context.Context) error {
+	// Выгрузки, прерванные прошлой остановкой, собираются заново
+	if err := s.exports.ResetRunning(ctx); err != nil {
+		return err
+	}
+
+	for {
+		job, err := s.exports.ClaimPending(ctx)
+		switch {
+		case err == nil:
+			s.process(ctx, job)
+			continue
+		case !errors.Is(err, mongo.ErrNoDocuments):
+			slog.Error("cannot claim user export", slog.Any("err", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+func (s *Story) process(ctx context.Context, job database.UserExport) {
+	data, err := s.archive(ctx, job)
+	if err == nil {
+		job.BlobSHA256, err = s.blobs.Put(ctx, data)
+		job.Size = int64(len(data))
+	}
+
+	now := time.Now()
+	job.FinishedAt = &now
+	job.Status = database.ExportDone
+	if err != nil {
+		// При остановке сервиса выгрузка останется в работе и будет перезапущена
+		if ctx.Err() != nil {
+			return
+		}
+
+		slog.Error("user export failed", slog.String("job_id", job.ID.Hex()), slog.Any("err", err))
+		job.Status = database.ExportFailed
+		job.Error = err.Error()
+	}
+
+	if err := s.exports.Finish(ctx, job); err != nil {
+		slog.Error("cannot save user export", slog.String("job_id", job.ID.Hex()), slog.Any("err", err))
+	}
+}
+
+// archive собирает ZIP-архив с данными пользователя.
+func (s *Story) archive(ctx context.Context, job database.UserExport) ([]byte, error) {
+	var links []*pb.Link
+	var stats []*pb.LinkStats
+	collect := func(l database.Link) error {
+		links = append(links, linkgrpc.LinkToPB(l))
+
+		st, err := s.clicks.Stats(ctx, l.ID)
+		if err != nil {
+			return err
+		}
+		if st.Total > 0 {
+			stats = append(stats, shortgrpc.StatsToPB(l.ID, st))
+		}
+
+		return nil
+	}
+
+	if err := s.links.ForEachByUserID(ctx, job.UserID, collect); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.links.FindDeletedByUserID(ctx, job.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range deleted {
+		if err := collect(l); err != nil {
+			return nil, err
+		}
+	}
+
+	notes, err := s.notes.FindByUserID(ctx, job.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := s.collections.FindByUserID(ctx, job.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []struct {
+		name string
+		v    any
+	}{
+		{"user.json", job.Profile},
+		{"links.json", nonNil(links)},
+		{"notes.json", convert(notes, linkgrpc.NoteToPB)},
+		{"collections.json", convert(collections, collectiongrpc.CollectionToPB)},
+		{"clicks.json", nonNil(stats)},
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range files {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Deflate, Modified: job.CreatedAt})
+		if err != nil {
+			return nil, err
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.v); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func convert[T any, P any](items []T, fn func(T) P) []P {
+	res := make([]P, len(items))
+	for i, item := range items {
+		res[i] = fn(item)
+	}
+
+	return res
+}
+
+// nonNil нужен, чтобы пустой список попал в файл как [], а не null.
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+
+	return items
+}
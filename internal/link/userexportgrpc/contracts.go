@@ -0,0 +1,16 @@
+package userexportgrpc
+
+import (
+	"context"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+)
+
+type exportsRepository interface {
+	Create(ctx context.Context, job database.UserExport) (database.UserExport, error)
+	FindLatestByUserID(ctx context.Context, userID string) (database.UserExport, error)
+}
+
+type blobStore interface {
+	Get(ctx context.Context, sum string) ([]byte, error)
+}
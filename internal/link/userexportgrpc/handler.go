@@ -0,0 +1,165 @@
+package userexportgrpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
+)
+
+// chunkSize - размер части архива в одном сообщении gRPC-стрима.
+const chunkSize = 64 << 10
+
+var _ pb.UserExportServiceServer = (*Handler)(nil)
+
+// New создает обработчик выгрузок. Выгрузки доступны только администраторам admins, см. requireAccess.
+func New(exportsRepository exportsRepository, blobs blobStore, admins reqmeta.Admins, timeout time.Duration) *Handler {
+	return &Handler{exportsRepository: exportsRepository, blobs: blobs, admins: admins, timeout: timeout}
+}
+
+type Handler struct {
+	pb.UnimplementedUserExportServiceServer
+	exportsRepository exportsRepository
+	blobs             blobStore
+	admins            reqmeta.Admins
+	timeout           time.Duration
+}
+
+// CreateUserExport ставит выгрузку в очередь. Пока предыдущая выгрузка не завершена, возвращается она.
+func (h Handler) CreateUserExport(ctx context.Context, request *pb.CreateUserExportRequest) (*pb.UserExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	user := request.GetUser()
+	if user.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user is required")
+	}
+	if err := h.requireAccess(ctx, user.Id); err != nil {
+		return nil, err
+	}
+
+	latest, err := h.exportsRepository.FindLatestByUserID(ctx, user.Id)
+	switch {
+	case err == nil && (latest.Status == database.ExportPending || latest.Status == database.ExportRunning):
+		return exportToPB(latest), nil
+	case err != nil && !errors.Is(err, mongo.ErrNoDocuments):
+		return nil, err
+	}
+
+	job, err := h.exportsRepository.Create(ctx, database.UserExport{
+		ID:     primitive.NewObjectID(),
+		UserID: user.Id,
+		Profile: database.ExportedUser{
+			ID:        user.Id,
+			Username:  user.Username,
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return exportToPB(job), nil
+}
+
+func (h Handler) GetUserExport(ctx context.Context, request *pb.GetUserExportRequest) (*pb.UserExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	job, err := h.findLatest(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportToPB(job), nil
+}
+
+func (h Handler) DownloadUserExport(
+	request *pb.GetUserExportRequest, stream pb.UserExportService_DownloadUserExportServer,
+) error {
+	ctx := stream.Context()
+
+	job, err := h.findLatest(ctx, request.UserId)
+	if err != nil {
+		return err
+	}
+
+	if job.Status != database.ExportDone {
+		return status.Errorf(codes.FailedPrecondition, "export %s is %s", job.ID.Hex(), job.Status)
+	}
+
+	data, err := h.blobs.Get(ctx, job.BlobSHA256)
+	if err != nil {
+		return err
+	}
+
+	for len(data) > 0 {
+		n := min(chunkSize, len(data))
+		if err := stream.Send(&pb.UserExportChunk{Chunk: data[:n]}); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+
+	return nil
+}
+
+func (h Handler) findLatest(ctx context.Context, userID string) (database.UserExport, error) {
+	if userID == "" {
+		return database.UserExport{}, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := h.requireAccess(ctx, userID); err != nil {
+		return database.UserExport{}, err
+	}
+
+	job, err := h.exportsRepository.FindLatestByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return job, status.Errorf(codes.NotFound, "export of user %s is not found", userID)
+		}
+		return job, err
+	}
+
+	return job, nil
+}
+
+// requireAccess пропускает только вызовы администраторов. X-User-ID api-gw не проверяет, поэтому
+// совпадение его с владельцем выгрузки ничего не доказывает: пока у api-gw нет аутентификации пользователей,
+// выгрузку, в которой собраны все данные пользователя, получает только оператор с секретом администратора.
+func (h Handler) requireAccess(ctx context.Context, userID string) error {
+	meta := reqmeta.FromIncoming(ctx)
+	if meta.ActorID == "" {
+		return status.Error(codes.Unauthenticated, "actor is required")
+	}
+	if !h.admins.Allow(meta) {
+		return status.Errorf(codes.PermissionDenied, "export of user %s is available to administrators only", userID)
+	}
+
+	return nil
+}
+
+func exportToPB(job database.UserExport) *pb.UserExport {
+	res := &pb.UserExport{
+		Id:        job.ID.Hex(),
+		UserId:    job.UserID,
+		Status:    string(job.Status),
+		Size:      job.Size,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt.String(),
+	}
+
+	if job.FinishedAt != nil {
+		res.FinishedAt = job.FinishedAt.String()
+	}
+
+	return res
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+const (
+	// EventUserDeleted публикуется users-srv после удаления пользователя, links-srv по нему очищает его данные.
+	EventUserDeleted = "user.deleted"
+	// EventUserRestored публикуется users-srv после восстановления пользователя из корзины,
+	// links-srv по нему возвращает данные, убранные очисткой.
+	EventUserRestored = "user.restored"
+)
+
+// Event - событие о пользователе в очереди событий пользователей.
+type Event struct {
+	Type       string    `json:"type"`
+	UserID     string    `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
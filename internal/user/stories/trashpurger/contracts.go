@@ -0,0 +1,10 @@
+package trashpurger
+
+import (
+	"context"
+	"time"
+)
+
+type usersRepository interface {
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
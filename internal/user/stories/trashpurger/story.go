@@ -0,0 +1,40 @@
+package trashpurger
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// New создает историю очистки удаленных пользователей: раз в interval пользователи,
+// удаленные больше retention назад, удаляются безвозвратно.
+func New(users usersRepository, retention, interval time.Duration) *Story {
+	return &Story{users: users, retention: retention, interval: interval}
+}
+
+type Story struct {
+	users     usersRepository
+	retention time.Duration
+	interval  time.Duration
+}
+
+func (s *Story) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := s.users.Purge(ctx, time.Now().Add(-s.retention))
+		switch {
+		case err != nil:
+			slog.Error("cannot purge deleted users", slog.Any("err", err))
+		case purged > 0:
+			slog.Info("deleted users purged", slog.Int64("users", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
 
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
 )
@@ -13,4 +14,15 @@ type usersRepository interface {
 	FindByID(ctx context.Context, userID uuid.UUID) (database.User, error)
 	DeleteByUserID(ctx context.Context, userID uuid.UUID) error
 	FindAll(ctx context.Context) ([]database.User, error)
+	FindDeleted(ctx context.Context) ([]database.User, error)
+	Restore(ctx context.Context, userID uuid.UUID) (database.User, error)
+}
+
+type auditRepository interface {
+	Create(ctx context.Context, e database.AuditEntry) (database.AuditEntry, error)
+	Find(ctx context.Context, filter database.AuditFilter) ([]database.AuditEntry, error)
+}
+
+type amqpPublisher interface {
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
 }
@@ -2,22 +2,52 @@ package usergrpc
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/user/models"
 	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/reqmeta"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/validation"
 )
 
 var _ pb.UserServiceServer = (*Handler)(nil)
 
-func New(usersRepository usersRepository, timeout time.Duration) *Handler {
-	return &Handler{usersRepository: usersRepository, timeout: timeout}
+// New создает обработчик users-srv. События об удалении пользователей публикуются в очередь eventsQueue,
+// журнал аудита доступен администраторам admins.
+func New(
+	usersRepository usersRepository,
+	auditRepository auditRepository,
+	publisher amqpPublisher,
+	eventsQueue string,
+	admins reqmeta.Admins,
+	timeout time.Duration,
+) *Handler {
+	return &Handler{
+		usersRepository: usersRepository,
+		auditRepository: auditRepository,
+		pub:             publisher,
+		eventsQueue:     eventsQueue,
+		admins:          admins,
+		timeout:         timeout,
+	}
 }
 
 type Handler struct {
 	pb.UnimplementedUserServiceServer
 	usersRepository usersRepository
+	auditRepository auditRepository
+	pub             amqpPublisher
+	eventsQueue     string
+	admins          reqmeta.Admins
 	timeout         time.Duration
 }
 
@@ -26,18 +56,14 @@ func (h Handler) CreateUser(ctx context.Context, in *pb.CreateUserRequest) (*pb.
 	defer cancel()
 
 	// TODO implement me - implemented
-	var (
-		id  uuid.UUID
-		err error
-	)
-	if in.Id == "" {
-		id = uuid.New()
-	} else {
-		id, err = uuid.Parse(in.Id)
-	}
-
-	if err != nil {
-		return &pb.Empty{}, err
+	id := uuid.New()
+	if in.Id != "" {
+		var errs validation.Errors
+		errs.UUID("id", in.Id)
+		if err := errs.Err(); err != nil {
+			return &pb.Empty{}, err
+		}
+		id = uuid.MustParse(in.Id)
 	}
 
 	req := database.CreateUserReq{
@@ -45,8 +71,7 @@ func (h Handler) CreateUser(ctx context.Context, in *pb.CreateUserRequest) (*pb.
 		Username: in.Username,
 		Password: in.Password,
 	}
-	_, err = h.usersRepository.Create(ctx, req)
-	return &pb.Empty{}, err
+	return &pb.Empty{}, h.upsert(ctx, req)
 }
 
 func (h Handler) GetUser(ctx context.Context, in *pb.GetUserRequest) (*pb.User, error) {
@@ -56,11 +81,14 @@ func (h Handler) GetUser(ctx context.Context, in *pb.GetUserRequest) (*pb.User,
 	// TODO implement me - implemented
 	id, err := uuid.Parse(in.Id)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	user, err := h.usersRepository.FindByID(ctx, id)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "user %s is not found", in.Id)
+		}
 		return nil, err
 	}
 	return &pb.User{
@@ -77,17 +105,46 @@ func (h Handler) UpdateUser(ctx context.Context, in *pb.UpdateUserRequest) (*pb.
 	defer cancel()
 
 	// TODO implement me - implemented but with create because upsert
-	id, err := uuid.Parse(in.Id)
-	if err != nil {
+	var errs validation.Errors
+	errs.UUID("id", in.Id)
+	if err := errs.Err(); err != nil {
 		return &pb.Empty{}, err
 	}
+	id := uuid.MustParse(in.Id)
 	req := database.CreateUserReq{
 		ID:       id,
 		Username: in.Username,
 		Password: in.Password,
 	}
-	_, err = h.usersRepository.Create(ctx, req) // Create because used upsert db query
-	return &pb.Empty{}, err
+	return &pb.Empty{}, h.upsert(ctx, req) // Create because used upsert db query
+}
+
+// upsert создает или обновляет пользователя и записывает в журнал аудита, что именно произошло.
+func (h Handler) upsert(ctx context.Context, req database.CreateUserReq) error {
+	before, err := h.current(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := validateUser(req, before); err != nil {
+		return err
+	}
+
+	user, err := h.usersRepository.Create(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	action := database.AuditUserUpdated
+	if before == nil {
+		action = database.AuditUserCreated
+	}
+	old, cur := userChanges(before, user)
+	if before == nil || len(cur) > 0 {
+		h.audit(ctx, action, user.ID.String(), old, cur)
+	}
+
+	return nil
 }
 
 func (h Handler) DeleteUser(ctx context.Context, in *pb.DeleteUserRequest) (*pb.Empty, error) {
@@ -100,8 +157,47 @@ func (h Handler) DeleteUser(ctx context.Context, in *pb.DeleteUserRequest) (*pb.
 		return &pb.Empty{}, err
 	}
 
-	err = h.usersRepository.DeleteByUserID(ctx, id)
-	return &pb.Empty{}, err
+	before, err := h.current(ctx, id)
+	if err != nil {
+		return &pb.Empty{}, err
+	}
+
+	if err := h.usersRepository.DeleteByUserID(ctx, id); err != nil {
+		return &pb.Empty{}, err
+	}
+
+	// Повторное удаление ничего не меняет, поэтому в журнал попадает только первое
+	if before != nil {
+		h.audit(ctx, database.AuditUserDeleted, id.String(), map[string]any{"deleted": false}, map[string]any{"deleted": true})
+	}
+
+	// links-srv очищает данные пользователя по событию. Удаление повторяемо, поэтому при ошибке
+	// публикации клиент может просто повторить запрос
+	return &pb.Empty{}, h.publish(models.Event{
+		Type:       models.EventUserDeleted,
+		UserID:     id.String(),
+		OccurredAt: time.Now(),
+	})
+}
+
+func (h Handler) publish(e models.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	err = h.pub.Publish("", h.eventsQueue, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Type:         e.Type,
+		Body:         data,
+		Timestamp:    e.OccurredAt,
+	})
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "cannot publish %s event: %v", e.Type, err)
+	}
+
+	return nil
 }
 
 func (h Handler) ListUsers(ctx context.Context, in *pb.Empty) (*pb.ListUsersResponse, error) {
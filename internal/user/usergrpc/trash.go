@@ -0,0 +1,89 @@
+package usergrpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/user/models"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/pb"
+)
+
+func (h Handler) ListDeletedUsers(ctx context.Context, in *pb.Empty) (*pb.ListUsersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	users, err := h.usersRepository.FindDeleted(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.User, len(users))
+	for i, u := range users {
+		res[i] = trashUserToPB(u)
+	}
+
+	return &pb.ListUsersResponse{Users: res}, nil
+}
+
+func (h Handler) RestoreUser(ctx context.Context, in *pb.RestoreUserRequest) (*pb.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	id, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := h.usersRepository.Restore(ctx, id)
+	switch {
+	case err == nil:
+		h.audit(ctx, database.AuditUserRestored, id.String(), map[string]any{"deleted": true}, map[string]any{"deleted": false})
+	case errors.Is(err, pgx.ErrNoRows):
+		// Пользователь уже восстановлен, например прошлым запросом, который не смог опубликовать событие.
+		// Событие публикуется снова: links-srv возвращает только ссылки, убранные очисткой, поэтому повтор безопасен
+		current, err := h.current(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			return nil, status.Errorf(codes.NotFound, "user %s is not deleted", in.Id)
+		}
+		user = *current
+	default:
+		return nil, err
+	}
+
+	// links-srv возвращает ссылки пользователя из корзины по событию
+	err = h.publish(models.Event{
+		Type:       models.EventUserRestored,
+		UserID:     id.String(),
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trashUserToPB(user), nil
+}
+
+// trashUserToPB конвертирует пользователя для ответов корзины, не отдавая пароль.
+func trashUserToPB(u database.User) *pb.User {
+	res := &pb.User{
+		Id:        u.ID.String(),
+		Username:  u.Username,
+		CreatedAt: u.CreatedAt.String(),
+		UpdatedAt: u.UpdatedAt.String(),
+	}
+	if u.DeletedAt != nil {
+		res.DeletedAt = u.DeletedAt.String()
+	}
+
+	return res
+}
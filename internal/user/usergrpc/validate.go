@@ -0,0 +1,64 @@
+package usergrpc
+
+import (
+	"unicode"
+
+	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/validation"
+)
+
+// Ограничения совпадают со спецификацией api-gw. Пароль ограничен 72 байтами - пределом bcrypt.
+const (
+	minUsernameLength = 3
+	maxUsernameLength = 32
+	minPasswordLength = 8
+	maxPasswordLength = 72
+)
+
+// validateUser проверяет имя пользователя и пароль. Пароль проверяется, только если он новый или меняется:
+// api-gw при частичном обновлении присылает текущий пароль, который мог быть задан до появления политики.
+func validateUser(req database.CreateUserReq, before *database.User) error {
+	var errs validation.Errors
+
+	validateUsername(&errs, req.Username)
+	if before == nil || before.Password != req.Password {
+		validatePassword(&errs, req.Password)
+	}
+
+	return errs.Err()
+}
+
+func validateUsername(errs *validation.Errors, username string) {
+	if n := len(username); n < minUsernameLength || n > maxUsernameLength {
+		errs.Add("username", "must be %d to %d characters long", minUsernameLength, maxUsernameLength)
+	}
+
+	for _, r := range username {
+		if !isUsernameRune(r) {
+			errs.Add("username", "may contain only latin letters, digits, '_', '.' and '-'")
+			return
+		}
+	}
+}
+
+func isUsernameRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '.' || r == '-'
+}
+
+func validatePassword(errs *validation.Errors, password string) {
+	if n := len(password); n < minPasswordLength || n > maxPasswordLength {
+		errs.Add("password", "must be %d to %d bytes long", minPasswordLength, maxPasswordLength)
+	}
+
+	var letter, other bool
+	for _, r := range password {
+		if unicode.IsLetter(r) {
+			letter = true
+		} else {
+			other = true
+		}
+	}
+	if !letter || !other {
+		errs.Add("password", "must contain at least one letter and one digit or symbol")
+	}
+}
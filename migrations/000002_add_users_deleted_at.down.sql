@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS users_deleted_at_idx;
+
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
+
+END;
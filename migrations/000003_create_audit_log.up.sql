@@ -0,0 +1,23 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS audit_log
+(
+    id          BIGSERIAL NOT NULL,
+    actor       TEXT      NOT NULL DEFAULT '',
+    action      TEXT      NOT NULL,
+    target_type TEXT      NOT NULL,
+    target_id   TEXT      NOT NULL,
+    request_id  TEXT      NOT NULL DEFAULT '',
+    ip          TEXT      NOT NULL DEFAULT '',
+    before      JSONB,
+    after       JSONB,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT pk_audit_log_idx PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_created_at_idx ON audit_log (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS audit_log_actor_created_at_idx ON audit_log (actor, created_at DESC);
+CREATE INDEX IF NOT EXISTS audit_log_action_created_at_idx ON audit_log (action, created_at DESC);
+
+END;
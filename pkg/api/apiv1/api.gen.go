@@ -15,10 +15,12 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // Defines values for ErrorCode.
@@ -27,66 +29,880 @@ const (
 	Conflict            ErrorCode = "conflict"
 	InternalServerError ErrorCode = "internalServerError"
 	NotFound            ErrorCode = "notFound"
+	TooManyRequests     ErrorCode = "tooManyRequests"
 )
 
+// Defines values for ImportJobStatus.
+const (
+	ImportJobStatusDone    ImportJobStatus = "done"
+	ImportJobStatusFailed  ImportJobStatus = "failed"
+	ImportJobStatusPending ImportJobStatus = "pending"
+	ImportJobStatusRunning ImportJobStatus = "running"
+)
+
+// Defines values for ImportUploadFormat.
+const (
+	Netscape  ImportUploadFormat = "netscape"
+	Pinboard  ImportUploadFormat = "pinboard"
+	PocketCsv ImportUploadFormat = "pocket_csv"
+)
+
+// Defines values for LinkRevisionSource.
+const (
+	LinkRevisionSourceEnricher LinkRevisionSource = "enricher"
+	LinkRevisionSourceImport   LinkRevisionSource = "import"
+	LinkRevisionSourceUser     LinkRevisionSource = "user"
+)
+
+// Defines values for ProfileSectionErrorSection.
+const (
+	ProfileSectionErrorSectionLinks ProfileSectionErrorSection = "links"
+	ProfileSectionErrorSectionTags  ProfileSectionErrorSection = "tags"
+	ProfileSectionErrorSectionUser  ProfileSectionErrorSection = "user"
+)
+
+// Defines values for UserCleanupStatus.
+const (
+	UserCleanupStatusDone     UserCleanupStatus = "done"
+	UserCleanupStatusFailed   UserCleanupStatus = "failed"
+	UserCleanupStatusRestored UserCleanupStatus = "restored"
+	UserCleanupStatusRunning  UserCleanupStatus = "running"
+)
+
+// Defines values for UserExportStatus.
+const (
+	Done    UserExportStatus = "done"
+	Failed  UserExportStatus = "failed"
+	Pending UserExportStatus = "pending"
+	Running UserExportStatus = "running"
+)
+
+// Defines values for Visibility.
+const (
+	Private  Visibility = "private"
+	Public   Visibility = "public"
+	Unlisted Visibility = "unlisted"
+)
+
+// Defines values for GetAuditParamsAction.
+const (
+	UserCreated  GetAuditParamsAction = "user.created"
+	UserDeleted  GetAuditParamsAction = "user.deleted"
+	UserRestored GetAuditParamsAction = "user.restored"
+	UserUpdated  GetAuditParamsAction = "user.updated"
+)
+
+// Defines values for GetLinksParamsTagsMode.
+const (
+	All GetLinksParamsTagsMode = "all"
+	Any GetLinksParamsTagsMode = "any"
+)
+
+// Defines values for GetLinksExportParamsFormat.
+const (
+	Csv  GetLinksExportParamsFormat = "csv"
+	Html GetLinksExportParamsFormat = "html"
+	Json GetLinksExportParamsFormat = "json"
+	Md   GetLinksExportParamsFormat = "md"
+)
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action string `json:"action"`
+
+	// Actor Пользователь из заголовка X-User-ID, пусто, если он не был указан
+	Actor *string `json:"actor,omitempty"`
+
+	// After Изменившиеся поля после действия, пароль скрыт
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// Before Изменившиеся поля до действия, пароль скрыт
+	Before    *map[string]interface{} `json:"before,omitempty"`
+	CreatedAt string                  `json:"created_at"`
+	Id        int64                   `json:"id"`
+	Ip        *string                 `json:"ip,omitempty"`
+
+	// RequestId Значение X-Request-ID, которое api-gw вернул клиенту
+	RequestId  *string `json:"request_id,omitempty"`
+	TargetId   string  `json:"target_id"`
+	TargetType string  `json:"target_type"`
+}
+
+// Collection defines model for Collection.
+type Collection struct {
+	CreatedAt   string   `json:"created_at"`
+	Description *string  `json:"description,omitempty"`
+	Id          string   `json:"id"`
+	LinkIds     []string `json:"link_ids"`
+	Name        string   `json:"name"`
+	ParentId    *string  `json:"parent_id,omitempty"`
+	Position    int64    `json:"position"`
+	UpdatedAt   string   `json:"updated_at"`
+	UserId      string   `json:"user_id"`
+}
+
+// CollectionCreate defines model for CollectionCreate.
+type CollectionCreate struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	ParentId    *string `json:"parent_id,omitempty"`
+	Position    *int64  `json:"position,omitempty"`
+	UserId      string  `json:"user_id"`
+}
+
+// CollectionLink defines model for CollectionLink.
+type CollectionLink struct {
+	LinkId string `json:"link_id"`
+}
+
+// CollectionOrder defines model for CollectionOrder.
+type CollectionOrder struct {
+	LinkIds []string `json:"link_ids"`
+}
+
+// CollectionUpdate defines model for CollectionUpdate.
+type CollectionUpdate struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	ParentId    *string `json:"parent_id,omitempty"`
+	Position    *int64  `json:"position,omitempty"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Code    ErrorCode `json:"code"`
 	Message *string   `json:"message,omitempty"`
+
+	// Violations Нарушения схемы запроса, если он не прошел проверку
+	Violations *[]Violation `json:"violations,omitempty"`
 }
 
 // ErrorCode defines model for Error.Code.
 type ErrorCode string
 
+// FieldChange defines model for FieldChange.
+type FieldChange struct {
+	// Field title, description, url, images, tags, auto_tags или visibility
+	Field string `json:"field"`
+
+	// New Значение после правки, строка или список строк
+	New interface{} `json:"new"`
+
+	// Old Значение до правки, строка или список строк
+	Old interface{} `json:"old"`
+}
+
+// ImageURL defines model for ImageURL.
+type ImageURL = string
+
+// ImportJob defines model for ImportJob.
+type ImportJob struct {
+	Created    int64           `json:"created"`
+	CreatedAt  string          `json:"created_at"`
+	Duplicates int64           `json:"duplicates"`
+	Error      *string         `json:"error,omitempty"`
+	Failed     int64           `json:"failed"`
+	FinishedAt *string         `json:"finished_at,omitempty"`
+	Format     *string         `json:"format,omitempty"`
+	Id         string          `json:"id"`
+	Processed  int64           `json:"processed"`
+	Status     ImportJobStatus `json:"status"`
+	Total      int64           `json:"total"`
+	UpdatedAt  string          `json:"updated_at"`
+	UserId     string          `json:"user_id"`
+}
+
+// ImportJobStatus defines model for ImportJob.Status.
+type ImportJobStatus string
+
+// ImportUpload defines model for ImportUpload.
+type ImportUpload struct {
+	File openapi_types.File `json:"file"`
+
+	// Format Пусто - определить по содержимому
+	Format *ImportUploadFormat `json:"format,omitempty"`
+	UserId string              `json:"user_id"`
+}
+
+// ImportUploadFormat Пусто - определить по содержимому
+type ImportUploadFormat string
+
 // Link defines model for Link.
 type Link struct {
-	CreatedAt string   `json:"created_at"`
-	Id        string   `json:"id"`
-	Images    []string `json:"images"`
+	Archived *bool `json:"archived,omitempty"`
+
+	// AutoTags Теги, извлеченные из страницы при обогащении
+	AutoTags  *[]string `json:"auto_tags,omitempty"`
+	CreatedAt string    `json:"created_at"`
+
+	// DeletedAt Когда ссылку переместили в корзину, заполнено только в корзине
+	DeletedAt   *string  `json:"deleted_at,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Favorite    *bool    `json:"favorite,omitempty"`
+	Id          string   `json:"id"`
+	Images      []string `json:"images"`
+
+	// Notes Заметки владельца, подошедшие под поисковый запрос q
+	Notes *[]Note `json:"notes,omitempty"`
+
+	// ReadAt Время первого прочтения, пустое у непрочитанной ссылки
+	ReadAt    *string  `json:"read_at,omitempty"`
 	Tags      []string `json:"tags"`
 	Title     string   `json:"title"`
 	UpdatedAt string   `json:"updated_at"`
 	Url       string   `json:"url"`
 	UserId    string   `json:"user_id"`
+
+	// Visibility private - только владелец, unlisted - любой по ID ссылки, public - все, включая общие списки и ленты
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// LinkContent defines model for LinkContent.
+type LinkContent struct {
+	ExtractedAt        string `json:"extracted_at"`
+	LinkId             string `json:"link_id"`
+	ReadingTimeMinutes int32  `json:"reading_time_minutes"`
+	Text               string `json:"text"`
+	WordCount          int32  `json:"word_count"`
 }
 
 // LinkCreate defines model for LinkCreate.
 type LinkCreate struct {
-	Id     string   `json:"id"`
-	Images []string `json:"images"`
-	Tags   []string `json:"tags"`
-	Title  string   `json:"title"`
-	Url    string   `json:"url"`
-	UserId string   `json:"user_id"`
+	Description *string `json:"description,omitempty"`
+
+	// Id При создании не передается, при обновлении совпадает с ID из пути
+	Id     string     `json:"id,omitempty"`
+	Images []ImageURL `json:"images,omitempty"`
+
+	// ReturnExisting Вернуть уже сохраненную ссылку с тем же нормализованным URL вместо ошибки 409
+	ReturnExisting *bool  `json:"return_existing,omitempty"`
+	Tags           []Tag  `json:"tags,omitempty"`
+	Title          string `json:"title,omitempty"`
+
+	// Url Адрес http или https
+	Url    string `json:"url"`
+	UserId string `json:"user_id,omitempty"`
+
+	// Visibility private - только владелец, unlisted - любой по ID ссылки, public - все, включая общие списки и ленты
+	Visibility *Visibility `json:"visibility,omitempty"`
 }
 
-// User defines model for User.
-type User struct {
+// LinkRevision defines model for LinkRevision.
+type LinkRevision struct {
+	// Actor ID пользователя, внесшего правку, пусто для обогащения
+	Actor     *string            `json:"actor,omitempty"`
+	Changes   []FieldChange      `json:"changes"`
+	CreatedAt string             `json:"created_at"`
+	Id        string             `json:"id"`
+	LinkId    string             `json:"link_id"`
+	Source    LinkRevisionSource `json:"source"`
+}
+
+// LinkRevisionSource defines model for LinkRevision.Source.
+type LinkRevisionSource string
+
+// LinkSettings defines model for LinkSettings.
+type LinkSettings struct {
+	// DefaultVisibility private - только владелец, unlisted - любой по ID ссылки, public - все, включая общие списки и ленты
+	DefaultVisibility Visibility `json:"default_visibility"`
+	UserId            *string    `json:"user_id,omitempty"`
+}
+
+// LinkSlug defines model for LinkSlug.
+type LinkSlug struct {
+	LinkId string `json:"link_id"`
+	Slug   string `json:"slug"`
+}
+
+// LinkSlugCreate defines model for LinkSlugCreate.
+type LinkSlugCreate struct {
+	// Slug Пустой slug генерируется автоматически
+	Slug *string `json:"slug,omitempty"`
+}
+
+// LinkStats defines model for LinkStats.
+type LinkStats struct {
+	ByCountry   []StatBucket `json:"by_country"`
+	ByDay       []StatBucket `json:"by_day"`
+	ByReferrer  []StatBucket `json:"by_referrer"`
+	ByUserAgent []StatBucket `json:"by_user_agent"`
+	LinkId      string       `json:"link_id"`
+	Total       int64        `json:"total"`
+}
+
+// LinksStateResult defines model for LinksStateResult.
+type LinksStateResult struct {
+	// Matched Сколько ссылок пользователя найдено по ids
+	Matched int64 `json:"matched"`
+}
+
+// LinksStateUpdate defines model for LinksStateUpdate.
+type LinksStateUpdate struct {
+	Archived *bool    `json:"archived,omitempty"`
+	Favorite *bool    `json:"favorite,omitempty"`
+	Ids      []string `json:"ids"`
+	Read     *bool    `json:"read,omitempty"`
+	UserId   string   `json:"user_id"`
+}
+
+// Note defines model for Note.
+type Note struct {
+	// Body Текст заметки в markdown
+	Body      string `json:"body"`
+	CreatedAt string `json:"created_at"`
+
+	// End Конец выделения, не включая
+	End    *int32 `json:"end,omitempty"`
+	Id     string `json:"id"`
+	LinkId string `json:"link_id"`
+
+	// Quote Выделенный фрагмент извлеченного текста статьи
+	Quote *string `json:"quote,omitempty"`
+
+	// Start Начало выделения в символах извлеченного текста
+	Start     *int32 `json:"start,omitempty"`
+	UpdatedAt string `json:"updated_at"`
+	UserId    string `json:"user_id"`
+}
+
+// NoteCreate defines model for NoteCreate.
+type NoteCreate struct {
+	Body *string `json:"body,omitempty"`
+	End  *int32  `json:"end,omitempty"`
+
+	// Quote Если заданы только start и end, цитата берется из извлеченного текста
+	Quote  *string `json:"quote,omitempty"`
+	Start  *int32  `json:"start,omitempty"`
+	UserId string  `json:"user_id"`
+}
+
+// NoteUpdate defines model for NoteUpdate.
+type NoteUpdate struct {
+	Body   string `json:"body"`
+	UserId string `json:"user_id"`
+}
+
+// Password Хотя бы одна буква и хотя бы одна цифра или другой символ
+type Password = string
+
+// ProfileSectionError defines model for ProfileSectionError.
+type ProfileSectionError struct {
+	// Code Код ошибки gRPC, например Unavailable или DeadlineExceeded
+	Code    string                     `json:"code"`
+	Message string                     `json:"message"`
+	Section ProfileSectionErrorSection `json:"section"`
+}
+
+// ProfileSectionErrorSection defines model for ProfileSectionError.Section.
+type ProfileSectionErrorSection string
+
+// ProfileUser Пользователь без пароля
+type ProfileUser struct {
 	CreatedAt string `json:"created_at"`
 	Id        string `json:"id"`
-	Password  string `json:"password"`
 	UpdatedAt string `json:"updated_at"`
 	Username  string `json:"username"`
 }
 
+// Share defines model for Share.
+type Share struct {
+	CollectionId *string `json:"collection_id,omitempty"`
+	CreatedAt    string  `json:"created_at"`
+	ExpiresAt    *string `json:"expires_at,omitempty"`
+	HasPassword  bool    `json:"has_password"`
+	Id           string  `json:"id"`
+	LinkId       *string `json:"link_id,omitempty"`
+	RevokedAt    *string `json:"revoked_at,omitempty"`
+	Token        string  `json:"token"`
+	UserId       string  `json:"user_id"`
+	Views        int64   `json:"views"`
+}
+
+// ShareCreate defines model for ShareCreate.
+type ShareCreate struct {
+	CollectionId *string `json:"collection_id,omitempty"`
+	LinkId       *string `json:"link_id,omitempty"`
+	Password     *string `json:"password,omitempty"`
+	TtlSeconds   *int64  `json:"ttl_seconds,omitempty"`
+	UserId       string  `json:"user_id"`
+}
+
+// SharedContent defines model for SharedContent.
+type SharedContent struct {
+	Description *string      `json:"description,omitempty"`
+	Links       []SharedLink `json:"links"`
+	Name        string       `json:"name"`
+	Views       int64        `json:"views"`
+}
+
+// SharedLink defines model for SharedLink.
+type SharedLink struct {
+	CreatedAt string    `json:"created_at"`
+	Images    *[]string `json:"images,omitempty"`
+	Tags      *[]string `json:"tags,omitempty"`
+	Title     string    `json:"title"`
+	Url       string    `json:"url"`
+}
+
+// Snapshot defines model for Snapshot.
+type Snapshot struct {
+	ContentType string `json:"content_type"`
+	CreatedAt   string `json:"created_at"`
+	LinkId      string `json:"link_id"`
+	Sha256      string `json:"sha256"`
+	Size        int64  `json:"size"`
+}
+
+// StatBucket defines model for StatBucket.
+type StatBucket struct {
+	Count int64  `json:"count"`
+	Key   string `json:"key"`
+}
+
+// Tag defines model for Tag.
+type Tag = string
+
+// TagCount defines model for TagCount.
+type TagCount struct {
+	Count int64  `json:"count"`
+	Tag   string `json:"tag"`
+}
+
+// TagMerge defines model for TagMerge.
+type TagMerge struct {
+	From []Tag `json:"from"`
+	To   Tag   `json:"to"`
+}
+
+// TagRename defines model for TagRename.
+type TagRename struct {
+	Name Tag `json:"name"`
+}
+
+// TagsUpdateResult defines model for TagsUpdateResult.
+type TagsUpdateResult struct {
+	Modified int64 `json:"modified"`
+}
+
+// User defines model for User.
+type User struct {
+	CreatedAt string `json:"created_at"`
+
+	// DeletedAt Когда пользователя удалили, заполнено только в корзине
+	DeletedAt *string `json:"deleted_at,omitempty"`
+	Id        string  `json:"id"`
+	Password  string  `json:"password"`
+	UpdatedAt string  `json:"updated_at"`
+	Username  string  `json:"username"`
+}
+
+// UserCleanup defines model for UserCleanup.
+type UserCleanup struct {
+	// Collections Коллекции, перемещенные в корзину
+	Collections int64   `json:"collections"`
+	DeletedAt   string  `json:"deleted_at"`
+	Error       *string `json:"error,omitempty"`
+	FinishedAt  *string `json:"finished_at,omitempty"`
+
+	// Links Ссылки, перемещенные в корзину
+	Links int64 `json:"links"`
+
+	// Restored Ссылки, возвращенные из корзины при восстановлении пользователя
+	Restored   *int64  `json:"restored,omitempty"`
+	RestoredAt *string `json:"restored_at,omitempty"`
+
+	// Shares Отозванные ссылки доступа
+	Shares    int64             `json:"shares"`
+	Status    UserCleanupStatus `json:"status"`
+	UpdatedAt string            `json:"updated_at"`
+	UserId    string            `json:"user_id"`
+}
+
+// UserCleanupStatus defines model for UserCleanup.Status.
+type UserCleanupStatus string
+
 // UserCreate defines model for UserCreate.
 type UserCreate struct {
-	Id       string `json:"id"`
-	Password string `json:"password"`
-	Username string `json:"username"`
+	// Id Не передается, ID генерирует users-srv
+	Id string `json:"id,omitempty"`
+
+	// Password Хотя бы одна буква и хотя бы одна цифра или другой символ
+	Password Password `json:"password"`
+
+	// Username Латинские буквы, цифры, точка, дефис и подчеркивание
+	Username Username `json:"username"`
+}
+
+// UserExport defines model for UserExport.
+type UserExport struct {
+	CreatedAt  string  `json:"created_at"`
+	Error      *string `json:"error,omitempty"`
+	FinishedAt *string `json:"finished_at,omitempty"`
+	Id         string  `json:"id"`
+
+	// Size Размер архива в байтах
+	Size   *int64           `json:"size,omitempty"`
+	Status UserExportStatus `json:"status"`
+	UserId string           `json:"user_id"`
+}
+
+// UserExportStatus defines model for UserExport.Status.
+type UserExportStatus string
+
+// UserProfile defines model for UserProfile.
+type UserProfile struct {
+	Errors []ProfileSectionError `json:"errors"`
+	Links  *[]Link               `json:"links,omitempty"`
+
+	// Partial Часть разделов не получена, причины перечислены в errors
+	Partial bool        `json:"partial"`
+	Tags    *[]TagCount `json:"tags,omitempty"`
+
+	// User Пользователь без пароля
+	User *ProfileUser `json:"user,omitempty"`
+}
+
+// UserUpdate defines model for UserUpdate.
+type UserUpdate struct {
+	// Id Совпадает с ID из пути
+	Id string `json:"id,omitempty"`
+
+	// Password Хотя бы одна буква и хотя бы одна цифра или другой символ
+	Password *Password `json:"password,omitempty"`
+
+	// Username Латинские буквы, цифры, точка, дефис и подчеркивание
+	Username *Username `json:"username,omitempty"`
+}
+
+// Username Латинские буквы, цифры, точка, дефис и подчеркивание
+type Username = string
+
+// Violation defines model for Violation.
+type Violation struct {
+	// Field Параметр или путь к полю тела через точку
+	Field *string `json:"field,omitempty"`
+
+	// In Где найдено нарушение - body, path, query или header
+	In      string `json:"in"`
+	Message string `json:"message"`
+}
+
+// Visibility private - только владелец, unlisted - любой по ID ссылки, public - все, включая общие списки и ленты
+type Visibility string
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	// Actor Пользователь, выполнивший действие
+	Actor  *string               `form:"actor,omitempty" json:"actor,omitempty"`
+	Action *GetAuditParamsAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// From Начало периода, включительно
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода, не включительно
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Размер страницы, по умолчанию 50, не больше 500
+	Limit  *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// XUserID Администратор, от имени которого выполняется запрос
+	XUserID string `json:"X-User-ID"`
+
+	// XAdminToken Секрет USERS_ADMIN_TOKEN, подтверждающий запрос администратора
+	XAdminToken string `json:"X-Admin-Token"`
+}
+
+// GetAuditParamsAction defines parameters for GetAudit.
+type GetAuditParamsAction string
+
+// GetCollectionsParams defines parameters for GetCollections.
+type GetCollectionsParams struct {
+	UserId string `form:"user_id" json:"user_id"`
+}
+
+// GetCollectionsIdLinksParams defines parameters for GetCollectionsIdLinks.
+type GetCollectionsIdLinksParams struct {
+	// XUserID Кто запрашивает ссылки. Приватные и скрытые ссылки видны, только если это владелец коллекции
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// GetImportIdParams defines parameters for GetImportId.
+type GetImportIdParams struct {
+	// XUserID Владелец задания, от имени которого выполняется запрос
+	XUserID string `json:"X-User-ID"`
+}
+
+// GetLinksParams defines parameters for GetLinks.
+type GetLinksParams struct {
+	// UserId Владелец ссылок
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// Tags Теги через запятую
+	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+
+	// TagsMode any - ссылка содержит хотя бы один тег, all - все теги
+	TagsMode *GetLinksParamsTagsMode `form:"tags_mode,omitempty" json:"tags_mode,omitempty"`
+
+	// ExcludeTags Теги через запятую, ссылки с которыми нужно исключить
+	ExcludeTags *[]string `form:"exclude_tags,omitempty" json:"exclude_tags,omitempty"`
+
+	// Q Подстрока в названии, описании или адресе. Вместе с user_id ищет и по заметкам владельца, подошедшие заметки возвращаются в notes
+	Q      *string `form:"q,omitempty" json:"q,omitempty"`
+	Limit  *int64  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int64  `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// XUserID Кто запрашивает ссылки. Приватные и скрытые ссылки видны, только если это владелец из user_id
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// GetLinksParamsTagsMode defines parameters for GetLinks.
+type GetLinksParamsTagsMode string
+
+// GetLinksArchiveParams defines parameters for GetLinksArchive.
+type GetLinksArchiveParams struct {
+	UserId string `form:"user_id" json:"user_id"`
+	Limit  *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// XUserID Кто запрашивает ссылки. Приватные и скрытые ссылки видны, только если это сам пользователь
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// GetLinksExportParams defines parameters for GetLinksExport.
+type GetLinksExportParams struct {
+	UserId string                      `form:"user_id" json:"user_id"`
+	Format *GetLinksExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// XUserID Владелец ссылок, от имени которого выполняется запрос
+	XUserID string `json:"X-User-ID"`
+}
+
+// GetLinksExportParamsFormat defines parameters for GetLinksExport.
+type GetLinksExportParamsFormat string
+
+// GetLinksFavoritesParams defines parameters for GetLinksFavorites.
+type GetLinksFavoritesParams struct {
+	UserId string `form:"user_id" json:"user_id"`
+	Limit  *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// XUserID Кто запрашивает ссылки. Приватные и скрытые ссылки видны, только если это сам пользователь
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// GetLinksUnreadParams defines parameters for GetLinksUnread.
+type GetLinksUnreadParams struct {
+	UserId string `form:"user_id" json:"user_id"`
+	Limit  *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// XUserID Кто запрашивает ссылки. Приватные и скрытые ссылки видны, только если это сам пользователь
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// GetLinksUserUserIDParams defines parameters for GetLinksUserUserID.
+type GetLinksUserUserIDParams struct {
+	// XUserID Кто запрашивает ссылки. Приватные и скрытые ссылки видны, только если это сам пользователь
+	XUserID *string `json:"X-User-ID,omitempty"`
 }
 
+// DeleteLinksIdParams defines parameters for DeleteLinksId.
+type DeleteLinksIdParams struct {
+	// XUserID Кто удаляет ссылку. Если указан, удалить можно только свою ссылку, без него - любую, кроме приватной
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// GetLinksIdParams defines parameters for GetLinksId.
+type GetLinksIdParams struct {
+	// XUserID Кто запрашивает ссылку. Приватная ссылка видна только владельцу
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// GetLinksIdContentParams defines parameters for GetLinksIdContent.
+type GetLinksIdContentParams struct {
+	// XUserID Кто запрашивает ссылку. Приватная ссылка видна только владельцу
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// GetLinksIdHistoryParams defines parameters for GetLinksIdHistory.
+type GetLinksIdHistoryParams struct {
+	// UserId Владелец ссылки, история видна только ему
+	UserId string `form:"user_id" json:"user_id"`
+}
+
+// PostLinksIdHistoryRevisionIDRevertParams defines parameters for PostLinksIdHistoryRevisionIDRevert.
+type PostLinksIdHistoryRevisionIDRevertParams struct {
+	// UserId Владелец ссылки
+	UserId string `form:"user_id" json:"user_id"`
+}
+
+// GetLinksIdNotesParams defines parameters for GetLinksIdNotes.
+type GetLinksIdNotesParams struct {
+	// UserId Владелец ссылки, заметки видны только ему
+	UserId string `form:"user_id" json:"user_id"`
+}
+
+// DeleteLinksIdNotesNoteIDParams defines parameters for DeleteLinksIdNotesNoteID.
+type DeleteLinksIdNotesNoteIDParams struct {
+	// UserId Владелец ссылки, заметки видны только ему
+	UserId string `form:"user_id" json:"user_id"`
+}
+
+// PutLinksIdSlugParams defines parameters for PutLinksIdSlug.
+type PutLinksIdSlugParams struct {
+	// XUserID Владелец ссылки, от имени которого выполняется запрос
+	XUserID string `json:"X-User-ID"`
+}
+
+// GetLinksIdSnapshotsParams defines parameters for GetLinksIdSnapshots.
+type GetLinksIdSnapshotsParams struct {
+	// XUserID Кто запрашивает ссылку. Приватная ссылка видна только владельцу
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// GetLinksIdSnapshotsShaParams defines parameters for GetLinksIdSnapshotsSha.
+type GetLinksIdSnapshotsShaParams struct {
+	// XUserID Кто запрашивает ссылку. Приватная ссылка видна только владельцу
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// GetLinksIdStatsParams defines parameters for GetLinksIdStats.
+type GetLinksIdStatsParams struct {
+	// XUserID Владелец ссылки, от имени которого выполняется запрос
+	XUserID string `json:"X-User-ID"`
+}
+
+// GetPublicLinksParams defines parameters for GetPublicLinks.
+type GetPublicLinksParams struct {
+	Limit  *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetSharedTokenParams defines parameters for GetSharedToken.
+type GetSharedTokenParams struct {
+	// XSharePassword Пароль, если ссылка доступа им защищена
+	XSharePassword *string `json:"X-Share-Password,omitempty"`
+}
+
+// GetSharesParams defines parameters for GetShares.
+type GetSharesParams struct {
+	UserId string `form:"user_id" json:"user_id"`
+
+	// XUserID Владелец ссылок доступа, от имени которого выполняется запрос
+	XUserID string `json:"X-User-ID"`
+}
+
+// DeleteSharesIdParams defines parameters for DeleteSharesId.
+type DeleteSharesIdParams struct {
+	// XUserID Владелец ссылок доступа, от имени которого выполняется запрос
+	XUserID string `json:"X-User-ID"`
+}
+
+// GetTrashLinksParams defines parameters for GetTrashLinks.
+type GetTrashLinksParams struct {
+	// UserId Владелец корзины
+	UserId string `form:"user_id" json:"user_id"`
+}
+
+// PostTrashLinksIdRestoreParams defines parameters for PostTrashLinksIdRestore.
+type PostTrashLinksIdRestoreParams struct {
+	// UserId Владелец корзины
+	UserId string `form:"user_id" json:"user_id"`
+}
+
+// GetUsersIdExportParams defines parameters for GetUsersIdExport.
+type GetUsersIdExportParams struct {
+	// XUserID Администратор, от имени которого выполняется запрос
+	XUserID string `json:"X-User-ID"`
+
+	// XAdminToken Секрет USERS_ADMIN_TOKEN, подтверждающий запрос администратора
+	XAdminToken string `json:"X-Admin-Token"`
+}
+
+// PostUsersIdExportParams defines parameters for PostUsersIdExport.
+type PostUsersIdExportParams struct {
+	// XUserID Администратор, от имени которого выполняется запрос
+	XUserID string `json:"X-User-ID"`
+
+	// XAdminToken Секрет USERS_ADMIN_TOKEN, подтверждающий запрос администратора
+	XAdminToken string `json:"X-Admin-Token"`
+}
+
+// GetUsersIdLinkSettingsParams defines parameters for GetUsersIdLinkSettings.
+type GetUsersIdLinkSettingsParams struct {
+	// XUserID Владелец настроек, от имени которого выполняется запрос
+	XUserID string `json:"X-User-ID"`
+}
+
+// PutUsersIdLinkSettingsParams defines parameters for PutUsersIdLinkSettings.
+type PutUsersIdLinkSettingsParams struct {
+	// XUserID Владелец настроек, от имени которого выполняется запрос
+	XUserID string `json:"X-User-ID"`
+}
+
+// GetUsersIdProfileParams defines parameters for GetUsersIdProfile.
+type GetUsersIdProfileParams struct {
+	// XUserID Кто запрашивает профиль. Приватные и скрытые ссылки видны, только если это сам пользователь
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// GetUsersIdTagsParams defines parameters for GetUsersIdTags.
+type GetUsersIdTagsParams struct {
+	// XUserID Кто запрашивает теги. Теги приватных и скрытых ссылок видны, только если это сам пользователь
+	XUserID *string `json:"X-User-ID,omitempty"`
+}
+
+// PostCollectionsJSONRequestBody defines body for PostCollections for application/json ContentType.
+type PostCollectionsJSONRequestBody = CollectionCreate
+
+// PutCollectionsIdJSONRequestBody defines body for PutCollectionsId for application/json ContentType.
+type PutCollectionsIdJSONRequestBody = CollectionUpdate
+
+// PostCollectionsIdLinksJSONRequestBody defines body for PostCollectionsIdLinks for application/json ContentType.
+type PostCollectionsIdLinksJSONRequestBody = CollectionLink
+
+// PutCollectionsIdLinksJSONRequestBody defines body for PutCollectionsIdLinks for application/json ContentType.
+type PutCollectionsIdLinksJSONRequestBody = CollectionOrder
+
+// PostImportMultipartRequestBody defines body for PostImport for multipart/form-data ContentType.
+type PostImportMultipartRequestBody = ImportUpload
+
 // PostLinksJSONRequestBody defines body for PostLinks for application/json ContentType.
 type PostLinksJSONRequestBody = LinkCreate
 
+// PostLinksStateJSONRequestBody defines body for PostLinksState for application/json ContentType.
+type PostLinksStateJSONRequestBody = LinksStateUpdate
+
 // PutLinksIdJSONRequestBody defines body for PutLinksId for application/json ContentType.
 type PutLinksIdJSONRequestBody = LinkCreate
 
+// PostLinksIdNotesJSONRequestBody defines body for PostLinksIdNotes for application/json ContentType.
+type PostLinksIdNotesJSONRequestBody = NoteCreate
+
+// PutLinksIdNotesNoteIDJSONRequestBody defines body for PutLinksIdNotesNoteID for application/json ContentType.
+type PutLinksIdNotesNoteIDJSONRequestBody = NoteUpdate
+
+// PutLinksIdSlugJSONRequestBody defines body for PutLinksIdSlug for application/json ContentType.
+type PutLinksIdSlugJSONRequestBody = LinkSlugCreate
+
+// PostSharesJSONRequestBody defines body for PostShares for application/json ContentType.
+type PostSharesJSONRequestBody = ShareCreate
+
 // PostUsersJSONRequestBody defines body for PostUsers for application/json ContentType.
 type PostUsersJSONRequestBody = UserCreate
 
 // PutUsersIdJSONRequestBody defines body for PutUsersId for application/json ContentType.
-type PutUsersIdJSONRequestBody = UserCreate
+type PutUsersIdJSONRequestBody = UserUpdate
+
+// PutUsersIdLinkSettingsJSONRequestBody defines body for PutUsersIdLinkSettings for application/json ContentType.
+type PutUsersIdLinkSettingsJSONRequestBody = LinkSettings
+
+// PostUsersIdTagsMergeJSONRequestBody defines body for PostUsersIdTagsMerge for application/json ContentType.
+type PostUsersIdTagsMergeJSONRequestBody = TagMerge
+
+// PutUsersIdTagsTagJSONRequestBody defines body for PutUsersIdTagsTag for application/json ContentType.
+type PutUsersIdTagsTagJSONRequestBody = TagRename
 
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
@@ -161,28 +977,157 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
-	// GetLinks request
-	GetLinks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetAudit request
+	GetAudit(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PostLinksWithBody request with any body
+	// GetCollections request
+	GetCollections(ctx context.Context, params *GetCollectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCollectionsWithBody request with any body
+	PostCollectionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostCollections(ctx context.Context, body PostCollectionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCollectionsId request
+	DeleteCollectionsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCollectionsId request
+	GetCollectionsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutCollectionsIdWithBody request with any body
+	PutCollectionsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutCollectionsId(ctx context.Context, id string, body PutCollectionsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCollectionsIdLinks request
+	GetCollectionsIdLinks(ctx context.Context, id string, params *GetCollectionsIdLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCollectionsIdLinksWithBody request with any body
+	PostCollectionsIdLinksWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostCollectionsIdLinks(ctx context.Context, id string, body PostCollectionsIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutCollectionsIdLinksWithBody request with any body
+	PutCollectionsIdLinksWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutCollectionsIdLinks(ctx context.Context, id string, body PutCollectionsIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCollectionsIdLinksLinkID request
+	DeleteCollectionsIdLinksLinkID(ctx context.Context, id string, linkID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostImportWithBody request with any body
+	PostImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetImportId request
+	GetImportId(ctx context.Context, id string, params *GetImportIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLinks request
+	GetLinks(ctx context.Context, params *GetLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLinksWithBody request with any body
 	PostLinksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostLinks(ctx context.Context, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLinksArchive request
+	GetLinksArchive(ctx context.Context, params *GetLinksArchiveParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLinksExport request
+	GetLinksExport(ctx context.Context, params *GetLinksExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLinksFavorites request
+	GetLinksFavorites(ctx context.Context, params *GetLinksFavoritesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLinksStateWithBody request with any body
+	PostLinksStateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLinksState(ctx context.Context, body PostLinksStateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLinksUnread request
+	GetLinksUnread(ctx context.Context, params *GetLinksUnreadParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetLinksUserUserID request
-	GetLinksUserUserID(ctx context.Context, userID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLinksUserUserID(ctx context.Context, userID string, params *GetLinksUserUserIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteLinksId request
-	DeleteLinksId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteLinksId(ctx context.Context, id string, params *DeleteLinksIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetLinksId request
-	GetLinksId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetLinksId(ctx context.Context, id string, params *GetLinksIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PutLinksIdWithBody request with any body
 	PutLinksIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PutLinksId(ctx context.Context, id string, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLinksIdContent request
+	GetLinksIdContent(ctx context.Context, id string, params *GetLinksIdContentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLinksIdHistory request
+	GetLinksIdHistory(ctx context.Context, id string, params *GetLinksIdHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLinksIdHistoryRevisionIDRevert request
+	PostLinksIdHistoryRevisionIDRevert(ctx context.Context, id string, revisionID string, params *PostLinksIdHistoryRevisionIDRevertParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLinksIdNotes request
+	GetLinksIdNotes(ctx context.Context, id string, params *GetLinksIdNotesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLinksIdNotesWithBody request with any body
+	PostLinksIdNotesWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLinksIdNotes(ctx context.Context, id string, body PostLinksIdNotesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteLinksIdNotesNoteID request
+	DeleteLinksIdNotesNoteID(ctx context.Context, id string, noteID string, params *DeleteLinksIdNotesNoteIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutLinksIdNotesNoteIDWithBody request with any body
+	PutLinksIdNotesNoteIDWithBody(ctx context.Context, id string, noteID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutLinksIdNotesNoteID(ctx context.Context, id string, noteID string, body PutLinksIdNotesNoteIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutLinksIdSlugWithBody request with any body
+	PutLinksIdSlugWithBody(ctx context.Context, id string, params *PutLinksIdSlugParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutLinksIdSlug(ctx context.Context, id string, params *PutLinksIdSlugParams, body PutLinksIdSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLinksIdSnapshots request
+	GetLinksIdSnapshots(ctx context.Context, id string, params *GetLinksIdSnapshotsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLinksIdSnapshotsSha request
+	GetLinksIdSnapshotsSha(ctx context.Context, id string, sha string, params *GetLinksIdSnapshotsShaParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLinksIdStats request
+	GetLinksIdStats(ctx context.Context, id string, params *GetLinksIdStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPublicLinks request
+	GetPublicLinks(ctx context.Context, params *GetPublicLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSharedToken request
+	GetSharedToken(ctx context.Context, token string, params *GetSharedTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetShares request
+	GetShares(ctx context.Context, params *GetSharesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSharesWithBody request with any body
+	PostSharesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostShares(ctx context.Context, body PostSharesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSharesId request
+	DeleteSharesId(ctx context.Context, id string, params *DeleteSharesIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrashLinks request
+	GetTrashLinks(ctx context.Context, params *GetTrashLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTrashLinksIdRestore request
+	PostTrashLinksIdRestore(ctx context.Context, id string, params *PostTrashLinksIdRestoreParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrashUsers request
+	GetTrashUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTrashUsersIdRestore request
+	PostTrashUsersIdRestore(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUsers request
 	GetUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -201,10 +1146,46 @@ type ClientInterface interface {
 	PutUsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PutUsersId(ctx context.Context, id string, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersIdCleanup request
+	GetUsersIdCleanup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersIdExport request
+	GetUsersIdExport(ctx context.Context, id string, params *GetUsersIdExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersIdExport request
+	PostUsersIdExport(ctx context.Context, id string, params *PostUsersIdExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersIdLinkSettings request
+	GetUsersIdLinkSettings(ctx context.Context, id string, params *GetUsersIdLinkSettingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutUsersIdLinkSettingsWithBody request with any body
+	PutUsersIdLinkSettingsWithBody(ctx context.Context, id string, params *PutUsersIdLinkSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutUsersIdLinkSettings(ctx context.Context, id string, params *PutUsersIdLinkSettingsParams, body PutUsersIdLinkSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersIdProfile request
+	GetUsersIdProfile(ctx context.Context, id string, params *GetUsersIdProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersIdTags request
+	GetUsersIdTags(ctx context.Context, id string, params *GetUsersIdTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersIdTagsMergeWithBody request with any body
+	PostUsersIdTagsMergeWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersIdTagsMerge(ctx context.Context, id string, body PostUsersIdTagsMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersIdTagsTag request
+	DeleteUsersIdTagsTag(ctx context.Context, id string, tag string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutUsersIdTagsTagWithBody request with any body
+	PutUsersIdTagsTagWithBody(ctx context.Context, id string, tag string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutUsersIdTagsTag(ctx context.Context, id string, tag string, body PutUsersIdTagsTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetLinks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLinksRequest(c.Server)
+func (c *Client) GetAudit(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -215,8 +1196,8 @@ func (c *Client) GetLinks(ctx context.Context, reqEditors ...RequestEditorFn) (*
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostLinksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostLinksRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetCollections(ctx context.Context, params *GetCollectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCollectionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -227,8 +1208,8 @@ func (c *Client) PostLinksWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostLinks(ctx context.Context, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostLinksRequest(c.Server, body)
+func (c *Client) PostCollectionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCollectionsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -239,8 +1220,8 @@ func (c *Client) PostLinks(ctx context.Context, body PostLinksJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetLinksUserUserID(ctx context.Context, userID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLinksUserUserIDRequest(c.Server, userID)
+func (c *Client) PostCollections(ctx context.Context, body PostCollectionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCollectionsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -251,8 +1232,8 @@ func (c *Client) GetLinksUserUserID(ctx context.Context, userID string, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteLinksId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteLinksIdRequest(c.Server, id)
+func (c *Client) DeleteCollectionsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCollectionsIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -263,8 +1244,8 @@ func (c *Client) DeleteLinksId(ctx context.Context, id string, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetLinksId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLinksIdRequest(c.Server, id)
+func (c *Client) GetCollectionsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCollectionsIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -275,8 +1256,8 @@ func (c *Client) GetLinksId(ctx context.Context, id string, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutLinksIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutLinksIdRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) PutCollectionsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutCollectionsIdRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -287,8 +1268,8 @@ func (c *Client) PutLinksIdWithBody(ctx context.Context, id string, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutLinksId(ctx context.Context, id string, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutLinksIdRequest(c.Server, id, body)
+func (c *Client) PutCollectionsId(ctx context.Context, id string, body PutCollectionsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutCollectionsIdRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -299,8 +1280,8 @@ func (c *Client) PutLinksId(ctx context.Context, id string, body PutLinksIdJSONR
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUsersRequest(c.Server)
+func (c *Client) GetCollectionsIdLinks(ctx context.Context, id string, params *GetCollectionsIdLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCollectionsIdLinksRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -311,8 +1292,8 @@ func (c *Client) GetUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostUsersRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostCollectionsIdLinksWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCollectionsIdLinksRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -323,8 +1304,8 @@ func (c *Client) PostUsersWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostUsers(ctx context.Context, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostUsersRequest(c.Server, body)
+func (c *Client) PostCollectionsIdLinks(ctx context.Context, id string, body PostCollectionsIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCollectionsIdLinksRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -335,8 +1316,8 @@ func (c *Client) PostUsers(ctx context.Context, body PostUsersJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteUsersIdRequest(c.Server, id)
+func (c *Client) PutCollectionsIdLinksWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutCollectionsIdLinksRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -347,8 +1328,8 @@ func (c *Client) DeleteUsersId(ctx context.Context, id string, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUsersIdRequest(c.Server, id)
+func (c *Client) PutCollectionsIdLinks(ctx context.Context, id string, body PutCollectionsIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutCollectionsIdLinksRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -359,8 +1340,8 @@ func (c *Client) GetUsersId(ctx context.Context, id string, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutUsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutUsersIdRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) DeleteCollectionsIdLinksLinkID(ctx context.Context, id string, linkID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCollectionsIdLinksLinkIDRequest(c.Server, id, linkID)
 	if err != nil {
 		return nil, err
 	}
@@ -371,8 +1352,8 @@ func (c *Client) PutUsersIdWithBody(ctx context.Context, id string, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutUsersId(ctx context.Context, id string, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutUsersIdRequest(c.Server, id, body)
+func (c *Client) PostImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostImportRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -383,431 +1364,3812 @@ func (c *Client) PutUsersId(ctx context.Context, id string, body PutUsersIdJSONR
 	return c.Client.Do(req)
 }
 
-// NewGetLinksRequest generates requests for GetLinks
-func NewGetLinksRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetImportId(ctx context.Context, id string, params *GetImportIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetImportIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/links")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetLinks(ctx context.Context, params *GetLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostLinksRequest calls the generic PostLinks builder with application/json body
-func NewPostLinksRequest(server string, body PostLinksJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostLinksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLinksRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostLinksRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostLinksRequestWithBody generates requests for PostLinks with any type of body
-func NewPostLinksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostLinks(ctx context.Context, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLinksRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/links")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetLinksArchive(ctx context.Context, params *GetLinksArchiveParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksArchiveRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetLinksUserUserIDRequest generates requests for GetLinksUserUserID
-func NewGetLinksUserUserIDRequest(server string, userID string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
+func (c *Client) GetLinksExport(ctx context.Context, params *GetLinksExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksExportRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/links/user/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetLinksFavorites(ctx context.Context, params *GetLinksFavoritesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksFavoritesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteLinksIdRequest generates requests for DeleteLinksId
-func NewDeleteLinksIdRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) PostLinksStateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLinksStateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostLinksState(ctx context.Context, body PostLinksStateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLinksStateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/links/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetLinksUnread(ctx context.Context, params *GetLinksUnreadParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksUnreadRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetLinksIdRequest generates requests for GetLinksId
-func NewGetLinksIdRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetLinksUserUserID(ctx context.Context, userID string, params *GetLinksUserUserIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksUserUserIDRequest(c.Server, userID, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteLinksId(ctx context.Context, id string, params *DeleteLinksIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteLinksIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/links/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetLinksId(ctx context.Context, id string, params *GetLinksIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutLinksIdRequest calls the generic PutLinksId builder with application/json body
-func NewPutLinksIdRequest(server string, id string, body PutLinksIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutLinksIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutLinksIdRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutLinksIdRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutLinksIdRequestWithBody generates requests for PutLinksId with any type of body
-func NewPutLinksIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) PutLinksId(ctx context.Context, id string, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutLinksIdRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetLinksIdContent(ctx context.Context, id string, params *GetLinksIdContentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksIdContentRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/links/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetLinksIdHistory(ctx context.Context, id string, params *GetLinksIdHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksIdHistoryRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetUsersRequest generates requests for GetUsers
-func NewGetUsersRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostLinksIdHistoryRevisionIDRevert(ctx context.Context, id string, revisionID string, params *PostLinksIdHistoryRevisionIDRevertParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLinksIdHistoryRevisionIDRevertRequest(c.Server, id, revisionID, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/users")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetLinksIdNotes(ctx context.Context, id string, params *GetLinksIdNotesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksIdNotesRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostUsersRequest calls the generic PostUsers builder with application/json body
-func NewPostUsersRequest(server string, body PostUsersJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostLinksIdNotesWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLinksIdNotesRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostUsersRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostUsersRequestWithBody generates requests for PostUsers with any type of body
-func NewPostUsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostLinksIdNotes(ctx context.Context, id string, body PostLinksIdNotesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLinksIdNotesRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/users")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteLinksIdNotesNoteID(ctx context.Context, id string, noteID string, params *DeleteLinksIdNotesNoteIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteLinksIdNotesNoteIDRequest(c.Server, id, noteID, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PutLinksIdNotesNoteIDWithBody(ctx context.Context, id string, noteID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutLinksIdNotesNoteIDRequestWithBody(c.Server, id, noteID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteUsersIdRequest generates requests for DeleteUsersId
-func NewDeleteUsersIdRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) PutLinksIdNotesNoteID(ctx context.Context, id string, noteID string, body PutLinksIdNotesNoteIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutLinksIdNotesNoteIDRequest(c.Server, id, noteID, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutLinksIdSlugWithBody(ctx context.Context, id string, params *PutLinksIdSlugParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutLinksIdSlugRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutLinksIdSlug(ctx context.Context, id string, params *PutLinksIdSlugParams, body PutLinksIdSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutLinksIdSlugRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) GetLinksIdSnapshots(ctx context.Context, id string, params *GetLinksIdSnapshotsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksIdSnapshotsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetUsersIdRequest generates requests for GetUsersId
-func NewGetUsersIdRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetLinksIdSnapshotsSha(ctx context.Context, id string, sha string, params *GetLinksIdSnapshotsShaParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksIdSnapshotsShaRequest(c.Server, id, sha, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetLinksIdStats(ctx context.Context, id string, params *GetLinksIdStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLinksIdStatsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetPublicLinks(ctx context.Context, params *GetPublicLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPublicLinksRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetSharedToken(ctx context.Context, token string, params *GetSharedTokenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSharedTokenRequest(c.Server, token, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutUsersIdRequest calls the generic PutUsersId builder with application/json body
-func NewPutUsersIdRequest(server string, id string, body PutUsersIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetShares(ctx context.Context, params *GetSharesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSharesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutUsersIdRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutUsersIdRequestWithBody generates requests for PutUsersId with any type of body
-func NewPutUsersIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) PostSharesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSharesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostShares(ctx context.Context, body PostSharesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSharesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteSharesId(ctx context.Context, id string, params *DeleteSharesIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSharesIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) GetTrashLinks(ctx context.Context, params *GetTrashLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrashLinksRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PostTrashLinksIdRestore(ctx context.Context, id string, params *PostTrashLinksIdRestoreParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTrashLinksIdRestoreRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) GetTrashUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrashUsersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) PostTrashUsersIdRestore(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTrashUsersIdRestoreRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsers(ctx context.Context, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUsersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUsersId(ctx context.Context, id string, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersIdCleanup(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersIdCleanupRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersIdExport(ctx context.Context, id string, params *GetUsersIdExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersIdExportRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersIdExport(ctx context.Context, id string, params *PostUsersIdExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersIdExportRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersIdLinkSettings(ctx context.Context, id string, params *GetUsersIdLinkSettingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersIdLinkSettingsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUsersIdLinkSettingsWithBody(ctx context.Context, id string, params *PutUsersIdLinkSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersIdLinkSettingsRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUsersIdLinkSettings(ctx context.Context, id string, params *PutUsersIdLinkSettingsParams, body PutUsersIdLinkSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersIdLinkSettingsRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersIdProfile(ctx context.Context, id string, params *GetUsersIdProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersIdProfileRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersIdTags(ctx context.Context, id string, params *GetUsersIdTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersIdTagsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersIdTagsMergeWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersIdTagsMergeRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersIdTagsMerge(ctx context.Context, id string, body PostUsersIdTagsMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersIdTagsMergeRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersIdTagsTag(ctx context.Context, id string, tag string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersIdTagsTagRequest(c.Server, id, tag)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUsersIdTagsTagWithBody(ctx context.Context, id string, tag string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersIdTagsTagRequestWithBody(c.Server, id, tag, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUsersIdTagsTag(ctx context.Context, id string, tag string, body PutUsersIdTagsTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUsersIdTagsTagRequest(c.Server, id, tag, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAuditRequest generates requests for GetAudit
+func NewGetAuditRequest(server string, params *GetAuditParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Token", runtime.ParamLocationHeader, params.XAdminToken)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Admin-Token", headerParam1)
+
+	}
+
+	return req, nil
+}
+
+// NewGetCollectionsRequest generates requests for GetCollections
+func NewGetCollectionsRequest(server string, params *GetCollectionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/collections")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostCollectionsRequest calls the generic PostCollections builder with application/json body
+func NewPostCollectionsRequest(server string, body PostCollectionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCollectionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostCollectionsRequestWithBody generates requests for PostCollections with any type of body
+func NewPostCollectionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/collections")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteCollectionsIdRequest generates requests for DeleteCollectionsId
+func NewDeleteCollectionsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/collections/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCollectionsIdRequest generates requests for GetCollectionsId
+func NewGetCollectionsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/collections/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutCollectionsIdRequest calls the generic PutCollectionsId builder with application/json body
+func NewPutCollectionsIdRequest(server string, id string, body PutCollectionsIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutCollectionsIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutCollectionsIdRequestWithBody generates requests for PutCollectionsId with any type of body
+func NewPutCollectionsIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/collections/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCollectionsIdLinksRequest generates requests for GetCollectionsIdLinks
+func NewGetCollectionsIdLinksRequest(server string, id string, params *GetCollectionsIdLinksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/collections/%s/links", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostCollectionsIdLinksRequest calls the generic PostCollectionsIdLinks builder with application/json body
+func NewPostCollectionsIdLinksRequest(server string, id string, body PostCollectionsIdLinksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCollectionsIdLinksRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostCollectionsIdLinksRequestWithBody generates requests for PostCollectionsIdLinks with any type of body
+func NewPostCollectionsIdLinksRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/collections/%s/links", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutCollectionsIdLinksRequest calls the generic PutCollectionsIdLinks builder with application/json body
+func NewPutCollectionsIdLinksRequest(server string, id string, body PutCollectionsIdLinksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutCollectionsIdLinksRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutCollectionsIdLinksRequestWithBody generates requests for PutCollectionsIdLinks with any type of body
+func NewPutCollectionsIdLinksRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/collections/%s/links", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteCollectionsIdLinksLinkIDRequest generates requests for DeleteCollectionsIdLinksLinkID
+func NewDeleteCollectionsIdLinksLinkIDRequest(server string, id string, linkID string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "linkID", runtime.ParamLocationPath, linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/collections/%s/links/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostImportRequestWithBody generates requests for PostImport with any type of body
+func NewPostImportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetImportIdRequest generates requests for GetImportId
+func NewGetImportIdRequest(server string, id string, params *GetImportIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/import/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetLinksRequest generates requests for GetLinks
+func NewGetLinksRequest(server string, params *GetLinksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tags != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TagsMode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags_mode", runtime.ParamLocationQuery, *params.TagsMode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExcludeTags != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude_tags", runtime.ParamLocationQuery, *params.ExcludeTags); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostLinksRequest calls the generic PostLinks builder with application/json body
+func NewPostLinksRequest(server string, body PostLinksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLinksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLinksRequestWithBody generates requests for PostLinks with any type of body
+func NewPostLinksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetLinksArchiveRequest generates requests for GetLinksArchive
+func NewGetLinksArchiveRequest(server string, params *GetLinksArchiveParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/archive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetLinksExportRequest generates requests for GetLinksExport
+func NewGetLinksExportRequest(server string, params *GetLinksExportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetLinksFavoritesRequest generates requests for GetLinksFavorites
+func NewGetLinksFavoritesRequest(server string, params *GetLinksFavoritesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/favorites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostLinksStateRequest calls the generic PostLinksState builder with application/json body
+func NewPostLinksStateRequest(server string, body PostLinksStateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLinksStateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLinksStateRequestWithBody generates requests for PostLinksState with any type of body
+func NewPostLinksStateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/state")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetLinksUnreadRequest generates requests for GetLinksUnread
+func NewGetLinksUnreadRequest(server string, params *GetLinksUnreadParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/unread")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetLinksUserUserIDRequest generates requests for GetLinksUserUserID
+func NewGetLinksUserUserIDRequest(server string, userID string, params *GetLinksUserUserIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userID", runtime.ParamLocationPath, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/user/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteLinksIdRequest generates requests for DeleteLinksId
+func NewDeleteLinksIdRequest(server string, id string, params *DeleteLinksIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetLinksIdRequest generates requests for GetLinksId
+func NewGetLinksIdRequest(server string, id string, params *GetLinksIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutLinksIdRequest calls the generic PutLinksId builder with application/json body
+func NewPutLinksIdRequest(server string, id string, body PutLinksIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutLinksIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutLinksIdRequestWithBody generates requests for PutLinksId with any type of body
+func NewPutLinksIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetLinksIdContentRequest generates requests for GetLinksIdContent
+func NewGetLinksIdContentRequest(server string, id string, params *GetLinksIdContentParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/content", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetLinksIdHistoryRequest generates requests for GetLinksIdHistory
+func NewGetLinksIdHistoryRequest(server string, id string, params *GetLinksIdHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostLinksIdHistoryRevisionIDRevertRequest generates requests for PostLinksIdHistoryRevisionIDRevert
+func NewPostLinksIdHistoryRevisionIDRevertRequest(server string, id string, revisionID string, params *PostLinksIdHistoryRevisionIDRevertParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revisionID", runtime.ParamLocationPath, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/history/%s/revert", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLinksIdNotesRequest generates requests for GetLinksIdNotes
+func NewGetLinksIdNotesRequest(server string, id string, params *GetLinksIdNotesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/notes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostLinksIdNotesRequest calls the generic PostLinksIdNotes builder with application/json body
+func NewPostLinksIdNotesRequest(server string, id string, body PostLinksIdNotesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLinksIdNotesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostLinksIdNotesRequestWithBody generates requests for PostLinksIdNotes with any type of body
+func NewPostLinksIdNotesRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/notes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteLinksIdNotesNoteIDRequest generates requests for DeleteLinksIdNotesNoteID
+func NewDeleteLinksIdNotesNoteIDRequest(server string, id string, noteID string, params *DeleteLinksIdNotesNoteIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "noteID", runtime.ParamLocationPath, noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/notes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutLinksIdNotesNoteIDRequest calls the generic PutLinksIdNotesNoteID builder with application/json body
+func NewPutLinksIdNotesNoteIDRequest(server string, id string, noteID string, body PutLinksIdNotesNoteIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutLinksIdNotesNoteIDRequestWithBody(server, id, noteID, "application/json", bodyReader)
+}
+
+// NewPutLinksIdNotesNoteIDRequestWithBody generates requests for PutLinksIdNotesNoteID with any type of body
+func NewPutLinksIdNotesNoteIDRequestWithBody(server string, id string, noteID string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "noteID", runtime.ParamLocationPath, noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/notes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutLinksIdSlugRequest calls the generic PutLinksIdSlug builder with application/json body
+func NewPutLinksIdSlugRequest(server string, id string, params *PutLinksIdSlugParams, body PutLinksIdSlugJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutLinksIdSlugRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPutLinksIdSlugRequestWithBody generates requests for PutLinksIdSlug with any type of body
+func NewPutLinksIdSlugRequestWithBody(server string, id string, params *PutLinksIdSlugParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/slug", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetLinksIdSnapshotsRequest generates requests for GetLinksIdSnapshots
+func NewGetLinksIdSnapshotsRequest(server string, id string, params *GetLinksIdSnapshotsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/snapshots", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetLinksIdSnapshotsShaRequest generates requests for GetLinksIdSnapshotsSha
+func NewGetLinksIdSnapshotsShaRequest(server string, id string, sha string, params *GetLinksIdSnapshotsShaParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "sha", runtime.ParamLocationPath, sha)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/snapshots/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetLinksIdStatsRequest generates requests for GetLinksIdStats
+func NewGetLinksIdStatsRequest(server string, id string, params *GetLinksIdStatsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links/%s/stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetPublicLinksRequest generates requests for GetPublicLinks
+func NewGetPublicLinksRequest(server string, params *GetPublicLinksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/public/links")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSharedTokenRequest generates requests for GetSharedToken
+func NewGetSharedTokenRequest(server string, token string, params *GetSharedTokenParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/shared/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XSharePassword != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Share-Password", runtime.ParamLocationHeader, *params.XSharePassword)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Share-Password", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetSharesRequest generates requests for GetShares
+func NewGetSharesRequest(server string, params *GetSharesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/shares")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostSharesRequest calls the generic PostShares builder with application/json body
+func NewPostSharesRequest(server string, body PostSharesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSharesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostSharesRequestWithBody generates requests for PostShares with any type of body
+func NewPostSharesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/shares")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSharesIdRequest generates requests for DeleteSharesId
+func NewDeleteSharesIdRequest(server string, id string, params *DeleteSharesIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/shares/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetTrashLinksRequest generates requests for GetTrashLinks
+func NewGetTrashLinksRequest(server string, params *GetTrashLinksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trash/links")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTrashLinksIdRestoreRequest generates requests for PostTrashLinksIdRestore
+func NewPostTrashLinksIdRestoreRequest(server string, id string, params *PostTrashLinksIdRestoreParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trash/links/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTrashUsersRequest generates requests for GetTrashUsers
+func NewGetTrashUsersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trash/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTrashUsersIdRestoreRequest generates requests for PostTrashUsersIdRestore
+func NewPostTrashUsersIdRestoreRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trash/users/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersRequest generates requests for GetUsers
+func NewGetUsersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersRequest calls the generic PostUsers builder with application/json body
+func NewPostUsersRequest(server string, body PostUsersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersRequestWithBody generates requests for PostUsers with any type of body
+func NewPostUsersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUsersIdRequest generates requests for DeleteUsersId
+func NewDeleteUsersIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersIdRequest generates requests for GetUsersId
+func NewGetUsersIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutUsersIdRequest calls the generic PutUsersId builder with application/json body
+func NewPutUsersIdRequest(server string, id string, body PutUsersIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUsersIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPutUsersIdRequestWithBody generates requests for PutUsersId with any type of body
+func NewPutUsersIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersIdCleanupRequest generates requests for GetUsersIdCleanup
+func NewGetUsersIdCleanupRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/cleanup", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersIdExportRequest generates requests for GetUsersIdExport
+func NewGetUsersIdExportRequest(server string, id string, params *GetUsersIdExportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Token", runtime.ParamLocationHeader, params.XAdminToken)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Admin-Token", headerParam1)
+
+	}
+
+	return req, nil
+}
+
+// NewPostUsersIdExportRequest generates requests for PostUsersIdExport
+func NewPostUsersIdExportRequest(server string, id string, params *PostUsersIdExportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Admin-Token", runtime.ParamLocationHeader, params.XAdminToken)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Admin-Token", headerParam1)
+
+	}
+
+	return req, nil
+}
+
+// NewGetUsersIdLinkSettingsRequest generates requests for GetUsersIdLinkSettings
+func NewGetUsersIdLinkSettingsRequest(server string, id string, params *GetUsersIdLinkSettingsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/link-settings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPutUsersIdLinkSettingsRequest calls the generic PutUsersIdLinkSettings builder with application/json body
+func NewPutUsersIdLinkSettingsRequest(server string, id string, params *PutUsersIdLinkSettingsParams, body PutUsersIdLinkSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUsersIdLinkSettingsRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewPutUsersIdLinkSettingsRequestWithBody generates requests for PutUsersIdLinkSettings with any type of body
+func NewPutUsersIdLinkSettingsRequestWithBody(server string, id string, params *PutUsersIdLinkSettingsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/link-settings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetUsersIdProfileRequest generates requests for GetUsersIdProfile
+func NewGetUsersIdProfileRequest(server string, id string, params *GetUsersIdProfileParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/profile", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUsersIdTagsRequest generates requests for GetUsersIdTags
+func NewGetUsersIdTagsRequest(server string, id string, params *GetUsersIdTagsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XUserID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, *params.XUserID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-User-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostUsersIdTagsMergeRequest calls the generic PostUsersIdTagsMerge builder with application/json body
+func NewPostUsersIdTagsMergeRequest(server string, id string, body PostUsersIdTagsMergeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersIdTagsMergeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostUsersIdTagsMergeRequestWithBody generates requests for PostUsersIdTagsMerge with any type of body
+func NewPostUsersIdTagsMergeRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/tags/merge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUsersIdTagsTagRequest generates requests for DeleteUsersIdTagsTag
+func NewDeleteUsersIdTagsTagRequest(server string, id string, tag string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutUsersIdTagsTagRequest calls the generic PutUsersIdTagsTag builder with application/json body
+func NewPutUsersIdTagsTagRequest(server string, id string, tag string, body PutUsersIdTagsTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUsersIdTagsTagRequestWithBody(server, id, tag, "application/json", bodyReader)
+}
+
+// NewPutUsersIdTagsTagRequestWithBody generates requests for PutUsersIdTagsTag with any type of body
+func NewPutUsersIdTagsTagRequestWithBody(server string, id string, tag string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
 }
 
 // WithBaseURL overrides the baseURL.
@@ -815,974 +5177,7474 @@ func WithBaseURL(baseURL string) ClientOption {
 	return func(c *Client) error {
 		newBaseURL, err := url.Parse(baseURL)
 		if err != nil {
-			return err
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAuditWithResponse request
+	GetAuditWithResponse(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*GetAuditResponse, error)
+
+	// GetCollectionsWithResponse request
+	GetCollectionsWithResponse(ctx context.Context, params *GetCollectionsParams, reqEditors ...RequestEditorFn) (*GetCollectionsResponse, error)
+
+	// PostCollectionsWithBodyWithResponse request with any body
+	PostCollectionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCollectionsResponse, error)
+
+	PostCollectionsWithResponse(ctx context.Context, body PostCollectionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCollectionsResponse, error)
+
+	// DeleteCollectionsIdWithResponse request
+	DeleteCollectionsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteCollectionsIdResponse, error)
+
+	// GetCollectionsIdWithResponse request
+	GetCollectionsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetCollectionsIdResponse, error)
+
+	// PutCollectionsIdWithBodyWithResponse request with any body
+	PutCollectionsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCollectionsIdResponse, error)
+
+	PutCollectionsIdWithResponse(ctx context.Context, id string, body PutCollectionsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCollectionsIdResponse, error)
+
+	// GetCollectionsIdLinksWithResponse request
+	GetCollectionsIdLinksWithResponse(ctx context.Context, id string, params *GetCollectionsIdLinksParams, reqEditors ...RequestEditorFn) (*GetCollectionsIdLinksResponse, error)
+
+	// PostCollectionsIdLinksWithBodyWithResponse request with any body
+	PostCollectionsIdLinksWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCollectionsIdLinksResponse, error)
+
+	PostCollectionsIdLinksWithResponse(ctx context.Context, id string, body PostCollectionsIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCollectionsIdLinksResponse, error)
+
+	// PutCollectionsIdLinksWithBodyWithResponse request with any body
+	PutCollectionsIdLinksWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCollectionsIdLinksResponse, error)
+
+	PutCollectionsIdLinksWithResponse(ctx context.Context, id string, body PutCollectionsIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCollectionsIdLinksResponse, error)
+
+	// DeleteCollectionsIdLinksLinkIDWithResponse request
+	DeleteCollectionsIdLinksLinkIDWithResponse(ctx context.Context, id string, linkID string, reqEditors ...RequestEditorFn) (*DeleteCollectionsIdLinksLinkIDResponse, error)
+
+	// PostImportWithBodyWithResponse request with any body
+	PostImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostImportResponse, error)
+
+	// GetImportIdWithResponse request
+	GetImportIdWithResponse(ctx context.Context, id string, params *GetImportIdParams, reqEditors ...RequestEditorFn) (*GetImportIdResponse, error)
+
+	// GetLinksWithResponse request
+	GetLinksWithResponse(ctx context.Context, params *GetLinksParams, reqEditors ...RequestEditorFn) (*GetLinksResponse, error)
+
+	// PostLinksWithBodyWithResponse request with any body
+	PostLinksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksResponse, error)
+
+	PostLinksWithResponse(ctx context.Context, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksResponse, error)
+
+	// GetLinksArchiveWithResponse request
+	GetLinksArchiveWithResponse(ctx context.Context, params *GetLinksArchiveParams, reqEditors ...RequestEditorFn) (*GetLinksArchiveResponse, error)
+
+	// GetLinksExportWithResponse request
+	GetLinksExportWithResponse(ctx context.Context, params *GetLinksExportParams, reqEditors ...RequestEditorFn) (*GetLinksExportResponse, error)
+
+	// GetLinksFavoritesWithResponse request
+	GetLinksFavoritesWithResponse(ctx context.Context, params *GetLinksFavoritesParams, reqEditors ...RequestEditorFn) (*GetLinksFavoritesResponse, error)
+
+	// PostLinksStateWithBodyWithResponse request with any body
+	PostLinksStateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksStateResponse, error)
+
+	PostLinksStateWithResponse(ctx context.Context, body PostLinksStateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksStateResponse, error)
+
+	// GetLinksUnreadWithResponse request
+	GetLinksUnreadWithResponse(ctx context.Context, params *GetLinksUnreadParams, reqEditors ...RequestEditorFn) (*GetLinksUnreadResponse, error)
+
+	// GetLinksUserUserIDWithResponse request
+	GetLinksUserUserIDWithResponse(ctx context.Context, userID string, params *GetLinksUserUserIDParams, reqEditors ...RequestEditorFn) (*GetLinksUserUserIDResponse, error)
+
+	// DeleteLinksIdWithResponse request
+	DeleteLinksIdWithResponse(ctx context.Context, id string, params *DeleteLinksIdParams, reqEditors ...RequestEditorFn) (*DeleteLinksIdResponse, error)
+
+	// GetLinksIdWithResponse request
+	GetLinksIdWithResponse(ctx context.Context, id string, params *GetLinksIdParams, reqEditors ...RequestEditorFn) (*GetLinksIdResponse, error)
+
+	// PutLinksIdWithBodyWithResponse request with any body
+	PutLinksIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error)
+
+	PutLinksIdWithResponse(ctx context.Context, id string, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error)
+
+	// GetLinksIdContentWithResponse request
+	GetLinksIdContentWithResponse(ctx context.Context, id string, params *GetLinksIdContentParams, reqEditors ...RequestEditorFn) (*GetLinksIdContentResponse, error)
+
+	// GetLinksIdHistoryWithResponse request
+	GetLinksIdHistoryWithResponse(ctx context.Context, id string, params *GetLinksIdHistoryParams, reqEditors ...RequestEditorFn) (*GetLinksIdHistoryResponse, error)
+
+	// PostLinksIdHistoryRevisionIDRevertWithResponse request
+	PostLinksIdHistoryRevisionIDRevertWithResponse(ctx context.Context, id string, revisionID string, params *PostLinksIdHistoryRevisionIDRevertParams, reqEditors ...RequestEditorFn) (*PostLinksIdHistoryRevisionIDRevertResponse, error)
+
+	// GetLinksIdNotesWithResponse request
+	GetLinksIdNotesWithResponse(ctx context.Context, id string, params *GetLinksIdNotesParams, reqEditors ...RequestEditorFn) (*GetLinksIdNotesResponse, error)
+
+	// PostLinksIdNotesWithBodyWithResponse request with any body
+	PostLinksIdNotesWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksIdNotesResponse, error)
+
+	PostLinksIdNotesWithResponse(ctx context.Context, id string, body PostLinksIdNotesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksIdNotesResponse, error)
+
+	// DeleteLinksIdNotesNoteIDWithResponse request
+	DeleteLinksIdNotesNoteIDWithResponse(ctx context.Context, id string, noteID string, params *DeleteLinksIdNotesNoteIDParams, reqEditors ...RequestEditorFn) (*DeleteLinksIdNotesNoteIDResponse, error)
+
+	// PutLinksIdNotesNoteIDWithBodyWithResponse request with any body
+	PutLinksIdNotesNoteIDWithBodyWithResponse(ctx context.Context, id string, noteID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdNotesNoteIDResponse, error)
+
+	PutLinksIdNotesNoteIDWithResponse(ctx context.Context, id string, noteID string, body PutLinksIdNotesNoteIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdNotesNoteIDResponse, error)
+
+	// PutLinksIdSlugWithBodyWithResponse request with any body
+	PutLinksIdSlugWithBodyWithResponse(ctx context.Context, id string, params *PutLinksIdSlugParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdSlugResponse, error)
+
+	PutLinksIdSlugWithResponse(ctx context.Context, id string, params *PutLinksIdSlugParams, body PutLinksIdSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdSlugResponse, error)
+
+	// GetLinksIdSnapshotsWithResponse request
+	GetLinksIdSnapshotsWithResponse(ctx context.Context, id string, params *GetLinksIdSnapshotsParams, reqEditors ...RequestEditorFn) (*GetLinksIdSnapshotsResponse, error)
+
+	// GetLinksIdSnapshotsShaWithResponse request
+	GetLinksIdSnapshotsShaWithResponse(ctx context.Context, id string, sha string, params *GetLinksIdSnapshotsShaParams, reqEditors ...RequestEditorFn) (*GetLinksIdSnapshotsShaResponse, error)
+
+	// GetLinksIdStatsWithResponse request
+	GetLinksIdStatsWithResponse(ctx context.Context, id string, params *GetLinksIdStatsParams, reqEditors ...RequestEditorFn) (*GetLinksIdStatsResponse, error)
+
+	// GetPublicLinksWithResponse request
+	GetPublicLinksWithResponse(ctx context.Context, params *GetPublicLinksParams, reqEditors ...RequestEditorFn) (*GetPublicLinksResponse, error)
+
+	// GetSharedTokenWithResponse request
+	GetSharedTokenWithResponse(ctx context.Context, token string, params *GetSharedTokenParams, reqEditors ...RequestEditorFn) (*GetSharedTokenResponse, error)
+
+	// GetSharesWithResponse request
+	GetSharesWithResponse(ctx context.Context, params *GetSharesParams, reqEditors ...RequestEditorFn) (*GetSharesResponse, error)
+
+	// PostSharesWithBodyWithResponse request with any body
+	PostSharesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSharesResponse, error)
+
+	PostSharesWithResponse(ctx context.Context, body PostSharesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSharesResponse, error)
+
+	// DeleteSharesIdWithResponse request
+	DeleteSharesIdWithResponse(ctx context.Context, id string, params *DeleteSharesIdParams, reqEditors ...RequestEditorFn) (*DeleteSharesIdResponse, error)
+
+	// GetTrashLinksWithResponse request
+	GetTrashLinksWithResponse(ctx context.Context, params *GetTrashLinksParams, reqEditors ...RequestEditorFn) (*GetTrashLinksResponse, error)
+
+	// PostTrashLinksIdRestoreWithResponse request
+	PostTrashLinksIdRestoreWithResponse(ctx context.Context, id string, params *PostTrashLinksIdRestoreParams, reqEditors ...RequestEditorFn) (*PostTrashLinksIdRestoreResponse, error)
+
+	// GetTrashUsersWithResponse request
+	GetTrashUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrashUsersResponse, error)
+
+	// PostTrashUsersIdRestoreWithResponse request
+	PostTrashUsersIdRestoreWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostTrashUsersIdRestoreResponse, error)
+
+	// GetUsersWithResponse request
+	GetUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersResponse, error)
+
+	// PostUsersWithBodyWithResponse request with any body
+	PostUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersResponse, error)
+
+	PostUsersWithResponse(ctx context.Context, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersResponse, error)
+
+	// DeleteUsersIdWithResponse request
+	DeleteUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteUsersIdResponse, error)
+
+	// GetUsersIdWithResponse request
+	GetUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUsersIdResponse, error)
+
+	// PutUsersIdWithBodyWithResponse request with any body
+	PutUsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error)
+
+	PutUsersIdWithResponse(ctx context.Context, id string, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error)
+
+	// GetUsersIdCleanupWithResponse request
+	GetUsersIdCleanupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUsersIdCleanupResponse, error)
+
+	// GetUsersIdExportWithResponse request
+	GetUsersIdExportWithResponse(ctx context.Context, id string, params *GetUsersIdExportParams, reqEditors ...RequestEditorFn) (*GetUsersIdExportResponse, error)
+
+	// PostUsersIdExportWithResponse request
+	PostUsersIdExportWithResponse(ctx context.Context, id string, params *PostUsersIdExportParams, reqEditors ...RequestEditorFn) (*PostUsersIdExportResponse, error)
+
+	// GetUsersIdLinkSettingsWithResponse request
+	GetUsersIdLinkSettingsWithResponse(ctx context.Context, id string, params *GetUsersIdLinkSettingsParams, reqEditors ...RequestEditorFn) (*GetUsersIdLinkSettingsResponse, error)
+
+	// PutUsersIdLinkSettingsWithBodyWithResponse request with any body
+	PutUsersIdLinkSettingsWithBodyWithResponse(ctx context.Context, id string, params *PutUsersIdLinkSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdLinkSettingsResponse, error)
+
+	PutUsersIdLinkSettingsWithResponse(ctx context.Context, id string, params *PutUsersIdLinkSettingsParams, body PutUsersIdLinkSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdLinkSettingsResponse, error)
+
+	// GetUsersIdProfileWithResponse request
+	GetUsersIdProfileWithResponse(ctx context.Context, id string, params *GetUsersIdProfileParams, reqEditors ...RequestEditorFn) (*GetUsersIdProfileResponse, error)
+
+	// GetUsersIdTagsWithResponse request
+	GetUsersIdTagsWithResponse(ctx context.Context, id string, params *GetUsersIdTagsParams, reqEditors ...RequestEditorFn) (*GetUsersIdTagsResponse, error)
+
+	// PostUsersIdTagsMergeWithBodyWithResponse request with any body
+	PostUsersIdTagsMergeWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersIdTagsMergeResponse, error)
+
+	PostUsersIdTagsMergeWithResponse(ctx context.Context, id string, body PostUsersIdTagsMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersIdTagsMergeResponse, error)
+
+	// DeleteUsersIdTagsTagWithResponse request
+	DeleteUsersIdTagsTagWithResponse(ctx context.Context, id string, tag string, reqEditors ...RequestEditorFn) (*DeleteUsersIdTagsTagResponse, error)
+
+	// PutUsersIdTagsTagWithBodyWithResponse request with any body
+	PutUsersIdTagsTagWithBodyWithResponse(ctx context.Context, id string, tag string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdTagsTagResponse, error)
+
+	PutUsersIdTagsTagWithResponse(ctx context.Context, id string, tag string, body PutUsersIdTagsTagJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdTagsTagResponse, error)
+}
+
+type GetAuditResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AuditEntry
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCollectionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Collection
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCollectionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCollectionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCollectionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Collection
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCollectionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCollectionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCollectionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCollectionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCollectionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCollectionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Collection
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCollectionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCollectionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutCollectionsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Collection
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutCollectionsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutCollectionsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCollectionsIdLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Link
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCollectionsIdLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCollectionsIdLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCollectionsIdLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCollectionsIdLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCollectionsIdLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutCollectionsIdLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutCollectionsIdLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutCollectionsIdLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCollectionsIdLinksLinkIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCollectionsIdLinksLinkIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCollectionsIdLinksLinkIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ImportJob
+	JSON400      *Error
+	JSON413      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetImportIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportJob
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetImportIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetImportIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Link
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Link
+	JSON201      *Link
+	JSON400      *Error
+	JSON409      *Error
+	JSON412      *Error
+	JSON429      *Error
+	JSON500      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksArchiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Link
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksArchiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksArchiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *openapi_types.File
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksFavoritesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Link
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksFavoritesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksFavoritesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLinksStateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinksStateResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLinksStateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLinksStateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksUnreadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Link
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksUnreadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksUnreadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksUserUserIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Link
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksUserUserIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksUserUserIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteLinksIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteLinksIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteLinksIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Link
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutLinksIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON412      *Error
+	JSON429      *Error
+	JSON500      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutLinksIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutLinksIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksIdContentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkContent
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksIdContentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksIdContentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksIdHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]LinkRevision
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksIdHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksIdHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLinksIdHistoryRevisionIDRevertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Link
+	JSON400      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLinksIdHistoryRevisionIDRevertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLinksIdHistoryRevisionIDRevertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksIdNotesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Note
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksIdNotesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksIdNotesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLinksIdNotesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Note
+	JSON400      *Error
+	JSON404      *Error
+	JSON412      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLinksIdNotesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLinksIdNotesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteLinksIdNotesNoteIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteLinksIdNotesNoteIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteLinksIdNotesNoteIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutLinksIdNotesNoteIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Note
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutLinksIdNotesNoteIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutLinksIdNotesNoteIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutLinksIdSlugResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkSlug
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutLinksIdSlugResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutLinksIdSlugResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksIdSnapshotsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Snapshot
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksIdSnapshotsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksIdSnapshotsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksIdSnapshotsShaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksIdSnapshotsShaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksIdSnapshotsShaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLinksIdStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkStats
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLinksIdStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLinksIdStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPublicLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Link
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPublicLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPublicLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSharedTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SharedContent
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSharedTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSharedTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSharesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Share
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSharesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSharesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSharesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Share
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSharesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSharesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSharesIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Share
+	JSON401      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSharesIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSharesIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTrashLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Link
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrashLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrashLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTrashLinksIdRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Link
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTrashLinksIdRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTrashLinksIdRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTrashUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]User
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrashUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrashUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTrashUsersIdRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTrashUsersIdRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTrashUsersIdRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]User
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutUsersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutUsersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutUsersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersIdCleanupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserCleanup
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersIdCleanupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersIdCleanupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersIdExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserExport
+	JSON202      *UserExport
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersIdExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersIdExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersIdExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *UserExport
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersIdExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersIdExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersIdLinkSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkSettings
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersIdLinkSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersIdLinkSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutUsersIdLinkSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkSettings
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutUsersIdLinkSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutUsersIdLinkSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersIdProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserProfile
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersIdProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersIdProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersIdTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TagCount
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersIdTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersIdTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersIdTagsMergeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagsUpdateResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersIdTagsMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersIdTagsMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersIdTagsTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagsUpdateResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersIdTagsTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersIdTagsTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutUsersIdTagsTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagsUpdateResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutUsersIdTagsTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutUsersIdTagsTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAuditWithResponse request returning *GetAuditResponse
+func (c *ClientWithResponses) GetAuditWithResponse(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*GetAuditResponse, error) {
+	rsp, err := c.GetAudit(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditResponse(rsp)
+}
+
+// GetCollectionsWithResponse request returning *GetCollectionsResponse
+func (c *ClientWithResponses) GetCollectionsWithResponse(ctx context.Context, params *GetCollectionsParams, reqEditors ...RequestEditorFn) (*GetCollectionsResponse, error) {
+	rsp, err := c.GetCollections(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCollectionsResponse(rsp)
+}
+
+// PostCollectionsWithBodyWithResponse request with arbitrary body returning *PostCollectionsResponse
+func (c *ClientWithResponses) PostCollectionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCollectionsResponse, error) {
+	rsp, err := c.PostCollectionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCollectionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCollectionsWithResponse(ctx context.Context, body PostCollectionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCollectionsResponse, error) {
+	rsp, err := c.PostCollections(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCollectionsResponse(rsp)
+}
+
+// DeleteCollectionsIdWithResponse request returning *DeleteCollectionsIdResponse
+func (c *ClientWithResponses) DeleteCollectionsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteCollectionsIdResponse, error) {
+	rsp, err := c.DeleteCollectionsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCollectionsIdResponse(rsp)
+}
+
+// GetCollectionsIdWithResponse request returning *GetCollectionsIdResponse
+func (c *ClientWithResponses) GetCollectionsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetCollectionsIdResponse, error) {
+	rsp, err := c.GetCollectionsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCollectionsIdResponse(rsp)
+}
+
+// PutCollectionsIdWithBodyWithResponse request with arbitrary body returning *PutCollectionsIdResponse
+func (c *ClientWithResponses) PutCollectionsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCollectionsIdResponse, error) {
+	rsp, err := c.PutCollectionsIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutCollectionsIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutCollectionsIdWithResponse(ctx context.Context, id string, body PutCollectionsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCollectionsIdResponse, error) {
+	rsp, err := c.PutCollectionsId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutCollectionsIdResponse(rsp)
+}
+
+// GetCollectionsIdLinksWithResponse request returning *GetCollectionsIdLinksResponse
+func (c *ClientWithResponses) GetCollectionsIdLinksWithResponse(ctx context.Context, id string, params *GetCollectionsIdLinksParams, reqEditors ...RequestEditorFn) (*GetCollectionsIdLinksResponse, error) {
+	rsp, err := c.GetCollectionsIdLinks(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCollectionsIdLinksResponse(rsp)
+}
+
+// PostCollectionsIdLinksWithBodyWithResponse request with arbitrary body returning *PostCollectionsIdLinksResponse
+func (c *ClientWithResponses) PostCollectionsIdLinksWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCollectionsIdLinksResponse, error) {
+	rsp, err := c.PostCollectionsIdLinksWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCollectionsIdLinksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCollectionsIdLinksWithResponse(ctx context.Context, id string, body PostCollectionsIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCollectionsIdLinksResponse, error) {
+	rsp, err := c.PostCollectionsIdLinks(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCollectionsIdLinksResponse(rsp)
+}
+
+// PutCollectionsIdLinksWithBodyWithResponse request with arbitrary body returning *PutCollectionsIdLinksResponse
+func (c *ClientWithResponses) PutCollectionsIdLinksWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutCollectionsIdLinksResponse, error) {
+	rsp, err := c.PutCollectionsIdLinksWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutCollectionsIdLinksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutCollectionsIdLinksWithResponse(ctx context.Context, id string, body PutCollectionsIdLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PutCollectionsIdLinksResponse, error) {
+	rsp, err := c.PutCollectionsIdLinks(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutCollectionsIdLinksResponse(rsp)
+}
+
+// DeleteCollectionsIdLinksLinkIDWithResponse request returning *DeleteCollectionsIdLinksLinkIDResponse
+func (c *ClientWithResponses) DeleteCollectionsIdLinksLinkIDWithResponse(ctx context.Context, id string, linkID string, reqEditors ...RequestEditorFn) (*DeleteCollectionsIdLinksLinkIDResponse, error) {
+	rsp, err := c.DeleteCollectionsIdLinksLinkID(ctx, id, linkID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCollectionsIdLinksLinkIDResponse(rsp)
+}
+
+// PostImportWithBodyWithResponse request with arbitrary body returning *PostImportResponse
+func (c *ClientWithResponses) PostImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostImportResponse, error) {
+	rsp, err := c.PostImportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostImportResponse(rsp)
+}
+
+// GetImportIdWithResponse request returning *GetImportIdResponse
+func (c *ClientWithResponses) GetImportIdWithResponse(ctx context.Context, id string, params *GetImportIdParams, reqEditors ...RequestEditorFn) (*GetImportIdResponse, error) {
+	rsp, err := c.GetImportId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetImportIdResponse(rsp)
+}
+
+// GetLinksWithResponse request returning *GetLinksResponse
+func (c *ClientWithResponses) GetLinksWithResponse(ctx context.Context, params *GetLinksParams, reqEditors ...RequestEditorFn) (*GetLinksResponse, error) {
+	rsp, err := c.GetLinks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksResponse(rsp)
+}
+
+// PostLinksWithBodyWithResponse request with arbitrary body returning *PostLinksResponse
+func (c *ClientWithResponses) PostLinksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksResponse, error) {
+	rsp, err := c.PostLinksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLinksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLinksWithResponse(ctx context.Context, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksResponse, error) {
+	rsp, err := c.PostLinks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLinksResponse(rsp)
+}
+
+// GetLinksArchiveWithResponse request returning *GetLinksArchiveResponse
+func (c *ClientWithResponses) GetLinksArchiveWithResponse(ctx context.Context, params *GetLinksArchiveParams, reqEditors ...RequestEditorFn) (*GetLinksArchiveResponse, error) {
+	rsp, err := c.GetLinksArchive(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksArchiveResponse(rsp)
+}
+
+// GetLinksExportWithResponse request returning *GetLinksExportResponse
+func (c *ClientWithResponses) GetLinksExportWithResponse(ctx context.Context, params *GetLinksExportParams, reqEditors ...RequestEditorFn) (*GetLinksExportResponse, error) {
+	rsp, err := c.GetLinksExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksExportResponse(rsp)
+}
+
+// GetLinksFavoritesWithResponse request returning *GetLinksFavoritesResponse
+func (c *ClientWithResponses) GetLinksFavoritesWithResponse(ctx context.Context, params *GetLinksFavoritesParams, reqEditors ...RequestEditorFn) (*GetLinksFavoritesResponse, error) {
+	rsp, err := c.GetLinksFavorites(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksFavoritesResponse(rsp)
+}
+
+// PostLinksStateWithBodyWithResponse request with arbitrary body returning *PostLinksStateResponse
+func (c *ClientWithResponses) PostLinksStateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksStateResponse, error) {
+	rsp, err := c.PostLinksStateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLinksStateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLinksStateWithResponse(ctx context.Context, body PostLinksStateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksStateResponse, error) {
+	rsp, err := c.PostLinksState(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLinksStateResponse(rsp)
+}
+
+// GetLinksUnreadWithResponse request returning *GetLinksUnreadResponse
+func (c *ClientWithResponses) GetLinksUnreadWithResponse(ctx context.Context, params *GetLinksUnreadParams, reqEditors ...RequestEditorFn) (*GetLinksUnreadResponse, error) {
+	rsp, err := c.GetLinksUnread(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksUnreadResponse(rsp)
+}
+
+// GetLinksUserUserIDWithResponse request returning *GetLinksUserUserIDResponse
+func (c *ClientWithResponses) GetLinksUserUserIDWithResponse(ctx context.Context, userID string, params *GetLinksUserUserIDParams, reqEditors ...RequestEditorFn) (*GetLinksUserUserIDResponse, error) {
+	rsp, err := c.GetLinksUserUserID(ctx, userID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksUserUserIDResponse(rsp)
+}
+
+// DeleteLinksIdWithResponse request returning *DeleteLinksIdResponse
+func (c *ClientWithResponses) DeleteLinksIdWithResponse(ctx context.Context, id string, params *DeleteLinksIdParams, reqEditors ...RequestEditorFn) (*DeleteLinksIdResponse, error) {
+	rsp, err := c.DeleteLinksId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteLinksIdResponse(rsp)
+}
+
+// GetLinksIdWithResponse request returning *GetLinksIdResponse
+func (c *ClientWithResponses) GetLinksIdWithResponse(ctx context.Context, id string, params *GetLinksIdParams, reqEditors ...RequestEditorFn) (*GetLinksIdResponse, error) {
+	rsp, err := c.GetLinksId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksIdResponse(rsp)
+}
+
+// PutLinksIdWithBodyWithResponse request with arbitrary body returning *PutLinksIdResponse
+func (c *ClientWithResponses) PutLinksIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error) {
+	rsp, err := c.PutLinksIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutLinksIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutLinksIdWithResponse(ctx context.Context, id string, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error) {
+	rsp, err := c.PutLinksId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutLinksIdResponse(rsp)
+}
+
+// GetLinksIdContentWithResponse request returning *GetLinksIdContentResponse
+func (c *ClientWithResponses) GetLinksIdContentWithResponse(ctx context.Context, id string, params *GetLinksIdContentParams, reqEditors ...RequestEditorFn) (*GetLinksIdContentResponse, error) {
+	rsp, err := c.GetLinksIdContent(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksIdContentResponse(rsp)
+}
+
+// GetLinksIdHistoryWithResponse request returning *GetLinksIdHistoryResponse
+func (c *ClientWithResponses) GetLinksIdHistoryWithResponse(ctx context.Context, id string, params *GetLinksIdHistoryParams, reqEditors ...RequestEditorFn) (*GetLinksIdHistoryResponse, error) {
+	rsp, err := c.GetLinksIdHistory(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksIdHistoryResponse(rsp)
+}
+
+// PostLinksIdHistoryRevisionIDRevertWithResponse request returning *PostLinksIdHistoryRevisionIDRevertResponse
+func (c *ClientWithResponses) PostLinksIdHistoryRevisionIDRevertWithResponse(ctx context.Context, id string, revisionID string, params *PostLinksIdHistoryRevisionIDRevertParams, reqEditors ...RequestEditorFn) (*PostLinksIdHistoryRevisionIDRevertResponse, error) {
+	rsp, err := c.PostLinksIdHistoryRevisionIDRevert(ctx, id, revisionID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLinksIdHistoryRevisionIDRevertResponse(rsp)
+}
+
+// GetLinksIdNotesWithResponse request returning *GetLinksIdNotesResponse
+func (c *ClientWithResponses) GetLinksIdNotesWithResponse(ctx context.Context, id string, params *GetLinksIdNotesParams, reqEditors ...RequestEditorFn) (*GetLinksIdNotesResponse, error) {
+	rsp, err := c.GetLinksIdNotes(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksIdNotesResponse(rsp)
+}
+
+// PostLinksIdNotesWithBodyWithResponse request with arbitrary body returning *PostLinksIdNotesResponse
+func (c *ClientWithResponses) PostLinksIdNotesWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksIdNotesResponse, error) {
+	rsp, err := c.PostLinksIdNotesWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLinksIdNotesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLinksIdNotesWithResponse(ctx context.Context, id string, body PostLinksIdNotesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksIdNotesResponse, error) {
+	rsp, err := c.PostLinksIdNotes(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLinksIdNotesResponse(rsp)
+}
+
+// DeleteLinksIdNotesNoteIDWithResponse request returning *DeleteLinksIdNotesNoteIDResponse
+func (c *ClientWithResponses) DeleteLinksIdNotesNoteIDWithResponse(ctx context.Context, id string, noteID string, params *DeleteLinksIdNotesNoteIDParams, reqEditors ...RequestEditorFn) (*DeleteLinksIdNotesNoteIDResponse, error) {
+	rsp, err := c.DeleteLinksIdNotesNoteID(ctx, id, noteID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteLinksIdNotesNoteIDResponse(rsp)
+}
+
+// PutLinksIdNotesNoteIDWithBodyWithResponse request with arbitrary body returning *PutLinksIdNotesNoteIDResponse
+func (c *ClientWithResponses) PutLinksIdNotesNoteIDWithBodyWithResponse(ctx context.Context, id string, noteID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdNotesNoteIDResponse, error) {
+	rsp, err := c.PutLinksIdNotesNoteIDWithBody(ctx, id, noteID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutLinksIdNotesNoteIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutLinksIdNotesNoteIDWithResponse(ctx context.Context, id string, noteID string, body PutLinksIdNotesNoteIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdNotesNoteIDResponse, error) {
+	rsp, err := c.PutLinksIdNotesNoteID(ctx, id, noteID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutLinksIdNotesNoteIDResponse(rsp)
+}
+
+// PutLinksIdSlugWithBodyWithResponse request with arbitrary body returning *PutLinksIdSlugResponse
+func (c *ClientWithResponses) PutLinksIdSlugWithBodyWithResponse(ctx context.Context, id string, params *PutLinksIdSlugParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdSlugResponse, error) {
+	rsp, err := c.PutLinksIdSlugWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutLinksIdSlugResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutLinksIdSlugWithResponse(ctx context.Context, id string, params *PutLinksIdSlugParams, body PutLinksIdSlugJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdSlugResponse, error) {
+	rsp, err := c.PutLinksIdSlug(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutLinksIdSlugResponse(rsp)
+}
+
+// GetLinksIdSnapshotsWithResponse request returning *GetLinksIdSnapshotsResponse
+func (c *ClientWithResponses) GetLinksIdSnapshotsWithResponse(ctx context.Context, id string, params *GetLinksIdSnapshotsParams, reqEditors ...RequestEditorFn) (*GetLinksIdSnapshotsResponse, error) {
+	rsp, err := c.GetLinksIdSnapshots(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksIdSnapshotsResponse(rsp)
+}
+
+// GetLinksIdSnapshotsShaWithResponse request returning *GetLinksIdSnapshotsShaResponse
+func (c *ClientWithResponses) GetLinksIdSnapshotsShaWithResponse(ctx context.Context, id string, sha string, params *GetLinksIdSnapshotsShaParams, reqEditors ...RequestEditorFn) (*GetLinksIdSnapshotsShaResponse, error) {
+	rsp, err := c.GetLinksIdSnapshotsSha(ctx, id, sha, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksIdSnapshotsShaResponse(rsp)
+}
+
+// GetLinksIdStatsWithResponse request returning *GetLinksIdStatsResponse
+func (c *ClientWithResponses) GetLinksIdStatsWithResponse(ctx context.Context, id string, params *GetLinksIdStatsParams, reqEditors ...RequestEditorFn) (*GetLinksIdStatsResponse, error) {
+	rsp, err := c.GetLinksIdStats(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLinksIdStatsResponse(rsp)
+}
+
+// GetPublicLinksWithResponse request returning *GetPublicLinksResponse
+func (c *ClientWithResponses) GetPublicLinksWithResponse(ctx context.Context, params *GetPublicLinksParams, reqEditors ...RequestEditorFn) (*GetPublicLinksResponse, error) {
+	rsp, err := c.GetPublicLinks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPublicLinksResponse(rsp)
+}
+
+// GetSharedTokenWithResponse request returning *GetSharedTokenResponse
+func (c *ClientWithResponses) GetSharedTokenWithResponse(ctx context.Context, token string, params *GetSharedTokenParams, reqEditors ...RequestEditorFn) (*GetSharedTokenResponse, error) {
+	rsp, err := c.GetSharedToken(ctx, token, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSharedTokenResponse(rsp)
+}
+
+// GetSharesWithResponse request returning *GetSharesResponse
+func (c *ClientWithResponses) GetSharesWithResponse(ctx context.Context, params *GetSharesParams, reqEditors ...RequestEditorFn) (*GetSharesResponse, error) {
+	rsp, err := c.GetShares(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSharesResponse(rsp)
+}
+
+// PostSharesWithBodyWithResponse request with arbitrary body returning *PostSharesResponse
+func (c *ClientWithResponses) PostSharesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSharesResponse, error) {
+	rsp, err := c.PostSharesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSharesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSharesWithResponse(ctx context.Context, body PostSharesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSharesResponse, error) {
+	rsp, err := c.PostShares(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSharesResponse(rsp)
+}
+
+// DeleteSharesIdWithResponse request returning *DeleteSharesIdResponse
+func (c *ClientWithResponses) DeleteSharesIdWithResponse(ctx context.Context, id string, params *DeleteSharesIdParams, reqEditors ...RequestEditorFn) (*DeleteSharesIdResponse, error) {
+	rsp, err := c.DeleteSharesId(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSharesIdResponse(rsp)
+}
+
+// GetTrashLinksWithResponse request returning *GetTrashLinksResponse
+func (c *ClientWithResponses) GetTrashLinksWithResponse(ctx context.Context, params *GetTrashLinksParams, reqEditors ...RequestEditorFn) (*GetTrashLinksResponse, error) {
+	rsp, err := c.GetTrashLinks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrashLinksResponse(rsp)
+}
+
+// PostTrashLinksIdRestoreWithResponse request returning *PostTrashLinksIdRestoreResponse
+func (c *ClientWithResponses) PostTrashLinksIdRestoreWithResponse(ctx context.Context, id string, params *PostTrashLinksIdRestoreParams, reqEditors ...RequestEditorFn) (*PostTrashLinksIdRestoreResponse, error) {
+	rsp, err := c.PostTrashLinksIdRestore(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTrashLinksIdRestoreResponse(rsp)
+}
+
+// GetTrashUsersWithResponse request returning *GetTrashUsersResponse
+func (c *ClientWithResponses) GetTrashUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrashUsersResponse, error) {
+	rsp, err := c.GetTrashUsers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrashUsersResponse(rsp)
+}
+
+// PostTrashUsersIdRestoreWithResponse request returning *PostTrashUsersIdRestoreResponse
+func (c *ClientWithResponses) PostTrashUsersIdRestoreWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostTrashUsersIdRestoreResponse, error) {
+	rsp, err := c.PostTrashUsersIdRestore(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTrashUsersIdRestoreResponse(rsp)
+}
+
+// GetUsersWithResponse request returning *GetUsersResponse
+func (c *ClientWithResponses) GetUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersResponse, error) {
+	rsp, err := c.GetUsers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersResponse(rsp)
+}
+
+// PostUsersWithBodyWithResponse request with arbitrary body returning *PostUsersResponse
+func (c *ClientWithResponses) PostUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersResponse, error) {
+	rsp, err := c.PostUsersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersWithResponse(ctx context.Context, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersResponse, error) {
+	rsp, err := c.PostUsers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersResponse(rsp)
+}
+
+// DeleteUsersIdWithResponse request returning *DeleteUsersIdResponse
+func (c *ClientWithResponses) DeleteUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteUsersIdResponse, error) {
+	rsp, err := c.DeleteUsersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersIdResponse(rsp)
+}
+
+// GetUsersIdWithResponse request returning *GetUsersIdResponse
+func (c *ClientWithResponses) GetUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUsersIdResponse, error) {
+	rsp, err := c.GetUsersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersIdResponse(rsp)
+}
+
+// PutUsersIdWithBodyWithResponse request with arbitrary body returning *PutUsersIdResponse
+func (c *ClientWithResponses) PutUsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error) {
+	rsp, err := c.PutUsersIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutUsersIdWithResponse(ctx context.Context, id string, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error) {
+	rsp, err := c.PutUsersId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersIdResponse(rsp)
+}
+
+// GetUsersIdCleanupWithResponse request returning *GetUsersIdCleanupResponse
+func (c *ClientWithResponses) GetUsersIdCleanupWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUsersIdCleanupResponse, error) {
+	rsp, err := c.GetUsersIdCleanup(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersIdCleanupResponse(rsp)
+}
+
+// GetUsersIdExportWithResponse request returning *GetUsersIdExportResponse
+func (c *ClientWithResponses) GetUsersIdExportWithResponse(ctx context.Context, id string, params *GetUsersIdExportParams, reqEditors ...RequestEditorFn) (*GetUsersIdExportResponse, error) {
+	rsp, err := c.GetUsersIdExport(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersIdExportResponse(rsp)
+}
+
+// PostUsersIdExportWithResponse request returning *PostUsersIdExportResponse
+func (c *ClientWithResponses) PostUsersIdExportWithResponse(ctx context.Context, id string, params *PostUsersIdExportParams, reqEditors ...RequestEditorFn) (*PostUsersIdExportResponse, error) {
+	rsp, err := c.PostUsersIdExport(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersIdExportResponse(rsp)
+}
+
+// GetUsersIdLinkSettingsWithResponse request returning *GetUsersIdLinkSettingsResponse
+func (c *ClientWithResponses) GetUsersIdLinkSettingsWithResponse(ctx context.Context, id string, params *GetUsersIdLinkSettingsParams, reqEditors ...RequestEditorFn) (*GetUsersIdLinkSettingsResponse, error) {
+	rsp, err := c.GetUsersIdLinkSettings(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersIdLinkSettingsResponse(rsp)
+}
+
+// PutUsersIdLinkSettingsWithBodyWithResponse request with arbitrary body returning *PutUsersIdLinkSettingsResponse
+func (c *ClientWithResponses) PutUsersIdLinkSettingsWithBodyWithResponse(ctx context.Context, id string, params *PutUsersIdLinkSettingsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdLinkSettingsResponse, error) {
+	rsp, err := c.PutUsersIdLinkSettingsWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersIdLinkSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutUsersIdLinkSettingsWithResponse(ctx context.Context, id string, params *PutUsersIdLinkSettingsParams, body PutUsersIdLinkSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdLinkSettingsResponse, error) {
+	rsp, err := c.PutUsersIdLinkSettings(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersIdLinkSettingsResponse(rsp)
+}
+
+// GetUsersIdProfileWithResponse request returning *GetUsersIdProfileResponse
+func (c *ClientWithResponses) GetUsersIdProfileWithResponse(ctx context.Context, id string, params *GetUsersIdProfileParams, reqEditors ...RequestEditorFn) (*GetUsersIdProfileResponse, error) {
+	rsp, err := c.GetUsersIdProfile(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersIdProfileResponse(rsp)
+}
+
+// GetUsersIdTagsWithResponse request returning *GetUsersIdTagsResponse
+func (c *ClientWithResponses) GetUsersIdTagsWithResponse(ctx context.Context, id string, params *GetUsersIdTagsParams, reqEditors ...RequestEditorFn) (*GetUsersIdTagsResponse, error) {
+	rsp, err := c.GetUsersIdTags(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersIdTagsResponse(rsp)
+}
+
+// PostUsersIdTagsMergeWithBodyWithResponse request with arbitrary body returning *PostUsersIdTagsMergeResponse
+func (c *ClientWithResponses) PostUsersIdTagsMergeWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersIdTagsMergeResponse, error) {
+	rsp, err := c.PostUsersIdTagsMergeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersIdTagsMergeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersIdTagsMergeWithResponse(ctx context.Context, id string, body PostUsersIdTagsMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersIdTagsMergeResponse, error) {
+	rsp, err := c.PostUsersIdTagsMerge(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersIdTagsMergeResponse(rsp)
+}
+
+// DeleteUsersIdTagsTagWithResponse request returning *DeleteUsersIdTagsTagResponse
+func (c *ClientWithResponses) DeleteUsersIdTagsTagWithResponse(ctx context.Context, id string, tag string, reqEditors ...RequestEditorFn) (*DeleteUsersIdTagsTagResponse, error) {
+	rsp, err := c.DeleteUsersIdTagsTag(ctx, id, tag, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersIdTagsTagResponse(rsp)
+}
+
+// PutUsersIdTagsTagWithBodyWithResponse request with arbitrary body returning *PutUsersIdTagsTagResponse
+func (c *ClientWithResponses) PutUsersIdTagsTagWithBodyWithResponse(ctx context.Context, id string, tag string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdTagsTagResponse, error) {
+	rsp, err := c.PutUsersIdTagsTagWithBody(ctx, id, tag, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersIdTagsTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutUsersIdTagsTagWithResponse(ctx context.Context, id string, tag string, body PutUsersIdTagsTagJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdTagsTagResponse, error) {
+	rsp, err := c.PutUsersIdTagsTag(ctx, id, tag, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUsersIdTagsTagResponse(rsp)
+}
+
+// ParseGetAuditResponse parses an HTTP response from a GetAuditWithResponse call
+func ParseGetAuditResponse(rsp *http.Response) (*GetAuditResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AuditEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCollectionsResponse parses an HTTP response from a GetCollectionsWithResponse call
+func ParseGetCollectionsResponse(rsp *http.Response) (*GetCollectionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCollectionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCollectionsResponse parses an HTTP response from a PostCollectionsWithResponse call
+func ParsePostCollectionsResponse(rsp *http.Response) (*PostCollectionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCollectionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCollectionsIdResponse parses an HTTP response from a DeleteCollectionsIdWithResponse call
+func ParseDeleteCollectionsIdResponse(rsp *http.Response) (*DeleteCollectionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCollectionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCollectionsIdResponse parses an HTTP response from a GetCollectionsIdWithResponse call
+func ParseGetCollectionsIdResponse(rsp *http.Response) (*GetCollectionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCollectionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutCollectionsIdResponse parses an HTTP response from a PutCollectionsIdWithResponse call
+func ParsePutCollectionsIdResponse(rsp *http.Response) (*PutCollectionsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutCollectionsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCollectionsIdLinksResponse parses an HTTP response from a GetCollectionsIdLinksWithResponse call
+func ParseGetCollectionsIdLinksResponse(rsp *http.Response) (*GetCollectionsIdLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCollectionsIdLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCollectionsIdLinksResponse parses an HTTP response from a PostCollectionsIdLinksWithResponse call
+func ParsePostCollectionsIdLinksResponse(rsp *http.Response) (*PostCollectionsIdLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCollectionsIdLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutCollectionsIdLinksResponse parses an HTTP response from a PutCollectionsIdLinksWithResponse call
+func ParsePutCollectionsIdLinksResponse(rsp *http.Response) (*PutCollectionsIdLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutCollectionsIdLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCollectionsIdLinksLinkIDResponse parses an HTTP response from a DeleteCollectionsIdLinksLinkIDWithResponse call
+func ParseDeleteCollectionsIdLinksLinkIDResponse(rsp *http.Response) (*DeleteCollectionsIdLinksLinkIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCollectionsIdLinksLinkIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostImportResponse parses an HTTP response from a PostImportWithResponse call
+func ParsePostImportResponse(rsp *http.Response) (*PostImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ImportJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetImportIdResponse parses an HTTP response from a GetImportIdWithResponse call
+func ParseGetImportIdResponse(rsp *http.Response) (*GetImportIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetImportIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksResponse parses an HTTP response from a GetLinksWithResponse call
+func ParseGetLinksResponse(rsp *http.Response) (*GetLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLinksResponse parses an HTTP response from a PostLinksWithResponse call
+func ParsePostLinksResponse(rsp *http.Response) (*PostLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksArchiveResponse parses an HTTP response from a GetLinksArchiveWithResponse call
+func ParseGetLinksArchiveResponse(rsp *http.Response) (*GetLinksArchiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksArchiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksExportResponse parses an HTTP response from a GetLinksExportWithResponse call
+func ParseGetLinksExportResponse(rsp *http.Response) (*GetLinksExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest openapi_types.File
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/markdown) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksFavoritesResponse parses an HTTP response from a GetLinksFavoritesWithResponse call
+func ParseGetLinksFavoritesResponse(rsp *http.Response) (*GetLinksFavoritesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksFavoritesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLinksStateResponse parses an HTTP response from a PostLinksStateWithResponse call
+func ParsePostLinksStateResponse(rsp *http.Response) (*PostLinksStateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLinksStateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinksStateResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksUnreadResponse parses an HTTP response from a GetLinksUnreadWithResponse call
+func ParseGetLinksUnreadResponse(rsp *http.Response) (*GetLinksUnreadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksUnreadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksUserUserIDResponse parses an HTTP response from a GetLinksUserUserIDWithResponse call
+func ParseGetLinksUserUserIDResponse(rsp *http.Response) (*GetLinksUserUserIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksUserUserIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteLinksIdResponse parses an HTTP response from a DeleteLinksIdWithResponse call
+func ParseDeleteLinksIdResponse(rsp *http.Response) (*DeleteLinksIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteLinksIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksIdResponse parses an HTTP response from a GetLinksIdWithResponse call
+func ParseGetLinksIdResponse(rsp *http.Response) (*GetLinksIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutLinksIdResponse parses an HTTP response from a PutLinksIdWithResponse call
+func ParsePutLinksIdResponse(rsp *http.Response) (*PutLinksIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutLinksIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksIdContentResponse parses an HTTP response from a GetLinksIdContentWithResponse call
+func ParseGetLinksIdContentResponse(rsp *http.Response) (*GetLinksIdContentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksIdContentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksIdHistoryResponse parses an HTTP response from a GetLinksIdHistoryWithResponse call
+func ParseGetLinksIdHistoryResponse(rsp *http.Response) (*GetLinksIdHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksIdHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []LinkRevision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLinksIdHistoryRevisionIDRevertResponse parses an HTTP response from a PostLinksIdHistoryRevisionIDRevertWithResponse call
+func ParsePostLinksIdHistoryRevisionIDRevertResponse(rsp *http.Response) (*PostLinksIdHistoryRevisionIDRevertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLinksIdHistoryRevisionIDRevertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksIdNotesResponse parses an HTTP response from a GetLinksIdNotesWithResponse call
+func ParseGetLinksIdNotesResponse(rsp *http.Response) (*GetLinksIdNotesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksIdNotesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Note
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLinksIdNotesResponse parses an HTTP response from a PostLinksIdNotesWithResponse call
+func ParsePostLinksIdNotesResponse(rsp *http.Response) (*PostLinksIdNotesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLinksIdNotesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Note
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteLinksIdNotesNoteIDResponse parses an HTTP response from a DeleteLinksIdNotesNoteIDWithResponse call
+func ParseDeleteLinksIdNotesNoteIDResponse(rsp *http.Response) (*DeleteLinksIdNotesNoteIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteLinksIdNotesNoteIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutLinksIdNotesNoteIDResponse parses an HTTP response from a PutLinksIdNotesNoteIDWithResponse call
+func ParsePutLinksIdNotesNoteIDResponse(rsp *http.Response) (*PutLinksIdNotesNoteIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutLinksIdNotesNoteIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Note
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutLinksIdSlugResponse parses an HTTP response from a PutLinksIdSlugWithResponse call
+func ParsePutLinksIdSlugResponse(rsp *http.Response) (*PutLinksIdSlugResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutLinksIdSlugResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkSlug
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksIdSnapshotsResponse parses an HTTP response from a GetLinksIdSnapshotsWithResponse call
+func ParseGetLinksIdSnapshotsResponse(rsp *http.Response) (*GetLinksIdSnapshotsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksIdSnapshotsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Snapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksIdSnapshotsShaResponse parses an HTTP response from a GetLinksIdSnapshotsShaWithResponse call
+func ParseGetLinksIdSnapshotsShaResponse(rsp *http.Response) (*GetLinksIdSnapshotsShaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksIdSnapshotsShaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLinksIdStatsResponse parses an HTTP response from a GetLinksIdStatsWithResponse call
+func ParseGetLinksIdStatsResponse(rsp *http.Response) (*GetLinksIdStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLinksIdStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPublicLinksResponse parses an HTTP response from a GetPublicLinksWithResponse call
+func ParseGetPublicLinksResponse(rsp *http.Response) (*GetPublicLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPublicLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSharedTokenResponse parses an HTTP response from a GetSharedTokenWithResponse call
+func ParseGetSharedTokenResponse(rsp *http.Response) (*GetSharedTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSharedTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SharedContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSharesResponse parses an HTTP response from a GetSharesWithResponse call
+func ParseGetSharesResponse(rsp *http.Response) (*GetSharesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSharesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Share
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSharesResponse parses an HTTP response from a PostSharesWithResponse call
+func ParsePostSharesResponse(rsp *http.Response) (*PostSharesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSharesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Share
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSharesIdResponse parses an HTTP response from a DeleteSharesIdWithResponse call
+func ParseDeleteSharesIdResponse(rsp *http.Response) (*DeleteSharesIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSharesIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Share
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTrashLinksResponse parses an HTTP response from a GetTrashLinksWithResponse call
+func ParseGetTrashLinksResponse(rsp *http.Response) (*GetTrashLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrashLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTrashLinksIdRestoreResponse parses an HTTP response from a PostTrashLinksIdRestoreWithResponse call
+func ParsePostTrashLinksIdRestoreResponse(rsp *http.Response) (*PostTrashLinksIdRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTrashLinksIdRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Link
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTrashUsersResponse parses an HTTP response from a GetTrashUsersWithResponse call
+func ParseGetTrashUsersResponse(rsp *http.Response) (*GetTrashUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrashUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTrashUsersIdRestoreResponse parses an HTTP response from a PostTrashUsersIdRestoreWithResponse call
+func ParsePostTrashUsersIdRestoreResponse(rsp *http.Response) (*PostTrashUsersIdRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTrashUsersIdRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersResponse parses an HTTP response from a GetUsersWithResponse call
+func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersResponse parses an HTTP response from a PostUsersWithResponse call
+func ParsePostUsersResponse(rsp *http.Response) (*PostUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersIdResponse parses an HTTP response from a DeleteUsersIdWithResponse call
+func ParseDeleteUsersIdResponse(rsp *http.Response) (*DeleteUsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersIdResponse parses an HTTP response from a GetUsersIdWithResponse call
+func ParseGetUsersIdResponse(rsp *http.Response) (*GetUsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutUsersIdResponse parses an HTTP response from a PutUsersIdWithResponse call
+func ParsePutUsersIdResponse(rsp *http.Response) (*PutUsersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutUsersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersIdCleanupResponse parses an HTTP response from a GetUsersIdCleanupWithResponse call
+func ParseGetUsersIdCleanupResponse(rsp *http.Response) (*GetUsersIdCleanupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersIdCleanupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserCleanup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersIdExportResponse parses an HTTP response from a GetUsersIdExportWithResponse call
+func ParseGetUsersIdExportResponse(rsp *http.Response) (*GetUsersIdExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersIdExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest UserExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/zip) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersIdExportResponse parses an HTTP response from a PostUsersIdExportWithResponse call
+func ParsePostUsersIdExportResponse(rsp *http.Response) (*PostUsersIdExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersIdExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest UserExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersIdLinkSettingsResponse parses an HTTP response from a GetUsersIdLinkSettingsWithResponse call
+func ParseGetUsersIdLinkSettingsResponse(rsp *http.Response) (*GetUsersIdLinkSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersIdLinkSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutUsersIdLinkSettingsResponse parses an HTTP response from a PutUsersIdLinkSettingsWithResponse call
+func ParsePutUsersIdLinkSettingsResponse(rsp *http.Response) (*PutUsersIdLinkSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutUsersIdLinkSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersIdProfileResponse parses an HTTP response from a GetUsersIdProfileWithResponse call
+func ParseGetUsersIdProfileResponse(rsp *http.Response) (*GetUsersIdProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersIdProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserProfile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersIdTagsResponse parses an HTTP response from a GetUsersIdTagsWithResponse call
+func ParseGetUsersIdTagsResponse(rsp *http.Response) (*GetUsersIdTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersIdTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TagCount
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersIdTagsMergeResponse parses an HTTP response from a PostUsersIdTagsMergeWithResponse call
+func ParsePostUsersIdTagsMergeResponse(rsp *http.Response) (*PostUsersIdTagsMergeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersIdTagsMergeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagsUpdateResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersIdTagsTagResponse parses an HTTP response from a DeleteUsersIdTagsTagWithResponse call
+func ParseDeleteUsersIdTagsTagResponse(rsp *http.Response) (*DeleteUsersIdTagsTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersIdTagsTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagsUpdateResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutUsersIdTagsTagResponse parses an HTTP response from a PutUsersIdTagsTagWithResponse call
+func ParsePutUsersIdTagsTagResponse(rsp *http.Response) (*PutUsersIdTagsTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutUsersIdTagsTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagsUpdateResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Журнал аудита действий с пользователями
+	// (GET /audit)
+	GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams)
+	// Получить коллекции пользователя
+	// (GET /collections)
+	GetCollections(w http.ResponseWriter, r *http.Request, params GetCollectionsParams)
+	// Создать коллекцию
+	// (POST /collections)
+	PostCollections(w http.ResponseWriter, r *http.Request)
+	// Удалить коллекцию. Ссылки коллекции не удаляются
+	// (DELETE /collections/{id})
+	DeleteCollectionsId(w http.ResponseWriter, r *http.Request, id string)
+	// Получить коллекцию по ID
+	// (GET /collections/{id})
+	GetCollectionsId(w http.ResponseWriter, r *http.Request, id string)
+	// Обновить название, описание, родителя или позицию коллекции
+	// (PUT /collections/{id})
+	PutCollectionsId(w http.ResponseWriter, r *http.Request, id string)
+	// Получить ссылки коллекции в заданном порядке
+	// (GET /collections/{id}/links)
+	GetCollectionsIdLinks(w http.ResponseWriter, r *http.Request, id string, params GetCollectionsIdLinksParams)
+	// Добавить ссылку в коллекцию
+	// (POST /collections/{id}/links)
+	PostCollectionsIdLinks(w http.ResponseWriter, r *http.Request, id string)
+	// Изменить порядок ссылок в коллекции
+	// (PUT /collections/{id}/links)
+	PutCollectionsIdLinks(w http.ResponseWriter, r *http.Request, id string)
+	// Убрать ссылку из коллекции
+	// (DELETE /collections/{id}/links/{linkID})
+	DeleteCollectionsIdLinksLinkID(w http.ResponseWriter, r *http.Request, id string, linkID string)
+	// Импортировать закладки из экспорта браузера, Pocket или Pinboard
+	// (POST /import)
+	PostImport(w http.ResponseWriter, r *http.Request)
+	// Получить прогресс импорта
+	// (GET /import/{id})
+	GetImportId(w http.ResponseWriter, r *http.Request, id string, params GetImportIdParams)
+	// Получить все объекты Link
+	// (GET /links)
+	GetLinks(w http.ResponseWriter, r *http.Request, params GetLinksParams)
+	// Создать новый объект Link
+	// (POST /links)
+	PostLinks(w http.ResponseWriter, r *http.Request)
+	// Архивные ссылки пользователя
+	// (GET /links/archive)
+	GetLinksArchive(w http.ResponseWriter, r *http.Request, params GetLinksArchiveParams)
+	// Выгрузить все ссылки пользователя
+	// (GET /links/export)
+	GetLinksExport(w http.ResponseWriter, r *http.Request, params GetLinksExportParams)
+	// Избранные ссылки пользователя
+	// (GET /links/favorites)
+	GetLinksFavorites(w http.ResponseWriter, r *http.Request, params GetLinksFavoritesParams)
+	// Изменить состояние нескольких ссылок пользователя
+	// (POST /links/state)
+	PostLinksState(w http.ResponseWriter, r *http.Request)
+	// Непрочитанные ссылки пользователя
+	// (GET /links/unread)
+	GetLinksUnread(w http.ResponseWriter, r *http.Request, params GetLinksUnreadParams)
+	// Получить ссылки, связанные с пользователем
+	// (GET /links/user/{userID})
+	GetLinksUserUserID(w http.ResponseWriter, r *http.Request, userID string, params GetLinksUserUserIDParams)
+	// Переместить объект Link в корзину
+	// (DELETE /links/{id})
+	DeleteLinksId(w http.ResponseWriter, r *http.Request, id string, params DeleteLinksIdParams)
+	// Получить объект Link по ID
+	// (GET /links/{id})
+	GetLinksId(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdParams)
+	// Обновить объект Link по ID
+	// (PUT /links/{id})
+	PutLinksId(w http.ResponseWriter, r *http.Request, id string)
+	// Получить извлеченный текст статьи по ID ссылки
+	// (GET /links/{id}/content)
+	GetLinksIdContent(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdContentParams)
+	// Получить историю правок ссылки
+	// (GET /links/{id}/history)
+	GetLinksIdHistory(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdHistoryParams)
+	// Вернуть ссылку к выбранной ревизии
+	// (POST /links/{id}/history/{revisionID}/revert)
+	PostLinksIdHistoryRevisionIDRevert(w http.ResponseWriter, r *http.Request, id string, revisionID string, params PostLinksIdHistoryRevisionIDRevertParams)
+	// Получить заметки и выделения ссылки
+	// (GET /links/{id}/notes)
+	GetLinksIdNotes(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdNotesParams)
+	// Добавить заметку или выделение к ссылке
+	// (POST /links/{id}/notes)
+	PostLinksIdNotes(w http.ResponseWriter, r *http.Request, id string)
+	// Удалить заметку
+	// (DELETE /links/{id}/notes/{noteID})
+	DeleteLinksIdNotesNoteID(w http.ResponseWriter, r *http.Request, id string, noteID string, params DeleteLinksIdNotesNoteIDParams)
+	// Изменить текст заметки
+	// (PUT /links/{id}/notes/{noteID})
+	PutLinksIdNotesNoteID(w http.ResponseWriter, r *http.Request, id string, noteID string)
+	// Назначить ссылке короткий slug для перехода через /s/{slug}
+	// (PUT /links/{id}/slug)
+	PutLinksIdSlug(w http.ResponseWriter, r *http.Request, id string, params PutLinksIdSlugParams)
+	// Получить список сохраненных снимков страницы по ID ссылки
+	// (GET /links/{id}/snapshots)
+	GetLinksIdSnapshots(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdSnapshotsParams)
+	// Получить содержимое снимка страницы
+	// (GET /links/{id}/snapshots/{sha})
+	GetLinksIdSnapshotsSha(w http.ResponseWriter, r *http.Request, id string, sha string, params GetLinksIdSnapshotsShaParams)
+	// Получить статистику переходов по короткой ссылке
+	// (GET /links/{id}/stats)
+	GetLinksIdStats(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdStatsParams)
+	// Недавно сохраненные публичные ссылки всех пользователей
+	// (GET /public/links)
+	GetPublicLinks(w http.ResponseWriter, r *http.Request, params GetPublicLinksParams)
+	// Открыть общий доступ без учетной записи
+	// (GET /shared/{token})
+	GetSharedToken(w http.ResponseWriter, r *http.Request, token string, params GetSharedTokenParams)
+	// Получить ссылки доступа пользователя
+	// (GET /shares)
+	GetShares(w http.ResponseWriter, r *http.Request, params GetSharesParams)
+	// Открыть доступ на чтение к коллекции или ссылке
+	// (POST /shares)
+	PostShares(w http.ResponseWriter, r *http.Request)
+	// Отозвать ссылку доступа
+	// (DELETE /shares/{id})
+	DeleteSharesId(w http.ResponseWriter, r *http.Request, id string, params DeleteSharesIdParams)
+	// Получить ссылки пользователя в корзине
+	// (GET /trash/links)
+	GetTrashLinks(w http.ResponseWriter, r *http.Request, params GetTrashLinksParams)
+	// Восстановить ссылку из корзины
+	// (POST /trash/links/{id}/restore)
+	PostTrashLinksIdRestore(w http.ResponseWriter, r *http.Request, id string, params PostTrashLinksIdRestoreParams)
+	// Получить удаленных пользователей
+	// (GET /trash/users)
+	GetTrashUsers(w http.ResponseWriter, r *http.Request)
+	// Восстановить удаленного пользователя
+	// (POST /trash/users/{id}/restore)
+	PostTrashUsersIdRestore(w http.ResponseWriter, r *http.Request, id string)
+	// Получить всех пользователей
+	// (GET /users)
+	GetUsers(w http.ResponseWriter, r *http.Request)
+	// Создать нового пользователя
+	// (POST /users)
+	PostUsers(w http.ResponseWriter, r *http.Request)
+	// Удалить пользователя по ID
+	// (DELETE /users/{id})
+	DeleteUsersId(w http.ResponseWriter, r *http.Request, id string)
+	// Получить пользователя по ID
+	// (GET /users/{id})
+	GetUsersId(w http.ResponseWriter, r *http.Request, id string)
+	// Обновить пользователя по ID
+	// (PUT /users/{id})
+	PutUsersId(w http.ResponseWriter, r *http.Request, id string)
+	// Получить ход очистки данных удаленного пользователя
+	// (GET /users/{id}/cleanup)
+	GetUsersIdCleanup(w http.ResponseWriter, r *http.Request, id string)
+	// Скачать выгрузку всех данных пользователя
+	// (GET /users/{id}/export)
+	GetUsersIdExport(w http.ResponseWriter, r *http.Request, id string, params GetUsersIdExportParams)
+	// Запросить выгрузку всех данных пользователя
+	// (POST /users/{id}/export)
+	PostUsersIdExport(w http.ResponseWriter, r *http.Request, id string, params PostUsersIdExportParams)
+	// Получить настройки ссылок пользователя
+	// (GET /users/{id}/link-settings)
+	GetUsersIdLinkSettings(w http.ResponseWriter, r *http.Request, id string, params GetUsersIdLinkSettingsParams)
+	// Изменить настройки ссылок пользователя
+	// (PUT /users/{id}/link-settings)
+	PutUsersIdLinkSettings(w http.ResponseWriter, r *http.Request, id string, params PutUsersIdLinkSettingsParams)
+	// Получить профиль пользователя со ссылками и тегами
+	// (GET /users/{id}/profile)
+	GetUsersIdProfile(w http.ResponseWriter, r *http.Request, id string, params GetUsersIdProfileParams)
+	// Получить теги пользователя с количеством ссылок
+	// (GET /users/{id}/tags)
+	GetUsersIdTags(w http.ResponseWriter, r *http.Request, id string, params GetUsersIdTagsParams)
+	// Объединить несколько тегов пользователя в один во всех его ссылках
+	// (POST /users/{id}/tags/merge)
+	PostUsersIdTagsMerge(w http.ResponseWriter, r *http.Request, id string)
+	// Удалить тег из всех ссылок пользователя
+	// (DELETE /users/{id}/tags/{tag})
+	DeleteUsersIdTagsTag(w http.ResponseWriter, r *http.Request, id string, tag string)
+	// Переименовать тег пользователя во всех его ссылках
+	// (PUT /users/{id}/tags/{tag})
+	PutUsersIdTagsTag(w http.ResponseWriter, r *http.Request, id string, tag string)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Журнал аудита действий с пользователями
+// (GET /audit)
+func (_ Unimplemented) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить коллекции пользователя
+// (GET /collections)
+func (_ Unimplemented) GetCollections(w http.ResponseWriter, r *http.Request, params GetCollectionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать коллекцию
+// (POST /collections)
+func (_ Unimplemented) PostCollections(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить коллекцию. Ссылки коллекции не удаляются
+// (DELETE /collections/{id})
+func (_ Unimplemented) DeleteCollectionsId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить коллекцию по ID
+// (GET /collections/{id})
+func (_ Unimplemented) GetCollectionsId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновить название, описание, родителя или позицию коллекции
+// (PUT /collections/{id})
+func (_ Unimplemented) PutCollectionsId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить ссылки коллекции в заданном порядке
+// (GET /collections/{id}/links)
+func (_ Unimplemented) GetCollectionsIdLinks(w http.ResponseWriter, r *http.Request, id string, params GetCollectionsIdLinksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить ссылку в коллекцию
+// (POST /collections/{id}/links)
+func (_ Unimplemented) PostCollectionsIdLinks(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить порядок ссылок в коллекции
+// (PUT /collections/{id}/links)
+func (_ Unimplemented) PutCollectionsIdLinks(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Убрать ссылку из коллекции
+// (DELETE /collections/{id}/links/{linkID})
+func (_ Unimplemented) DeleteCollectionsIdLinksLinkID(w http.ResponseWriter, r *http.Request, id string, linkID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Импортировать закладки из экспорта браузера, Pocket или Pinboard
+// (POST /import)
+func (_ Unimplemented) PostImport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить прогресс импорта
+// (GET /import/{id})
+func (_ Unimplemented) GetImportId(w http.ResponseWriter, r *http.Request, id string, params GetImportIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить все объекты Link
+// (GET /links)
+func (_ Unimplemented) GetLinks(w http.ResponseWriter, r *http.Request, params GetLinksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать новый объект Link
+// (POST /links)
+func (_ Unimplemented) PostLinks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Архивные ссылки пользователя
+// (GET /links/archive)
+func (_ Unimplemented) GetLinksArchive(w http.ResponseWriter, r *http.Request, params GetLinksArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузить все ссылки пользователя
+// (GET /links/export)
+func (_ Unimplemented) GetLinksExport(w http.ResponseWriter, r *http.Request, params GetLinksExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Избранные ссылки пользователя
+// (GET /links/favorites)
+func (_ Unimplemented) GetLinksFavorites(w http.ResponseWriter, r *http.Request, params GetLinksFavoritesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить состояние нескольких ссылок пользователя
+// (POST /links/state)
+func (_ Unimplemented) PostLinksState(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Непрочитанные ссылки пользователя
+// (GET /links/unread)
+func (_ Unimplemented) GetLinksUnread(w http.ResponseWriter, r *http.Request, params GetLinksUnreadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить ссылки, связанные с пользователем
+// (GET /links/user/{userID})
+func (_ Unimplemented) GetLinksUserUserID(w http.ResponseWriter, r *http.Request, userID string, params GetLinksUserUserIDParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переместить объект Link в корзину
+// (DELETE /links/{id})
+func (_ Unimplemented) DeleteLinksId(w http.ResponseWriter, r *http.Request, id string, params DeleteLinksIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить объект Link по ID
+// (GET /links/{id})
+func (_ Unimplemented) GetLinksId(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновить объект Link по ID
+// (PUT /links/{id})
+func (_ Unimplemented) PutLinksId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить извлеченный текст статьи по ID ссылки
+// (GET /links/{id}/content)
+func (_ Unimplemented) GetLinksIdContent(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdContentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить историю правок ссылки
+// (GET /links/{id}/history)
+func (_ Unimplemented) GetLinksIdHistory(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вернуть ссылку к выбранной ревизии
+// (POST /links/{id}/history/{revisionID}/revert)
+func (_ Unimplemented) PostLinksIdHistoryRevisionIDRevert(w http.ResponseWriter, r *http.Request, id string, revisionID string, params PostLinksIdHistoryRevisionIDRevertParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить заметки и выделения ссылки
+// (GET /links/{id}/notes)
+func (_ Unimplemented) GetLinksIdNotes(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdNotesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить заметку или выделение к ссылке
+// (POST /links/{id}/notes)
+func (_ Unimplemented) PostLinksIdNotes(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить заметку
+// (DELETE /links/{id}/notes/{noteID})
+func (_ Unimplemented) DeleteLinksIdNotesNoteID(w http.ResponseWriter, r *http.Request, id string, noteID string, params DeleteLinksIdNotesNoteIDParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить текст заметки
+// (PUT /links/{id}/notes/{noteID})
+func (_ Unimplemented) PutLinksIdNotesNoteID(w http.ResponseWriter, r *http.Request, id string, noteID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Назначить ссылке короткий slug для перехода через /s/{slug}
+// (PUT /links/{id}/slug)
+func (_ Unimplemented) PutLinksIdSlug(w http.ResponseWriter, r *http.Request, id string, params PutLinksIdSlugParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список сохраненных снимков страницы по ID ссылки
+// (GET /links/{id}/snapshots)
+func (_ Unimplemented) GetLinksIdSnapshots(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdSnapshotsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить содержимое снимка страницы
+// (GET /links/{id}/snapshots/{sha})
+func (_ Unimplemented) GetLinksIdSnapshotsSha(w http.ResponseWriter, r *http.Request, id string, sha string, params GetLinksIdSnapshotsShaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить статистику переходов по короткой ссылке
+// (GET /links/{id}/stats)
+func (_ Unimplemented) GetLinksIdStats(w http.ResponseWriter, r *http.Request, id string, params GetLinksIdStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Недавно сохраненные публичные ссылки всех пользователей
+// (GET /public/links)
+func (_ Unimplemented) GetPublicLinks(w http.ResponseWriter, r *http.Request, params GetPublicLinksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Открыть общий доступ без учетной записи
+// (GET /shared/{token})
+func (_ Unimplemented) GetSharedToken(w http.ResponseWriter, r *http.Request, token string, params GetSharedTokenParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить ссылки доступа пользователя
+// (GET /shares)
+func (_ Unimplemented) GetShares(w http.ResponseWriter, r *http.Request, params GetSharesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Открыть доступ на чтение к коллекции или ссылке
+// (POST /shares)
+func (_ Unimplemented) PostShares(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отозвать ссылку доступа
+// (DELETE /shares/{id})
+func (_ Unimplemented) DeleteSharesId(w http.ResponseWriter, r *http.Request, id string, params DeleteSharesIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить ссылки пользователя в корзине
+// (GET /trash/links)
+func (_ Unimplemented) GetTrashLinks(w http.ResponseWriter, r *http.Request, params GetTrashLinksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановить ссылку из корзины
+// (POST /trash/links/{id}/restore)
+func (_ Unimplemented) PostTrashLinksIdRestore(w http.ResponseWriter, r *http.Request, id string, params PostTrashLinksIdRestoreParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить удаленных пользователей
+// (GET /trash/users)
+func (_ Unimplemented) GetTrashUsers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановить удаленного пользователя
+// (POST /trash/users/{id}/restore)
+func (_ Unimplemented) PostTrashUsersIdRestore(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить всех пользователей
+// (GET /users)
+func (_ Unimplemented) GetUsers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать нового пользователя
+// (POST /users)
+func (_ Unimplemented) PostUsers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить пользователя по ID
+// (DELETE /users/{id})
+func (_ Unimplemented) DeleteUsersId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить пользователя по ID
+// (GET /users/{id})
+func (_ Unimplemented) GetUsersId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновить пользователя по ID
+// (PUT /users/{id})
+func (_ Unimplemented) PutUsersId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить ход очистки данных удаленного пользователя
+// (GET /users/{id}/cleanup)
+func (_ Unimplemented) GetUsersIdCleanup(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Скачать выгрузку всех данных пользователя
+// (GET /users/{id}/export)
+func (_ Unimplemented) GetUsersIdExport(w http.ResponseWriter, r *http.Request, id string, params GetUsersIdExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Запросить выгрузку всех данных пользователя
+// (POST /users/{id}/export)
+func (_ Unimplemented) PostUsersIdExport(w http.ResponseWriter, r *http.Request, id string, params PostUsersIdExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить настройки ссылок пользователя
+// (GET /users/{id}/link-settings)
+func (_ Unimplemented) GetUsersIdLinkSettings(w http.ResponseWriter, r *http.Request, id string, params GetUsersIdLinkSettingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить настройки ссылок пользователя
+// (PUT /users/{id}/link-settings)
+func (_ Unimplemented) PutUsersIdLinkSettings(w http.ResponseWriter, r *http.Request, id string, params PutUsersIdLinkSettingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить профиль пользователя со ссылками и тегами
+// (GET /users/{id}/profile)
+func (_ Unimplemented) GetUsersIdProfile(w http.ResponseWriter, r *http.Request, id string, params GetUsersIdProfileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить теги пользователя с количеством ссылок
+// (GET /users/{id}/tags)
+func (_ Unimplemented) GetUsersIdTags(w http.ResponseWriter, r *http.Request, id string, params GetUsersIdTagsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Объединить несколько тегов пользователя в один во всех его ссылках
+// (POST /users/{id}/tags/merge)
+func (_ Unimplemented) PostUsersIdTagsMerge(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить тег из всех ссылок пользователя
+// (DELETE /users/{id}/tags/{tag})
+func (_ Unimplemented) DeleteUsersIdTagsTag(w http.ResponseWriter, r *http.Request, id string, tag string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать тег пользователя во всех его ссылках
+// (PUT /users/{id}/tags/{tag})
+func (_ Unimplemented) PutUsersIdTagsTag(w http.ResponseWriter, r *http.Request, id string, tag string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAudit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-ID is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-ID", Err: err})
+		return
+	}
+
+	// ------------- Required header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Admin-Token", runtime.ParamLocationHeader, valueList[0], &XAdminToken)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = XAdminToken
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Admin-Token is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Admin-Token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCollections operation middleware
+func (siw *ServerInterfaceWrapper) GetCollections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCollectionsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCollections(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostCollections operation middleware
+func (siw *ServerInterfaceWrapper) PostCollections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCollections(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteCollectionsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCollectionsId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCollectionsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCollectionsId operation middleware
+func (siw *ServerInterfaceWrapper) GetCollectionsId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCollectionsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutCollectionsId operation middleware
+func (siw *ServerInterfaceWrapper) PutCollectionsId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutCollectionsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCollectionsIdLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetCollectionsIdLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCollectionsIdLinksParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = &XUserID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCollectionsIdLinks(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostCollectionsIdLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostCollectionsIdLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCollectionsIdLinks(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutCollectionsIdLinks operation middleware
+func (siw *ServerInterfaceWrapper) PutCollectionsIdLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutCollectionsIdLinks(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteCollectionsIdLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCollectionsIdLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "linkID" -------------
+	var linkID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "linkID", runtime.ParamLocationPath, chi.URLParam(r, "linkID"), &linkID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "linkID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCollectionsIdLinksLinkID(w, r, id, linkID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostImport operation middleware
+func (siw *ServerInterfaceWrapper) PostImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostImport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetImportId operation middleware
+func (siw *ServerInterfaceWrapper) GetImportId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetImportIdParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
 		}
-		c.Server = newBaseURL.String()
-		return nil
+
+		params.XUserID = XUserID
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-ID is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-ID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetImportId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags_mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags_mode", r.URL.Query(), &params.TagsMode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags_mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_tags" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude_tags", r.URL.Query(), &params.ExcludeTags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = &XUserID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLinks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLinksArchive operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksArchiveParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = &XUserID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksArchive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLinksExport operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksExportParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-ID is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-ID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLinksFavorites operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksFavorites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksFavoritesParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = &XUserID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksFavorites(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetLinksWithResponse request
-	GetLinksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLinksResponse, error)
+// PostLinksState operation middleware
+func (siw *ServerInterfaceWrapper) PostLinksState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// PostLinksWithBodyWithResponse request with any body
-	PostLinksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksResponse, error)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLinksState(w, r)
+	}))
 
-	PostLinksWithResponse(ctx context.Context, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksResponse, error)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// GetLinksUserUserIDWithResponse request
-	GetLinksUserUserIDWithResponse(ctx context.Context, userID string, reqEditors ...RequestEditorFn) (*GetLinksUserUserIDResponse, error)
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	// DeleteLinksIdWithResponse request
-	DeleteLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteLinksIdResponse, error)
+// GetLinksUnread operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksUnread(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// GetLinksIdWithResponse request
-	GetLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetLinksIdResponse, error)
+	var err error
 
-	// PutLinksIdWithBodyWithResponse request with any body
-	PutLinksIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksUnreadParams
 
-	PutLinksIdWithResponse(ctx context.Context, id string, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error)
+	// ------------- Required query parameter "user_id" -------------
 
-	// GetUsersWithResponse request
-	GetUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersResponse, error)
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
 
-	// PostUsersWithBodyWithResponse request with any body
-	PostUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersResponse, error)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
 
-	PostUsersWithResponse(ctx context.Context, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersResponse, error)
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
 
-	// DeleteUsersIdWithResponse request
-	DeleteUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteUsersIdResponse, error)
+	// ------------- Optional query parameter "limit" -------------
 
-	// GetUsersIdWithResponse request
-	GetUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUsersIdResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-	// PutUsersIdWithBodyWithResponse request with any body
-	PutUsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error)
+	// ------------- Optional query parameter "offset" -------------
 
-	PutUsersIdWithResponse(ctx context.Context, id string, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error)
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-type GetLinksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Link
-	JSON400      *Error
-	JSON500      *Error
-}
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = &XUserID
 
-// Status returns HTTPResponse.Status
-func (r GetLinksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLinksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksUnread(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
-}
 
-type PostLinksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON500      *Error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Status returns HTTPResponse.Status
-func (r PostLinksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetLinksUserUserID operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksUserUserID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userID" -------------
+	var userID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userID", runtime.ParamLocationPath, chi.URLParam(r, "userID"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostLinksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksUserUserIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = &XUserID
+
 	}
-	return 0
-}
 
-type GetLinksUserUserIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Link
-	JSON400      *Error
-	JSON404      *Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksUserUserID(w, r, userID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Status returns HTTPResponse.Status
-func (r GetLinksUserUserIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteLinksId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLinksId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
-	return http.StatusText(0)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteLinksIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = &XUserID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLinksId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLinksUserUserIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetLinksId operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
-	return 0
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = &XUserID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type DeleteLinksIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
+// PutLinksId operation middleware
+func (siw *ServerInterfaceWrapper) PutLinksId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutLinksId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteLinksIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetLinksIdContent operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksIdContent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksIdContentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = &XUserID
+
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteLinksIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksIdContent(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
-}
 
-type GetLinksIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Link
-	JSON404      *Error
-	JSON500      *Error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Status returns HTTPResponse.Status
-func (r GetLinksIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// GetLinksIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksIdHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLinksIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
-	return 0
-}
 
-type PutLinksIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksIdHistoryParams
 
-// Status returns HTTPResponse.Status
-func (r PutLinksIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutLinksIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
-	return 0
-}
 
-type GetUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]User
-	JSON400      *Error
-	JSON500      *Error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksIdHistory(w, r, id, params)
+	}))
 
-// Status returns HTTPResponse.Status
-func (r GetUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PostUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON500      *Error
-}
+// PostLinksIdHistoryRevisionIDRevert operation middleware
+func (siw *ServerInterfaceWrapper) PostLinksIdHistoryRevisionIDRevert(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// Status returns HTTPResponse.Status
-func (r PostUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "revisionID" -------------
+	var revisionID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "revisionID", runtime.ParamLocationPath, chi.URLParam(r, "revisionID"), &revisionID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revisionID", Err: err})
+		return
 	}
-	return 0
-}
 
-type DeleteUsersIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *Error
-	JSON500      *Error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostLinksIdHistoryRevisionIDRevertParams
 
-// Status returns HTTPResponse.Status
-func (r DeleteUsersIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUsersIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
-	return 0
-}
 
-type GetUsersIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON404      *Error
-	JSON500      *Error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLinksIdHistoryRevisionIDRevert(w, r, id, revisionID, params)
+	}))
 
-// Status returns HTTPResponse.Status
-func (r GetUsersIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type PutUsersIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+// GetLinksIdNotes operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksIdNotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// Status returns HTTPResponse.Status
-func (r PutUsersIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutUsersIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksIdNotesParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
 	}
-	return 0
-}
 
-// GetLinksWithResponse request returning *GetLinksResponse
-func (c *ClientWithResponses) GetLinksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLinksResponse, error) {
-	rsp, err := c.GetLinks(ctx, reqEditors...)
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
-	return ParseGetLinksResponse(rsp)
-}
 
-// PostLinksWithBodyWithResponse request with arbitrary body returning *PostLinksResponse
-func (c *ClientWithResponses) PostLinksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLinksResponse, error) {
-	rsp, err := c.PostLinksWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksIdNotes(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParsePostLinksResponse(rsp)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (c *ClientWithResponses) PostLinksWithResponse(ctx context.Context, body PostLinksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLinksResponse, error) {
-	rsp, err := c.PostLinks(ctx, body, reqEditors...)
+// PostLinksIdNotes operation middleware
+func (siw *ServerInterfaceWrapper) PostLinksIdNotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
-	return ParsePostLinksResponse(rsp)
-}
 
-// GetLinksUserUserIDWithResponse request returning *GetLinksUserUserIDResponse
-func (c *ClientWithResponses) GetLinksUserUserIDWithResponse(ctx context.Context, userID string, reqEditors ...RequestEditorFn) (*GetLinksUserUserIDResponse, error) {
-	rsp, err := c.GetLinksUserUserID(ctx, userID, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLinksIdNotes(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseGetLinksUserUserIDResponse(rsp)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteLinksIdWithResponse request returning *DeleteLinksIdResponse
-func (c *ClientWithResponses) DeleteLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteLinksIdResponse, error) {
-	rsp, err := c.DeleteLinksId(ctx, id, reqEditors...)
+// DeleteLinksIdNotesNoteID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLinksIdNotesNoteID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
-	return ParseDeleteLinksIdResponse(rsp)
-}
 
-// GetLinksIdWithResponse request returning *GetLinksIdResponse
-func (c *ClientWithResponses) GetLinksIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetLinksIdResponse, error) {
-	rsp, err := c.GetLinksId(ctx, id, reqEditors...)
+	// ------------- Path parameter "noteID" -------------
+	var noteID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "noteID", runtime.ParamLocationPath, chi.URLParam(r, "noteID"), &noteID)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "noteID", Err: err})
+		return
 	}
-	return ParseGetLinksIdResponse(rsp)
-}
 
-// PutLinksIdWithBodyWithResponse request with arbitrary body returning *PutLinksIdResponse
-func (c *ClientWithResponses) PutLinksIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error) {
-	rsp, err := c.PutLinksIdWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteLinksIdNotesNoteIDParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
 	}
-	return ParsePutLinksIdResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutLinksIdWithResponse(ctx context.Context, id string, body PutLinksIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutLinksIdResponse, error) {
-	rsp, err := c.PutLinksId(ctx, id, body, reqEditors...)
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
-	return ParsePutLinksIdResponse(rsp)
-}
 
-// GetUsersWithResponse request returning *GetUsersResponse
-func (c *ClientWithResponses) GetUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersResponse, error) {
-	rsp, err := c.GetUsers(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLinksIdNotesNoteID(w, r, id, noteID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseGetUsersResponse(rsp)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostUsersWithBodyWithResponse request with arbitrary body returning *PostUsersResponse
-func (c *ClientWithResponses) PostUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersResponse, error) {
-	rsp, err := c.PostUsersWithBody(ctx, contentType, body, reqEditors...)
+// PutLinksIdNotesNoteID operation middleware
+func (siw *ServerInterfaceWrapper) PutLinksIdNotesNoteID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
-	return ParsePostUsersResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostUsersWithResponse(ctx context.Context, body PostUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersResponse, error) {
-	rsp, err := c.PostUsers(ctx, body, reqEditors...)
+	// ------------- Path parameter "noteID" -------------
+	var noteID string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "noteID", runtime.ParamLocationPath, chi.URLParam(r, "noteID"), &noteID)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "noteID", Err: err})
+		return
 	}
-	return ParsePostUsersResponse(rsp)
-}
 
-// DeleteUsersIdWithResponse request returning *DeleteUsersIdResponse
-func (c *ClientWithResponses) DeleteUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteUsersIdResponse, error) {
-	rsp, err := c.DeleteUsersId(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutLinksIdNotesNoteID(w, r, id, noteID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseDeleteUsersIdResponse(rsp)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetUsersIdWithResponse request returning *GetUsersIdResponse
-func (c *ClientWithResponses) GetUsersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUsersIdResponse, error) {
-	rsp, err := c.GetUsersId(ctx, id, reqEditors...)
+// PutLinksIdSlug operation middleware
+func (siw *ServerInterfaceWrapper) PutLinksIdSlug(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
-	return ParseGetUsersIdResponse(rsp)
-}
 
-// PutUsersIdWithBodyWithResponse request with arbitrary body returning *PutUsersIdResponse
-func (c *ClientWithResponses) PutUsersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error) {
-	rsp, err := c.PutUsersIdWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutLinksIdSlugParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-ID is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-ID", Err: err})
+		return
 	}
-	return ParsePutUsersIdResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutUsersIdWithResponse(ctx context.Context, id string, body PutUsersIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersIdResponse, error) {
-	rsp, err := c.PutUsersId(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutLinksIdSlug(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParsePutUsersIdResponse(rsp)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseGetLinksResponse parses an HTTP response from a GetLinksWithResponse call
-func ParseGetLinksResponse(rsp *http.Response) (*GetLinksResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetLinksIdSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksIdSnapshots(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &GetLinksResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksIdSnapshotsParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Link
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	headers := r.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
 		}
-		response.JSON500 = &dest
+
+		params.XUserID = &XUserID
 
 	}
 
-	return response, nil
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksIdSnapshots(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParsePostLinksResponse parses an HTTP response from a PostLinksWithResponse call
-func ParsePostLinksResponse(rsp *http.Response) (*PostLinksResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetLinksIdSnapshotsSha operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksIdSnapshotsSha(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &PostLinksResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "sha" -------------
+	var sha string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "sha", runtime.ParamLocationPath, chi.URLParam(r, "sha"), &sha)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sha", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksIdSnapshotsShaParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
 		}
-		response.JSON500 = &dest
+
+		params.XUserID = &XUserID
 
 	}
 
-	return response, nil
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksIdSnapshotsSha(w, r, id, sha, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseGetLinksUserUserIDResponse parses an HTTP response from a GetLinksUserUserIDWithResponse call
-func ParseGetLinksUserUserIDResponse(rsp *http.Response) (*GetLinksUserUserIDResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetLinksIdStats operation middleware
+func (siw *ServerInterfaceWrapper) GetLinksIdStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &GetLinksUserUserIDResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksIdStatsParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Link
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	headers := r.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
 		}
-		response.JSON404 = &dest
 
+		params.XUserID = XUserID
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-ID is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-ID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLinksIdStats(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseDeleteLinksIdResponse parses an HTTP response from a DeleteLinksIdWithResponse call
-func ParseDeleteLinksIdResponse(rsp *http.Response) (*DeleteLinksIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetPublicLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetPublicLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPublicLinksParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
 	}
 
-	response := &DeleteLinksIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPublicLinks(w, r, params)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseGetLinksIdResponse parses an HTTP response from a GetLinksIdWithResponse call
-func ParseGetLinksIdResponse(rsp *http.Response) (*GetLinksIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetSharedToken operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationPath, chi.URLParam(r, "token"), &token)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
 	}
 
-	response := &GetLinksIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSharedTokenParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Link
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	headers := r.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "X-Share-Password" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Share-Password")]; found {
+		var XSharePassword string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Share-Password", Count: n})
+			return
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Share-Password", runtime.ParamLocationHeader, valueList[0], &XSharePassword)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Share-Password", Err: err})
+			return
 		}
-		response.JSON500 = &dest
+
+		params.XSharePassword = &XSharePassword
 
 	}
 
-	return response, nil
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSharedToken(w, r, token, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParsePutLinksIdResponse parses an HTTP response from a PutLinksIdWithResponse call
-func ParsePutLinksIdResponse(rsp *http.Response) (*PutLinksIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// GetShares operation middleware
+func (siw *ServerInterfaceWrapper) GetShares(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSharesParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
 	}
 
-	response := &PutLinksIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	headers := r.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
 		}
-		response.JSON500 = &dest
-
-	}
 
-	return response, nil
-}
+		params.XUserID = XUserID
 
-// ParseGetUsersResponse parses an HTTP response from a GetUsersWithResponse call
-func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+	} else {
+		err := fmt.Errorf("Header parameter X-User-ID is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-ID", Err: err})
+		return
 	}
 
-	response := &GetUsersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetShares(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// PostShares operation middleware
+func (siw *ServerInterfaceWrapper) PostShares(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostShares(w, r)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParsePostUsersResponse parses an HTTP response from a PostUsersWithResponse call
-func ParsePostUsersResponse(rsp *http.Response) (*PostUsersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteSharesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSharesId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &PostUsersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSharesIdParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
 		}
-		response.JSON500 = &dest
 
+		params.XUserID = XUserID
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-ID is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-ID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSharesId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseDeleteUsersIdResponse parses an HTTP response from a DeleteUsersIdWithResponse call
-func ParseDeleteUsersIdResponse(rsp *http.Response) (*DeleteUsersIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetTrashLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetTrashLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTrashLinksParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
 
-	response := &DeleteUsersIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrashLinks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// PostTrashLinksIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostTrashLinksIdRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTrashLinksIdRestoreParams
 
-// ParseGetUsersIdResponse parses an HTTP response from a GetUsersIdWithResponse call
-func ParseGetUsersIdResponse(rsp *http.Response) (*GetUsersIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
 
-	response := &GetUsersIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTrashLinksIdRestore(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// GetTrashUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetTrashUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrashUsers(w, r)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParsePutUsersIdResponse parses an HTTP response from a PutUsersIdWithResponse call
-func ParsePutUsersIdResponse(rsp *http.Response) (*PutUsersIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostTrashUsersIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostTrashUsersIdRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &PutUsersIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTrashUsersIdRestore(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// GetUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsers(w, r)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Получить все объекты Link
-	// (GET /links)
-	GetLinks(w http.ResponseWriter, r *http.Request)
-	// Создать новый объект Link
-	// (POST /links)
-	PostLinks(w http.ResponseWriter, r *http.Request)
-	// Получить ссылки, связанные с пользователем
-	// (GET /links/user/{userID})
-	GetLinksUserUserID(w http.ResponseWriter, r *http.Request, userID string)
-	// Удалить объект Link по ID
-	// (DELETE /links/{id})
-	DeleteLinksId(w http.ResponseWriter, r *http.Request, id string)
-	// Получить объект Link по ID
-	// (GET /links/{id})
-	GetLinksId(w http.ResponseWriter, r *http.Request, id string)
-	// Обновить объект Link по ID
-	// (PUT /links/{id})
-	PutLinksId(w http.ResponseWriter, r *http.Request, id string)
-	// Получить всех пользователей
-	// (GET /users)
-	GetUsers(w http.ResponseWriter, r *http.Request)
-	// Создать нового пользователя
-	// (POST /users)
-	PostUsers(w http.ResponseWriter, r *http.Request)
-	// Удалить пользователя по ID
-	// (DELETE /users/{id})
-	DeleteUsersId(w http.ResponseWriter, r *http.Request, id string)
-	// Получить пользователя по ID
-	// (GET /users/{id})
-	GetUsersId(w http.ResponseWriter, r *http.Request, id string)
-	// Обновить пользователя по ID
-	// (PUT /users/{id})
-	PutUsersId(w http.ResponseWriter, r *http.Request, id string)
-}
+// PostUsers operation middleware
+func (siw *ServerInterfaceWrapper) PostUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsers(w, r)
+	}))
 
-type Unimplemented struct{}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Получить все объекты Link
-// (GET /links)
-func (_ Unimplemented) GetLinks(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Создать новый объект Link
-// (POST /links)
-func (_ Unimplemented) PostLinks(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// DeleteUsersId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// Получить ссылки, связанные с пользователем
-// (GET /links/user/{userID})
-func (_ Unimplemented) GetLinksUserUserID(w http.ResponseWriter, r *http.Request, userID string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// Удалить объект Link по ID
-// (DELETE /links/{id})
-func (_ Unimplemented) DeleteLinksId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// Получить объект Link по ID
-// (GET /links/{id})
-func (_ Unimplemented) GetLinksId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-// Обновить объект Link по ID
-// (PUT /links/{id})
-func (_ Unimplemented) PutLinksId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersId(w, r, id)
+	}))
 
-// Получить всех пользователей
-// (GET /users)
-func (_ Unimplemented) GetUsers(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Создать нового пользователя
-// (POST /users)
-func (_ Unimplemented) PostUsers(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Удалить пользователя по ID
-// (DELETE /users/{id})
-func (_ Unimplemented) DeleteUsersId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// GetUsersId operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// Получить пользователя по ID
-// (GET /users/{id})
-func (_ Unimplemented) GetUsersId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// Обновить пользователя по ID
-// (PUT /users/{id})
-func (_ Unimplemented) PutUsersId(w http.ResponseWriter, r *http.Request, id string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersId(w, r, id)
+	}))
 
-// GetLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Request) {
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutUsersId operation middleware
+func (siw *ServerInterfaceWrapper) PutUsersId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinks(w, r)
+		siw.Handler.PutUsersId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1792,12 +12654,23 @@ func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostLinks operation middleware
-func (siw *ServerInterfaceWrapper) PostLinks(w http.ResponseWriter, r *http.Request) {
+// GetUsersIdCleanup operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdCleanup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostLinks(w, r)
+		siw.Handler.GetUsersIdCleanup(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1807,23 +12680,74 @@ func (siw *ServerInterfaceWrapper) PostLinks(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLinksUserUserID operation middleware
-func (siw *ServerInterfaceWrapper) GetLinksUserUserID(w http.ResponseWriter, r *http.Request) {
+// GetUsersIdExport operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdExport(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "userID" -------------
-	var userID string
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "userID", runtime.ParamLocationPath, chi.URLParam(r, "userID"), &userID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdExportParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-ID is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-ID", Err: err})
+		return
+	}
+
+	// ------------- Required header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Admin-Token", runtime.ParamLocationHeader, valueList[0], &XAdminToken)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = XAdminToken
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Admin-Token is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Admin-Token", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinksUserUserID(w, r, userID)
+		siw.Handler.GetUsersIdExport(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1833,8 +12757,8 @@ func (siw *ServerInterfaceWrapper) GetLinksUserUserID(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteLinksId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLinksId(w http.ResponseWriter, r *http.Request) {
+// PostUsersIdExport operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersIdExport(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1848,8 +12772,59 @@ func (siw *ServerInterfaceWrapper) DeleteLinksId(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersIdExportParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-ID is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-ID", Err: err})
+		return
+	}
+
+	// ------------- Required header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Admin-Token", runtime.ParamLocationHeader, valueList[0], &XAdminToken)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = XAdminToken
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Admin-Token is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Admin-Token", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLinksId(w, r, id)
+		siw.Handler.PostUsersIdExport(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1859,8 +12834,8 @@ func (siw *ServerInterfaceWrapper) DeleteLinksId(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLinksId operation middleware
-func (siw *ServerInterfaceWrapper) GetLinksId(w http.ResponseWriter, r *http.Request) {
+// GetUsersIdLinkSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdLinkSettings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1874,8 +12849,36 @@ func (siw *ServerInterfaceWrapper) GetLinksId(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdLinkSettingsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-ID is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-ID", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinksId(w, r, id)
+		siw.Handler.GetUsersIdLinkSettings(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1885,8 +12888,8 @@ func (siw *ServerInterfaceWrapper) GetLinksId(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutLinksId operation middleware
-func (siw *ServerInterfaceWrapper) PutLinksId(w http.ResponseWriter, r *http.Request) {
+// PutUsersIdLinkSettings operation middleware
+func (siw *ServerInterfaceWrapper) PutUsersIdLinkSettings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1900,8 +12903,36 @@ func (siw *ServerInterfaceWrapper) PutLinksId(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutUsersIdLinkSettingsParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		err := fmt.Errorf("Header parameter X-User-ID is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-User-ID", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutLinksId(w, r, id)
+		siw.Handler.PutUsersIdLinkSettings(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1911,12 +12942,47 @@ func (siw *ServerInterfaceWrapper) PutLinksId(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetUsers operation middleware
-func (siw *ServerInterfaceWrapper) GetUsers(w http.ResponseWriter, r *http.Request) {
+// GetUsersIdProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdProfile(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdProfileParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = &XUserID
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsers(w, r)
+		siw.Handler.GetUsersIdProfile(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1926,12 +12992,47 @@ func (siw *ServerInterfaceWrapper) GetUsers(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PostUsers operation middleware
-func (siw *ServerInterfaceWrapper) PostUsers(w http.ResponseWriter, r *http.Request) {
+// GetUsersIdTags operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdTags(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdTagsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-User-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, valueList[0], &XUserID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-User-ID", Err: err})
+			return
+		}
+
+		params.XUserID = &XUserID
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsers(w, r)
+		siw.Handler.GetUsersIdTags(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1941,8 +13042,8 @@ func (siw *ServerInterfaceWrapper) PostUsers(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteUsersId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUsersId(w http.ResponseWriter, r *http.Request) {
+// PostUsersIdTagsMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersIdTagsMerge(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1957,7 +13058,7 @@ func (siw *ServerInterfaceWrapper) DeleteUsersId(w http.ResponseWriter, r *http.
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteUsersId(w, r, id)
+		siw.Handler.PostUsersIdTagsMerge(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1967,8 +13068,8 @@ func (siw *ServerInterfaceWrapper) DeleteUsersId(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetUsersId operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Request) {
+// DeleteUsersIdTagsTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersIdTagsTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1982,8 +13083,17 @@ func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Path parameter "tag" -------------
+	var tag string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tag", runtime.ParamLocationPath, chi.URLParam(r, "tag"), &tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersId(w, r, id)
+		siw.Handler.DeleteUsersIdTagsTag(w, r, id, tag)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1993,8 +13103,8 @@ func (siw *ServerInterfaceWrapper) GetUsersId(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutUsersId operation middleware
-func (siw *ServerInterfaceWrapper) PutUsersId(w http.ResponseWriter, r *http.Request) {
+// PutUsersIdTagsTag operation middleware
+func (siw *ServerInterfaceWrapper) PutUsersIdTagsTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2008,8 +13118,17 @@ func (siw *ServerInterfaceWrapper) PutUsersId(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Path parameter "tag" -------------
+	var tag string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tag", runtime.ParamLocationPath, chi.URLParam(r, "tag"), &tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutUsersId(w, r, id)
+		siw.Handler.PutUsersIdTagsTag(w, r, id, tag)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2132,12 +13251,63 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.GetAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/collections", wrapper.GetCollections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/collections", wrapper.PostCollections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/collections/{id}", wrapper.DeleteCollectionsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/collections/{id}", wrapper.GetCollectionsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/collections/{id}", wrapper.PutCollectionsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/collections/{id}/links", wrapper.GetCollectionsIdLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/collections/{id}/links", wrapper.PostCollectionsIdLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/collections/{id}/links", wrapper.PutCollectionsIdLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/collections/{id}/links/{linkID}", wrapper.DeleteCollectionsIdLinksLinkID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/import", wrapper.PostImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/import/{id}", wrapper.GetImportId)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/links", wrapper.GetLinks)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/links", wrapper.PostLinks)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/archive", wrapper.GetLinksArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/export", wrapper.GetLinksExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/favorites", wrapper.GetLinksFavorites)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/links/state", wrapper.PostLinksState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/unread", wrapper.GetLinksUnread)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/links/user/{userID}", wrapper.GetLinksUserUserID)
 	})
@@ -2150,6 +13320,66 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/links/{id}", wrapper.PutLinksId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/{id}/content", wrapper.GetLinksIdContent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/{id}/history", wrapper.GetLinksIdHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/links/{id}/history/{revisionID}/revert", wrapper.PostLinksIdHistoryRevisionIDRevert)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/{id}/notes", wrapper.GetLinksIdNotes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/links/{id}/notes", wrapper.PostLinksIdNotes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/links/{id}/notes/{noteID}", wrapper.DeleteLinksIdNotesNoteID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/links/{id}/notes/{noteID}", wrapper.PutLinksIdNotesNoteID)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/links/{id}/slug", wrapper.PutLinksIdSlug)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/{id}/snapshots", wrapper.GetLinksIdSnapshots)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/{id}/snapshots/{sha}", wrapper.GetLinksIdSnapshotsSha)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/{id}/stats", wrapper.GetLinksIdStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/public/links", wrapper.GetPublicLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/shared/{token}", wrapper.GetSharedToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/shares", wrapper.GetShares)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/shares", wrapper.PostShares)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/shares/{id}", wrapper.DeleteSharesId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trash/links", wrapper.GetTrashLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trash/links/{id}/restore", wrapper.PostTrashLinksIdRestore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trash/users", wrapper.GetTrashUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trash/users/{id}/restore", wrapper.PostTrashUsersIdRestore)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users", wrapper.GetUsers)
 	})
@@ -2165,6 +13395,36 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/users/{id}", wrapper.PutUsersId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/cleanup", wrapper.GetUsersIdCleanup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/export", wrapper.GetUsersIdExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{id}/export", wrapper.PostUsersIdExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/link-settings", wrapper.GetUsersIdLinkSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{id}/link-settings", wrapper.PutUsersIdLinkSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/profile", wrapper.GetUsersIdProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/tags", wrapper.GetUsersIdTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{id}/tags/merge", wrapper.PostUsersIdTagsMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{id}/tags/{tag}", wrapper.DeleteUsersIdTagsTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{id}/tags/{tag}", wrapper.PutUsersIdTagsTag)
+	})
 
 	return r
 }
@@ -2172,24 +13432,163 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+yY3W7bNhTHX0XgdinU7pbd+G5btsFAL4oNuSqKQrUYl61FqiTVITAE1A2GFUuBPsE6",
-	"FHsBN6uRr9l5hcM3Kg7pD9mWP5Q6toPmxpZofpxz+OOf57hJaiKKBadcK1JpElV7QqPAPv4kpZD4EEsR",
-	"U6kZtc01EVL8pjyJSOUB4UL/LBIeEp/UBN9vsJomPnkchL/S5wlV+MK4ppIHjd+ofEGlm/ehT/RBTEmF",
-	"KC0Zr5PUJxFVKqjb2Sd+S30i6fOESRrimtaG0Qzi8VNa0zjDPcaf5ZgsaaBp+CjQOVP7hIX5zVFQd+OZ",
-	"ppHK7dNvCKQMDux7UC86gukGze2ZxOE8qxPZyG9XVD7KdWkiiAy3zC3vZhuN7TsyjIGfDeGYZbM24Ufb",
-	"f3ortjXW1xDMyRAO5soL2Z6iclXcxoFSvwsZXgkqhQc1oks6POyeWbUYK+h4QVbm+/f5DkybiUMZ3xd2",
-	"UseQhdwLeOihB97396vEJy+oVExwUiF375TvlNEeEVMexIxUyLe2CdfRT6x7pQbjz+xTndrdQN8DzQSv",
-	"hqRCfqH6nu2AZqtYcOWi8k257GSYa8rtuCCOG6xmR5aeKlx/oONjx+NrSfdJhXxVGil+qS/3JaubUycG",
-	"/Q6pqkkWa+cXvIdLODUt6MG5Bz34YP6CDpybV9CDY5xgp6B184xyF0WeFX9DB46hY15C1xzBmQcn0IZL",
-	"8xJ6poVWfLcWK96Z13AKH+Ac2p5pWXOcUW0Lm0qiKJAH2PMf6MGFOTR/wql5Zd54cIz9xwJojrzBJsRC",
-	"5fBwX6gMEPZq/UGEByvzM6Pa6fhZ0TKh6RSFd/FrMiIjfzxzaFpwCR3zGrrQ8ywzJ/AR2tC9BWUWKO8H",
-	"QXKYdPFYOcMzqPRBSf2+hJRQxUpN/KzupgsFBQVrz/a1aiSDiGoqFak8aBKG9qJCEZ84GSXJoOs4EH4m",
-	"KpNC+3BrJcu0TMscwQW+bA+FO+WdNVjhROgNnCBUSBh08B0p6+BHG87gI3TweM5Xr2EQz+HUR6CPzVvr",
-	"Utf61/FMy4PLvNWgA/9nwW2yMHUy0qAuAxhHdte2W2qr4VK02lv9c0jdKShrh1bSLlzchlu5eV3xr33x",
-	"TFAWIvRvP0r9629SzCwuXnUXzZ6rXeuioLzSa3WZ+GVCty5BWLCDNyCNmsdRnOQlUcm1c7T5zKyghGEU",
-	"XaaRlbEv5168ccfg3XC/Fh4DvGoxgZtbZu7ZDuvI2ezfHIXLzFmpxNltHVGs4DR/LAjm7MpzxMjq9S3z",
-	"H9CVK89Zye1tFbqiKhR68B9eFrn8mLcZqVkyq7dEbTKrXxaZ/Ax/e4q17eNnItufgcwySf9aISmvVNGK",
-	"busGCoAbDdnUFbcEZrNqguvGbPN3ZvnqAvil1wc3+pRM1QoLT0mapp8CAAD//6jRHsMVIAAA",
+	"H4sIAAAAAAAC/+x9W3PbRrrgX0Fx52Ev0MWynZ14H7Y8tmdXO4njku2pqYkzKliEJIxJgAFAxxqvqiwp",
+	"ipN1xprNZk+m5lTi48yZOq80LVq0JNJ/ofGPTn1fdwPdQDcAUhJFWXxITJG4dH/93a+PK0teveG5thsG",
+	"lSuPK8HSql238OPVZtUJb7ihvwZ/NXyvYfuhY+Nv1lLoeC58CtcaduVKJQh9x12prJvwk+fDL1U7WPKd",
+	"Br2wQl6QPjmIviV7pE/apBVtkg78bZAu2TPIHmmR13AF/rpPWsbvpu4Gtj81f900yLtoK9qINknfNEgn",
+	"2iAHpGuQPukZpEc6BnkVPSMHRrQF9+GTehVTsbDl0FYt7K9kjxySDumRLmlHX5MuvCLaMcg7XDH9gC/t",
+	"GGSXdMhbXEubdKMdWBtpRU/o3oxog+xHT6Jn0WayAO/+H+2lEBZw3172fHuoFeyS/tHeveTbVmhXF61Q",
+	"eWhOFb5e9vw6XFBx3PCDS8ljHDe0V2wfL2wo7/ftz5t2EC46VcX2fiA90oqesg12jN9NLdDL6eHukz4c",
+	"LW6kY1gNZ2rlC4O0SSd6QnrRFjmASw7gTtKLNqMt1dmGlr9i89frfqXfZ35ny3d8u1q58imAwuT4Ld8q",
+	"vkaC6GcKgF/zajU7phKZegoOQwKf9rAyX9cc98GiU8U3OKFdD9SwoF9Yvm+twd+uVbeVFzYs33a1IG14",
+	"gcMXWAJtmo1q3o6bge2r36Q6HH41W7ywGAEI0gFJC8g/rWt4F/K4ahWfatVuCae3bNUC20wdaNGJjQjI",
+	"ZaGYAmA+QD5y3AcDgoMdQvFC+IX5K/jEr9r+cEsYiBjUiwsKVncXMesMIkxqu1pUuOH7VKCnmJhXxSXa",
+	"brOO93vhr72mi6zRc5drzlJYMSv3rSpj9hUTX+27Vu227T+0ffpcsxJ63seWu8YuE8GdbKxuB4G1ogbJ",
+	"Q8erWbDrQCF7fgQJGW1FX1PpE+0Y0Ua0TTrkMHpGtY53IHiiDdJS6xb0Z7j9gP3BRNM+SqIYt37h28uV",
+	"K5X/MJPoUzNMmZr5LV9hIc4hUFWH8GvHrlWvrVruip09imX4Mbv50AlrtmkIX5pG06+ZhlO3VuzANEJr",
+	"JTANqxl6i/ARVDHY/UMncO47NSdcU4la1/6ihIwXdSaAWgu1uq4J4N9EKIKOx14YbZB3pBttwLfCBfA2",
+	"r1ZGo6D60fDvSZ0ChSd9Od2w6kjmAYp3Fz6C9dWtRx/Z7kq4WrkyN3vpl0CnIeB65UrlD6th2Aj++5WZ",
+	"mU//cO9e8Nl/+YUKqvP1hueH/8u7r9UWSgqBIt2i2ag5S1ZoByWfZ3Pqzzxq2XJqpZe17LhOsKpfF39E",
+	"eXWn4XtLdhCUXkEQWmEzEDlWw3ar8DCz4jddl36qeq5difemYkWhF1q101V72Fb4WkRYxAhQkc463tFg",
+	"ahHFyruNmmdVVWynZkuAuO+4lq9kGsnxpq1CbtkZU8B1gYQ7aOgckG60CebhO9I3kGR3ke2+IV1ySPrk",
+	"ENlvLHzsMFiyGlQZXHpgh4tLwUP4w3Hve5avPskh9CXcsgpSXEtKmcn+0qrz0BZfcd/zaraFkiBmvAq4",
+	"/Ew65DXwMTCPSRsYKeN3vegZ6VCrmbGwFkq2r0CgAfxQfL0iffKatKJvGI/sirKq0CgoNFBqdvJzauV/",
+	"wzfvkhYsbwMscxCVcI4dPNxDNG43GUsmbWoAPiF7pAvmnsmkMvoCerj6vhFtUiMXLk3fQjoqhCtSsJat",
+	"h57vhLb6ZDQsh8rNAe0rL7QDpQhrISg2QVwZeMItivjRt9FXqI28Q6SnyscudQuwL+k/IMn2QR+JnpG3",
+	"kjJjfF5WNbnphbZq2b5tac73O3qK0Q4/0jYeeJ+rSk/Rs9PjHgpO36RjABbAibLrgMARd3ukT94K2ILI",
+	"qjDiVwYEPeo/asZbwJf92oD82qwISlOhNhhfqeTzdNl0FSLXRwDESDgYIwf2dM1zQ9sNs1zKfhT61lIO",
+	"PPT2HEUUx11ZDJ26vVh33KZCtbg4p5SOof1I/b4vPL+6uOQ13bDUkzRGJXuB9DjNek0ZBloIHoNvQNAU",
+	"f3nhwzlTp+9k5OQTqsD2yR4wV8rUuY3CWCt834k2ow1GerEs6KHVcsBlAX1OG9yH/B4j2jDmrzNnLBDt",
+	"poIKzcqjqRVvCr6cCh44jSmvQYEw1fDQtqtcCf2mreaUeSQRq9Jg6lmP5uk9c7Mpmi7/ft8Om767aD9y",
+	"ghBWrmBj3L+IWka0Rd4Ah9og/WibiVQqbLei57IgizYM5HCHBt4CsI2ekEPSQh8l824zOX1o3F34CJg7",
+	"F3p9A/l5l7xCtn9p9sOKqZA/GV6XB7w71ooMt8vDwy1mmQKaXpiduzQ8JjBemoL+X8guYGy0YYB1xO0z",
+	"+AzEeGRrSsuoy6/7WPg5bF7HTBZseIXKPayJoMxfp2I/G0QBam8DxkYbqCy8lqxh1KliOQy2MoYUMjpi",
+	"tKMC5RL6HMpjo+ioGFyzzHdtK38LvKa/JHmi4PTRNvCdpVX86KAdozACVPI3kR/s0QkQCr3+cLC37RB4",
+	"TpA92Kq9bDVr4eJwuCWhNYixT9zaGsXXon0pXqxdfa25kl157gGwO8q5etn1ea8fSszyVejsS/LWgEsM",
+	"8hqxHZh/FzyDXGAaQCx44SHSVRfMLVSwuxWJAX16der31tSfZqc+XJz67PFF84NL6woetK7bX2iFCsy4",
+	"v0YVFH+tNKHBk37VBFtXRWf31xar1jE+zLeXbd+3/eN7IiKztcI00mN4Zh6Olnfa6JVJ5mthkJWhkt6R",
+	"KZ6oDtcD2I+9YAfNmkIrr1vh0qqt0gVfkn0mBvZJP1FOwKmpExCgprTIW7LLDGq4zqBhskEhwteVv6uh",
+	"oiL57pIik73AOkz0owuzs7Nmpe64/G+1+at+zxBOI10MCW3vLCvwqmsah9A+sDJq6IuuA6Nu+Q+q3heu",
+	"Un7nC1zbrWp8OMAjvzLQuUD9cYlRj0YH+NkPoufRU9KKdlJ4pDH4hpDunzcZkDJeCHFZPfSARF+iyvOa",
+	"5lIApDK+M+aqiDY5NKmbahMoJfpW7XgIQssPNaGlp6j29xVggnOJNtBZ2UZfVivaLregcrA8VtdywuUS",
+	"rEU8HMzLAAg9lPDmOC8o/h9cvnzxA1OLsBkI1R3XqTfrIjUL0NKh0f/nAb89Zgr3omeytxFP3yBdw3ar",
+	"phF9Rb1W8J9BXlG7mysQaDuXPOFiN0CMdvqtzh5LFoDuJIdi4QOc5BCcFJ+uWu8tKwjAzaM44n9AehEc",
+	"zytwkINHtYdnh+libfjYNaJt5UV42shUuIUKZmu0BQdK3krkLR/pf53DU4pPWFQg791rPP5o/dN794J7",
+	"925/9p/v3bv1+KP1/03/Eb6Fi1Tc6JbvQRDiNk0+KIjNK9j6ruyBWFm4dc2k2gH6i1CwPDHuutZDy6lZ",
+	"92s23/p126rWHNe+8WjJtqt2VbW4vDB9kGRDpQw14D4Bd3AW2mj8OSbdZfJSJV5QaEEm4SApia9IB/1g",
+	"cXrdTsUcLIdLI85KcG1N6ocuIoiXD8amb69avq1CGp7SohPGBXu2HzUc3w50P69awWJDoNPSYZd89/ND",
+	"74F+SaH3wHaH8OXbXwTDWAvMUIB3iqJU2jl/fKEvAY9pKHFafJJ5IFWcUfJjGNYWA3vJc6tqAI1ANiFc",
+	"qtpoRlHkj3Kb0iYnvgyju4MkTw6NQYycOUukz9FDQR12LuJNQ4QxjzX6pgyvpQAhR8GKSMW1GsGqF6qY",
+	"GqKJLve3kKflOr5WrbnLH6h/cv5kD3P87JGSExIeZcobKQZI4ilRgEQRWNMksDyw14qPCi4y2WNVi4EA",
+	"hawSXp6V9KMLClXijrVyjS90+PWHVgnXJFxUsP6PbX9lUC687Hv1owVxcl0UoVfqmenUNlgU3qzZ6YLN",
+	"mdoAW+W3DLoabc7pHWsloPaH1j3mVZ1lp2T2V+q18b2qV3NdccCU/VIZMVr3XLQFv2ME8QCzfo4jB2YY",
+	"+X5iCqqg/wyiq8JpXAP9sNnI01gDDdgP0CGzD4YcglVMQvpGTKdKpyGVcYymjz2rEOtzJwvSIWMlJe36",
+	"TXJkjn83vh2Enm9XC9/bxiyENhjG8nvRAyK+OUlJa0NeEnV/ZNIRdGQx2LJ1sAxAU1IB8yckqT0hXt+R",
+	"spAwrRiWHG2BKVhuNdk0U11yqQBwZXbiMTn4FGmjXLsU6SeGk4TV5ehzGBtFmeXyozabZf66MnJnwO6C",
+	"qcB/eIRkFZEh5kmw2MmUYoR599zl16mOJc0adQC+8QjD14MKpeHZj07hZVpt6tT+BasuqeMIyy22oY4R",
+	"xF0bXCkt8hZdptsVc5R52kdOri5QseFgmHspezII+vJWpsqptz50Es9gFq7Otm1YfuhYquSdfyMt5Irf",
+	"Ghhv2WOBjz5ps9AQZedbzAPe4rlokPLJZAJznD/FHFYUBPB122BwO46cKGo9KDbWZApeiQOBM87gCweM",
+	"yU9Zhx1DOdAddcT3ZNP1RsYBlXDiT0lt+p9pHgbp0SwM0omd9tEzM/bN4+dNzCfeR1SDMoEvAa8Mrlns",
+	"YirHE3xIm6VOdmSH/UXZYX9Rl/ExPaXJOEtKvMpWZ5EX6F1moVxgnSzE8I7lJCbh/Oc0enQAIYmnTDru",
+	"xbtWV0Q7ruKV/w+gk8kF6Mk1cqRjTBkQaTGNhhWumsbnTdtfi3P0bKtq+6o36p3/aYbrFnjtfyvlR8l7",
+	"aPjOQyu0jam0QZQk0EPg2jSabs0JQrsKhSUH0XPMdntLEx/mr0t6nmk0mvdrzhJc2Y42SMdMRbcxWS76",
+	"BkET15Chgtg1GPfajJ4J5ShskSBX2CoqZoW+RBXgwONaRnueec0wk8Kw3KoBBGJcvTUPzkDbpwmDlQvT",
+	"s9OzACmvYbtWw6lcqVzErxBvVxHtZizo3wCfVmyVVfq9oNt2SC8FTgDlIZqWXV5lwor0W+SQ8p27t28s",
+	"3F68ev3j+ZuL89dvi5X81BqgTH8DD4aV34EthhFT6e47n/zmxs1pA2siKHAhbznaBG4XPWfhVbnYBemm",
+	"i5ErWgARK47wxyHpTlcQOj4S5Xy1cqXyP+wQW1ogkHyrboc2iOhPVcmp6r2buCqDRsngZ7l5wWueCMAM",
+	"92gniQ0LxRkVSpyVmJIo/6vEDS8qIrnQ9D7KTJWkpRAWOVDmZSXYRILWU1EgA3anikjykEC/iavVuuNO",
+	"3WFBkKNsRBOeMyUgxy0z3qYaZJAOXyPyr2SJNL22YCmaG2ngMblTjGROJ5V3+CeznPifzKrif+bYfutm",
+	"fq4JxfMuHCNpSbyqy2EEZKHZPvMAJnuIdXJY7lTooE1SvCQhRyi9oFR+UJlVhd5xrEm0RNLFcSYrJdzC",
+	"8sGD6Cn76blxeZav+RXFOJCExuXZWc1aa07dCdXL1Tsg1SjlLS8H9qDP+gw9Bw3PDaiCMTc7K4Q64KPV",
+	"oJWfjufO/DGgSknyhlIatND9J1u0vm5mHUQSczbIm2gL6isQZ1vwiEsDrjJvccxCUqzjR9KJW8ekq+Lo",
+	"Ki6MZhVSOyKdh+tbuqSLI1iSNtcBEV/L6WGBl0dycj/FWSkt1BSwshD+30IFMmjW65a/Blf+U4Ja4G8A",
+	"13mXJmTJAgAydLS+RdguPngm5UVm2lJGd7gmOctSGoSKthOXQnkhOBLSTnZSjrSFpgk081hwqr81DdQg",
+	"QenbQX/pUyEjE37A/4E7GK/vjg8rGDe0fhF7TVjlexrWOY5y2vtFgbi3vCCFuaxV169Yot6xbD/TPml9",
+	"fT2N9usZ1L5wAu9XnkEqEoTGhFBI2ZrgpA4nX3IwKTEyep7hoDOPneo6tTZB3c0i5HX8XkDJ+aqGnYIl",
+	"m3DTIzPSS8UhQjEQ20kQ49IIjiS7EiqYRVdNaxwx5O88cK3BkGlDDB4qmVqPakv4nGiHG/yw2RKyeFTo",
+	"M3t6zCqLBBOcPJIkjZ5zPyAKzqZKbjZHhGQnKYxZAKKUMD5N/E63KBgre3FCaTmU9lN8cozSemjyxlEW",
+	"E5s6ofkQfwPAZSYbq03kgY/EToieZ4iWdNXKxkwc7iwlLbBE8SSoWeEho/X2HKNaCNV2HMBLpOK0Qft7",
+	"MJWeJ9MILX0VuSlg4e6SHo9/Ja573sQw+jN9fyoyogJsCY/0Kdus6ih1gbUqlsZOiHlgsSnjm0Jxawt1",
+	"c8gGDg3BFbBPOqXt0hOkyxOXshQ1y8jYS7mZdei/6mPCzEQQnjXa+T4+uTTtRFs8HzNtOpdUPc8uZdDG",
+	"zUOTxovEq0j2aWUtjbt2SG9CGGeEMITZBnE/T+FU5e4V7QG1vpnH8M/89UE9TkhSH+GtJ6QKKh5S4+87",
+	"bi+WKEKiLfKKBeJaST52GqIT2jkjvrVXNBKXESi6gwVSYc2urjyOVa/UAv4V933AszdfQTp1kmsNVBh9",
+	"SYP7Jm95/pp2a4N42m4qbwg9KeylSJoVU6Hr0U7CueGHerMWOg3LD2cgBD5VtUKrPLilTsWlJM7csR11",
+	"0rtbddw/xBpyl5ZIHDIWSHuPCHGI/ngQZmyPg6Cl7Sxo80LeZYU3PIw2ccEXRhG+jnEWbdvoa0T+QzFj",
+	"o0/ejqf8S867y8YHsGgKwHyfGec0mxCyOv8MXUIEFGE8YIvs0ReYxi3scM2P6RZvcS0QfxyF0blEKMrO",
+	"V0fjCPku7YFIrEbaUGjssupO0v8/PLsY2wSWUUjxFGB6ikTmOIH6CaaywPUgHd+A6im2bjmMtnQben4m",
+	"4hkZoZxCE+AEabdo6u3/V2xxEieh0yIOub6P+itpZnQqbzrayD4BPnWNqdSVLOt0G/+/w3Kp6ZcgUbrI",
+	"xDejJ6qsXY0BXMBkJOefWZCh8z64dUF6JDs6gkPX1E0jEKsP2P53QBOMnmMH7UYN2w6xshYVwFkLc4Xv",
+	"t7CdRBCuYWI86IaV7BItd03GuVZqaES0qery1KWJ7x3y2jSsWi2uAGBfJq5xxUYW67T/ULIb1maVLkco",
+	"B6B/WbVauWTfQmibMs5EG4LEpNnvBk7Oe8PYIiBckosbfVvytOxHS7Vm1V48uVNDzrYrj+ppp4NI3UwQ",
+	"qRuz+hbvI0060wb5Lu6zDVTFiQEA8A3lYV2WkSZ2UMR6htLjF9K9F2VOGRcrkLZBBz+oIfv5MOnn5yLt",
+	"eKg4D5bn/B+0g4Fltif5XKU1CcrtRABGzwx+CPrQDRfJJ+E/FiYtjDhzIYnk5HnYBu7/z4YLUNZE61cy",
+	"DRRaOHOOtIz/mBpd8J+mDfL3lI6garOQahhiCEX86+axJltqofRTgkQGtN3GupCvafMSwc8xTu7HD0ew",
+	"imNHHqoYHhpJ+bSiHwx6ZuZGsD2t3r0fzycRzDRYadKzARY5N4ozeIF6VDt6xopcWwY2/+zzBsBlOmgz",
+	"leMAjS3UJ5/SQnh4THqaJJNCp8//YQ0jcNDFZ4pHnnYSmwoNS4Uo4nxNqqnm5yP3kulXggRj8is2gmdY",
+	"X3G9MSy1txFCKNKstbjut8VnaIizrrSG61X28hOrGRlXCxXV9UO95+ioNupERx42FyqnbHqiOutU57/E",
+	"jW1UnaK0hTkJF7LjLj5qJvQTq8neVLZAwlcgvbHIR1Iin1wC1uw++NMwWPLfpFiNsRrWa3zyVzKE8dBI",
+	"+w/x6WJsgofmU4GIaFvL81jDohGyvDzv35iW7SuLsymHUTuVEOcTrxL7kw46hcOtmJW6sp78qFypcMAr",
+	"G+Y3A2sZ7k5c/3C3xtMvBrw9N8jYl3yJ48MZBw3OntdyZ6nmKBsQap3tgBBMP3mNq9+THTkDCyU+VCcn",
+	"UvRd0gqHeVEhaUTSkBXuCaEPnFZM/Dp++UQ5nijHE+X47CrHfy1gCSU4URCyZnmarDUAiVBtwI+nTy3x",
+	"DepwIP1oh89fQo8CHQUVF5UCTePhUuWvx7p7RZtJOMhQjLImXWk0LNUQDdIuPwpbmRSXjEs7QVe6NI/t",
+	"FBzq0pQ7NSFKR8ccLkmqN+lP6K5sdnW0kQVmj82x5MKoG22Xc3mK1Nl0+Ug8jQdNDMdlvWPxSJ2WaEWn",
+	"FqLVE+7Sl0+UhImSMFESzi6zgvUywUgbNg2vLACVzzyG/7PiD12iK+Uf0IwXry2V79rkl044yHtT+Ht+",
+	"ql3yG70JodizVoIMWW+kHe1QH1PMOTTIDYq5yDGyfYnywvTbcTPDOJ9Ljq9jHYzBWNkGTwfLzLmgpbwx",
+	"4eOzhLS+mdC3glVhhTOsLWjFVBaxITcbVdY+5WBJY54U54q2po14fqro/TOFaTZ4iNhw8012iA0cJ+lH",
+	"z6WHmrGq2MM0yH7cv5lmPgJTxCHtvMEw55tYAHLM/ExVbZeX5SI0jzpvbEcEiyLr4/1NzU9G8GBAjWJ8",
+	"OhtBMZInp73VKZB5oaISbaUVFfSdSBnXXC0hLZnQ08kf2LH+FBWPI2e3pcXo6RB6qo39mND6mUh7zdJn",
+	"ph+YZlJ4OvMeBf0+BtLnr1Nny6bJkzmFtMdY+m8YuJAOj7SzPDQ6FkU3BivTIeIEWcRn45HWO6DsTTcR",
+	"O9fy90hiVggxt2jBll4NPqNZtJPs2El27HuYHZvpxqcXc7JhPCNsv0Ap5XO3J7rp6eimHP4qTP8Zj3qD",
+	"wmaTJkmPUi9M3k8HkjK8pWlKBzjvTTAG5eM5o51lU3vjaVibypNQTp/K0OKqAy6gtRK0+D/ZlafSxCFV",
+	"391lvP4JDffpqA3C5VuF5dhjNjABwL1gP3SCsiMTXqD7sM1shHQdPBt5uMsDtGMaPhoNz3j5PjABAfef",
+	"UzndIm05EpFD6TOPfYZc89fBA/zQzm3f9CLOeVHlOHYx+fM58OA9lINPkxh8jHs03zlO2GGT8RKkNePA",
+	"kVipuMlQBPf7TPJpg2GQggLNRWf6CKbZJC9oTevzYWK2thADZYGCZGSd2pLjOEGeOUZM8Mg1sRJmiRhz",
+	"/jqYSmBhig5aV6gq5DSfG40Z/T1zAWT1Y51lx0xnMf+NGYnRBtrWtDxO2CPpjiPX/o6hxpaind6+iiGm",
+	"t5Ri3rS/Q7GSdtPTJziPVkXL9K5gOQTvg4oGUC6lmv2QAkKqY7Xcka8b7Uz4V6pdypnV0lLo36U0z6iF",
+	"qUjpeEpBB4wTpO4T8sLDek9nRtdNj78zhyJbYzuZa8ypbzTe7O+przdLN6zdFPPuDOASMQ3W+kR2Gb1m",
+	"ta7ts9DrXTyhaCs+txSUYFuSPdhRqxQzj+GfUj2tRR50E28anY3k8vedB4VFFYtMsS3F3LgJ2zpzSkNq",
+	"mp1E2HkDG84YJZ6gcnE6hUYllYtUYdGETs8qnWbqnhLtQpYaGRkb1JorsMICSr4Nl42D0T6erbFPJj0I",
+	"gH56nf/wyBVoCd/znqSxQ51NRuhgXjOMGge8Srnk+zTBGXvUvzntZEHE+/PcP7wg0HR8KcojceQiUvKs",
+	"pj1alBxtjiOr/lEgnExRR4fnk/Upu+aExEpIeSMklobWkmongpnHcO16lsW7ViNY9cIy3tnb8bWThJZT",
+	"dN/yYxii0ovFPKG+uW0aHNFiiLFIJpeWNByJf0xi3AMVYslAl2NBvJZbPAuDtfWmfvSvomflsl9i4p15",
+	"HKxa64OQ8O1Va3RWV7BqDfqU88ALNM28intvvUyjFO4SUekdd81L6DRC8mVo3Sf7WfI9I8QrTELAnXRE",
+	"am0pgJsmy9AqJ0/xuonhdApZGhT0avmD/naWodPl8X5RtYq75k+sg5O1Ds5AxXUKWeiGUshChbmkvGPO",
+	"RCa80WjerzlLxUPab+F1ebNuCxuVxJ0y52bNbKeRuvXIqTfrlSsXZmeP1MMkfo3qLWPez+QFNLdG//ZT",
+	"ReuNgq4m49hPZBfjcHzEQFopxW3kbpk1Toy29W0E3lJEDlYt367OPA69B7abq5fexivvwHWlJGHIrjyK",
+	"MHyBU7hwA6bQBWQjNV08LjtBjkb7/MIwLj6FQi/xcFNTt6wg+MLzq6dWAkFhm1cE8VKh6/CWAu/kRivx",
+	"qcMpK4pwRicRf0anxqtoS2z3HJ9oIn3E73qxhy92LI5cUqZQKis4qSJGU0KTqcSxKIW7aUOgsbWEf4o2",
+	"40Y8rKTqG3RUiZvnGBaX9rKc6jhfmnQFLhIUco9gXHpnp474fdSryzmo4FSO1IcoC8nx7yA2aR9Nuqlz",
+	"ew+7Sec1XcruXtecLS9ZMuZpJxE5xIefTk4j4wqDScnxzXC8eKb6FIxO5fmbPGg/KXc/iyWsKX1GUmLQ",
+	"gZx0PcaMRbIv7550FbvviNqNoteaKnuRMoXTmkF+zpScY+diabV+4j0sbRO9v87EnxKcUFRZSYChDEPo",
+	"f6jvLZ3y84iZtj2F+jxtSOobc2g+gd4mBjU35R6PcQ47beYojfFFqy5V0ggNyRWdqu/AVoYbVS7P8jyL",
+	"1fHlLCPJ7absJDRpmzyoeq5tyJMFcIrg5IajQr15Vn9PcHu+usBuOBWpPbaUcvQSarlxbNzH7DwnKXdZ",
+	"Pz01txi/AuP0AXYVQjA7u1kkTEDdXO8gUuJdvGoUvB3eVIq3/10llFXxFBpkGkignwmmnNpEiYCScOJa",
+	"Vpw71UzN+c1kfOQhralLwJpuQstUUIN1WpY20YWjQkFBG5fJM8tbnC5h4hwcqHnPpdFhnozE2tmJG1yq",
+	"2ZbbbKSH8CiG3O2KrQe0rLEXj9vRCUGqv/UxcLIB90zfc5WtRxK6OlEJd5JihtLqIB3hNXCddMxn2IPC",
+	"51w1KsTo6SvwDVH3T19PfN24L2E/ibHT1E96pZmiaVoi3OP02o6bBSk6GWplqcRmC8ifstlCkTqG0lSa",
+	"ppQjRiYmUznpXCrJIy+AkeDI8ccv4NkDhy9Ksvj0gAIh9DDBHh32vORAorgjlAyUYTXFk0a0hzXaqSMK",
+	"zVM9dYSpRaPRhy4dAbvHYvzG/PUcLJkMARq8CF+r3Cct+3NF+6gw9zQ1+XGZRzHB/OPVW0rgvq6Q/aRx",
+	"/2QUoYHbRwwnKs77tIgzTSWZNveFVCIrZ9wPpY84vojbmcousWgn52XMhyaPwlemT8j+OSHcmPbPYXes",
+	"3HQsiMLv0Wa8/DGq4CRjBtfYxs+gPORLV6HOP6jfUVKLRzh5RXhtS25jRouRAX1gFIaYx51DfsLEvQM8",
+	"z7Pg/t5WHIGRzGqPtuNtDeq6oSRrP2p4fqinWD6eSe6vHu3wdnWvMadijx5R3F0uzk8XJzT9fv7WVDKn",
+	"GkvIv0SGeICF5F2c0DINMGf+cfYZu7exz0terWYvweLoN8AJlmrOEruYecBp3C+1OrTRX5Fu9CRZk6l0",
+	"v+Nq52bnYImkw3v0StO/ySGyEPRkIvyjp7yyNgMV3RvgqUnPPpCjMLdq2XJqdjWH19ygJzaSMPFfyC6c",
+	"DLJoVslKvYvjmMqVXf5LFA5QX7dp3L19Y+H24tXrH8/fXLzzyW9u3KQzA8hutMmI8Q2e43NehSD5WFs6",
+	"QOSV9lyt1h136s7AVUgnzfIZBgErEB/0J6chPycuwrvvuBYmAhSWm6doPE5oVE/PTygHizpkykFJMzc7",
+	"dzJbV0yUStMtFTgqtlEx2Wnj8SzYob82dXU5tH1lcX5Ss7h+nlP/ZfimNK5s88kciiOHrP9ITN3IOTMU",
+	"PjpVRdpbtEX1DUUHioPxDLq/JPtUoeJO/dRuYi+/qHeUqVhQWEf7vNSjQ3ahkWu0FX2jFpwagS0MvujJ",
+	"fcVjEdPNEbq0oR17qTJkPZGz51bOnp6wecckZCvJT0OztR/HF3Y5s57Ij/dOfpxpt9EPCQEnYeGjSZCU",
+	"kQrW4FRgh6HjrhSnHMxXsWsLv/x08ml7dEgncukO2T+XfXP4CagZTAKft/I8tz7ZP9eMLgua9780lvQy",
+	"my41H7dEoGbCDE67+7DEB0bceziPB71UN7bJoOJog1h0zAcO1OYjPrb4gGLSS5WWktaEVb7nrDLdkn14",
+	"VpnSqhq+t+zUbL3v/6Vsf+cOKd9L5qubupAdXv8aP5M9MQW+z2a2HqanmR9O33OTEARc1mWTcBKg0XzO",
+	"Ns+ZZWYm5NkeZN37SUiQm1uwH0r4uM89yrejZ9S/vxsnLMvmBmXT91zxHmqx2XDkQUwo6XmeiJ68ka4q",
+	"aT7RYm+x8zn9BsrsRL4EmEbfptumUq4JWBjXvyu6gfEZM2Z6yAw73ejPuAI+H1XHWsZ2hji8n5+YbnRx",
+	"DMK8IhOKsYfY275HWyt3YlXgGXk7yUM6S6byqOoLfhQZpMiVZOLdkUPhyNJibIzV8eL0qVLIHEc442pM",
+	"DPMmkgD/zsil0Cpl5N+xVsaiuTwTatMG+ZmLN0U7PJk9RtsJWFBmvxfssVStxh1r5ZrXdIfoSE8BnduN",
+	"PnErQeXMQbRDeVe0PUnKL51xEitpeqrmyVdddE5voMrVJ4cSTivJeqZu+ysFNfECeX+MV5+dRMs71gpd",
+	"8ogtXYAVTfBcsANooatshcnPtc86Re3S2gdaKj0hD21OpACs2AIDtrwv8OmEOeV3johNmDZ18jCveMZe",
+	"akXbagJ6HForJXpACUR0x1oZ3fSG0FoZ6CmfjQVVjEmFy7jTglw/QlGeGuMxJh+X0/ZsIO6JSJAFG5c0",
+	"tiIkSbPm/mtWmTwhHL2OpQCYRER6mVFGTqyv//sAp3/0D282AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,102 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+var (
+	ErrNotFound    = errors.New("blob not found")
+	ErrInvalidHash = errors.New("invalid blob hash")
+)
+
+var hashRe = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Hash возвращает адрес блоба - hex представление sha256 от его содержимого.
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// FS хранит блобы в файловой системе по адресу, вычисленному из их содержимого,
+// поэтому одинаковые данные сохраняются один раз.
+type FS struct {
+	dir string
+}
+
+func NewFS(dir string) (*FS, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("os MkdirAll: %w", err)
+	}
+
+	return &FS{dir: dir}, nil
+}
+
+func (s *FS) Put(ctx context.Context, data []byte) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	sum := Hash(data)
+	path := s.path(sum)
+
+	if _, err := os.Stat(path); err == nil {
+		return sum, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return "", fmt.Errorf("os MkdirAll: %w", err)
+	}
+
+	// Пишем во временный файл и переименовываем, чтобы читатели не увидели недописанный блоб
+	tmp, err := os.CreateTemp(filepath.Dir(path), sum+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("os CreateTemp: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("file Write: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("file Close: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("os Rename: %w", err)
+	}
+
+	return sum, nil
+}
+
+func (s *FS) Get(ctx context.Context, sum string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !hashRe.MatchString(sum) {
+		return nil, ErrInvalidHash
+	}
+
+	data, err := os.ReadFile(s.path(sum))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("os ReadFile: %w", err)
+	}
+
+	return data, nil
+}
+
+func (s *FS) path(sum string) string {
+	return filepath.Join(s.dir, sum[:2], sum)
+}
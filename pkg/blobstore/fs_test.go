@@ -0,0 +1,66 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFS(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s, err := NewFS(dir)
+	if err != nil {
+		t.Fatalf("NewFS() error = %v", err)
+	}
+
+	data := []byte("<html><body>snapshot</body></html>")
+
+	first, err := s.Put(ctx, data)
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	if first != Hash(data) {
+		t.Errorf("Put() = %v, want %v", first, Hash(data))
+	}
+
+	second, err := s.Put(ctx, data)
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	if first != second {
+		t.Errorf("Put() of identical data = %v, want %v", second, first)
+	}
+
+	files, err := os.ReadDir(filepath.Join(dir, first[:2]))
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+
+	if len(files) != 1 {
+		t.Errorf("stored %d files, want 1", len(files))
+	}
+
+	got, err := s.Get(ctx, first)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if !bytes.Equal(got, data) {
+		t.Errorf("Get() = %q, want %q", got, data)
+	}
+
+	if _, err := s.Get(ctx, Hash([]byte("missing"))); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() of missing blob error = %v, want %v", err, ErrNotFound)
+	}
+
+	if _, err := s.Get(ctx, "../../etc/passwd"); !errors.Is(err, ErrInvalidHash) {
+		t.Errorf("Get() of invalid hash error = %v, want %v", err, ErrInvalidHash)
+	}
+}
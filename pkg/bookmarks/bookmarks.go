@@ -0,0 +1,100 @@
+// Package bookmarks разбирает экспорт закладок других менеджеров: HTML в формате Netscape
+// (экспорт браузеров и Pocket), CSV Pocket и JSON Pinboard.
+package bookmarks
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatAuto      Format = ""
+	FormatNetscape  Format = "netscape"
+	FormatPocketCSV Format = "pocket_csv"
+	FormatPinboard  Format = "pinboard"
+)
+
+var ErrUnknownFormat = errors.New("unknown bookmarks format")
+
+// Bookmark - закладка, приведенная к общему виду. Folder содержит путь папок от корня.
+type Bookmark struct {
+	URL         string
+	Title       string
+	Description string
+	Tags        []string
+	Folder      []string
+	AddedAt     time.Time
+}
+
+// sniffSize - размер начала файла, по которому определяется формат.
+const sniffSize = 512
+
+// Parse разбирает экспорт в указанном формате. Для FormatAuto формат определяется по содержимому.
+func Parse(r io.Reader, format Format) ([]Bookmark, error) {
+	br := bufio.NewReader(r)
+
+	if format == FormatAuto {
+		head, err := br.Peek(sniffSize)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("bufio Peek: %w", err)
+		}
+
+		if format = Detect(head); format == FormatAuto {
+			return nil, ErrUnknownFormat
+		}
+	}
+
+	switch format {
+	case FormatNetscape:
+		return ParseHTML(br)
+	case FormatPocketCSV:
+		return ParseCSV(br)
+	case FormatPinboard:
+		return ParsePinboard(br)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+// Detect определяет формат по началу файла или возвращает FormatAuto, если формат не распознан.
+func Detect(head []byte) Format {
+	if len(head) > sniffSize {
+		head = head[:sniffSize]
+	}
+
+	head = bytes.TrimPrefix(head, []byte("\xef\xbb\xbf"))
+	trimmed := bytes.TrimSpace(head)
+	lower := strings.ToLower(string(trimmed))
+
+	switch {
+	case strings.HasPrefix(lower, "["):
+		return FormatPinboard
+	case strings.HasPrefix(lower, "<"):
+		return FormatNetscape
+	case strings.Contains(strings.SplitN(lower, "\n", 2)[0], "url"):
+		return FormatPocketCSV
+	default:
+		return FormatAuto
+	}
+}
+
+// splitTags разбивает строку тегов по любому из разделителей, отбрасывая пустые.
+func splitTags(s, seps string) []string {
+	fields := strings.FieldsFunc(s, func(r rune) bool { return strings.ContainsRune(seps, r) })
+
+	var tags []string
+	for _, f := range fields {
+		if f = strings.TrimSpace(f); f != "" {
+			tags = append(tags, f)
+		}
+	}
+
+	return tags
+}
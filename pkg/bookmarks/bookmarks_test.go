@@ -0,0 +1,130 @@
+package bookmarks
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const netscape = `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=UTF-8">
+<TITLE>Bookmarks</TITLE>
+<H1>Bookmarks</H1>
+<DL><p>
+    <DT><H3 ADD_DATE="1700000000">Dev</H3>
+    <DL><p>
+        <DT><A HREF="https://go.dev/" ADD_DATE="1700000100" TAGS="go,lang">The Go Programming Language</A>
+        <DD>Official site
+        <DT><H3>Databases</H3>
+        <DL><p>
+            <DT><A HREF="https://www.mongodb.com/docs/">MongoDB Docs</A>
+        </DL><p>
+    </DL><p>
+    <DT><A HREF="javascript:alert(1)">Bookmarklet</A>
+    <DT><A HREF="https://example.com/">Example</A>
+</DL><p>
+`
+
+const pocketHTML = `<!DOCTYPE html>
+<html><head><title>Pocket Export</title></head>
+<body>
+<h1>Unread</h1>
+<ul>
+<li><a href="https://example.com/article" time_added="1600000000" tags="read,later">Article</a></li>
+</ul>
+</body></html>
+`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Bookmark
+	}{
+		{
+			name:  "test_netscape_folders",
+			input: netscape,
+			want: []Bookmark{
+				{
+					URL:         "https://go.dev/",
+					Title:       "The Go Programming Language",
+					Description: "Official site",
+					Tags:        []string{"go", "lang"},
+					Folder:      []string{"Dev"},
+					AddedAt:     time.Unix(1700000100, 0).UTC(),
+				},
+				{
+					URL:    "https://www.mongodb.com/docs/",
+					Title:  "MongoDB Docs",
+					Folder: []string{"Dev", "Databases"},
+				},
+				{
+					URL:   "https://example.com/",
+					Title: "Example",
+				},
+			},
+		},
+		{
+			name:  "test_pocket_html",
+			input: pocketHTML,
+			want: []Bookmark{
+				{
+					URL:     "https://example.com/article",
+					Title:   "Article",
+					Tags:    []string{"read", "later"},
+					AddedAt: time.Unix(1600000000, 0).UTC(),
+				},
+			},
+		},
+		{
+			name: "test_pocket_csv",
+			input: "title,url,time_added,tags,status\n" +
+				"Article,https://example.com/article,1600000000,read|later,unread\n" +
+				"Empty,,1600000000,,unread\n",
+			want: []Bookmark{
+				{
+					URL:     "https://example.com/article",
+					Title:   "Article",
+					Tags:    []string{"read", "later"},
+					AddedAt: time.Unix(1600000000, 0).UTC(),
+				},
+			},
+		},
+		{
+			name: "test_pinboard",
+			input: `[{"href":"https://example.com/","description":"Example","extended":"Notes",
+				"meta":"x","hash":"y","time":"2020-01-02T03:04:05Z","shared":"yes","toread":"no","tags":"web  demo"}]`,
+			want: []Bookmark{
+				{
+					URL:         "https://example.com/",
+					Title:       "Example",
+					Description: "Notes",
+					Tags:        []string{"web", "demo"},
+					AddedAt:     time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := Parse(strings.NewReader(tt.input), FormatAuto)
+				if err != nil {
+					t.Fatalf("Parse() error = %v", err)
+				}
+
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestParse_UnknownFormat(t *testing.T) {
+	if _, err := Parse(strings.NewReader("just some text"), FormatAuto); err == nil {
+		t.Error("Parse() error = nil, want error")
+	}
+}
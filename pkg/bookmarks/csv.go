@@ -0,0 +1,69 @@
+package bookmarks
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseCSV разбирает CSV-экспорт Pocket с заголовком title,url,time_added,tags,status.
+// Колонки ищутся по заголовку, теги разделяются символом "|".
+func ParseCSV(r io.Reader) ([]Bookmark, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("csv Read: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+
+	urlIdx, ok := columns["url"]
+	if !ok {
+		return nil, fmt.Errorf("%w: csv without url column", ErrUnknownFormat)
+	}
+
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var res []Bookmark
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("csv Read: %w", err)
+		}
+
+		if urlIdx >= len(record) || strings.TrimSpace(record[urlIdx]) == "" {
+			continue
+		}
+
+		b := Bookmark{
+			URL:   strings.TrimSpace(record[urlIdx]),
+			Title: field(record, "title"),
+			Tags:  splitTags(field(record, "tags"), "|,"),
+		}
+
+		if sec, err := strconv.ParseInt(field(record, "time_added"), 10, 64); err == nil && sec > 0 {
+			b.AddedAt = time.Unix(sec, 0).UTC()
+		}
+
+		res = append(res, b)
+	}
+
+	return res, nil
+}
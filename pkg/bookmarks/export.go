@@ -0,0 +1,273 @@
+package bookmarks
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ExportFormat string
+
+const (
+	ExportJSON     ExportFormat = "json"
+	ExportCSV      ExportFormat = "csv"
+	ExportHTML     ExportFormat = "html"
+	ExportMarkdown ExportFormat = "md"
+)
+
+// Writer записывает закладки по одной, не накапливая их в памяти. Close дописывает окончание документа.
+type Writer interface {
+	Write(b Bookmark) error
+	Close() error
+}
+
+func NewWriter(format ExportFormat, w io.Writer) (Writer, error) {
+	switch format {
+	case ExportJSON:
+		return &jsonWriter{w: w}, nil
+	case ExportCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case ExportHTML:
+		return &htmlWriter{w: w}, nil
+	case ExportMarkdown:
+		return &markdownWriter{w: w}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+// ContentType возвращает MIME-тип документа в формате экспорта.
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportJSON:
+		return "application/json"
+	case ExportCSV:
+		return "text/csv; charset=utf-8"
+	case ExportHTML:
+		return "text/html; charset=utf-8"
+	case ExportMarkdown:
+		return "text/markdown; charset=utf-8"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+type jsonBookmark struct {
+	URL         string    `json:"url"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	Tags        []string  `json:"tags"`
+	AddedAt     time.Time `json:"added_at"`
+}
+
+// jsonWriter пишет массив JSON поэлементно.
+type jsonWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (jw *jsonWriter) Write(b Bookmark) error {
+	data, err := json.Marshal(jsonBookmark{
+		URL:         b.URL,
+		Title:       b.Title,
+		Description: b.Description,
+		Tags:        nonNil(b.Tags),
+		AddedAt:     b.AddedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("json Marshal: %w", err)
+	}
+
+	sep := ",\n"
+	if jw.count == 0 {
+		sep = "[\n"
+	}
+	jw.count++
+
+	_, err = io.WriteString(jw.w, sep+string(data))
+
+	return err
+}
+
+func (jw *jsonWriter) Close() error {
+	end := "\n]\n"
+	if jw.count == 0 {
+		end = "[]\n"
+	}
+
+	_, err := io.WriteString(jw.w, end)
+
+	return err
+}
+
+// csvWriter пишет CSV с колонками экспорта Pocket, чтобы файл можно было импортировать обратно.
+type csvWriter struct {
+	w      *csv.Writer
+	header bool
+}
+
+func (cw *csvWriter) Write(b Bookmark) error {
+	if !cw.header {
+		cw.header = true
+		if err := cw.w.Write([]string{"title", "url", "time_added", "tags", "status"}); err != nil {
+			return fmt.Errorf("csv Write: %w", err)
+		}
+	}
+
+	record := []string{b.Title, b.URL, unixTime(b.AddedAt), strings.Join(b.Tags, "|"), "unread"}
+	if err := cw.w.Write(record); err != nil {
+		return fmt.Errorf("csv Write: %w", err)
+	}
+
+	return nil
+}
+
+func (cw *csvWriter) Close() error {
+	if !cw.header {
+		if err := cw.w.Write([]string{"title", "url", "time_added", "tags", "status"}); err != nil {
+			return fmt.Errorf("csv Write: %w", err)
+		}
+	}
+
+	cw.w.Flush()
+
+	return cw.w.Error()
+}
+
+const htmlHeader = `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<!-- This is an automatically generated file.
+     It will be read and overwritten.
+     DO NOT EDIT! -->
+<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=UTF-8">
+<TITLE>Bookmarks</TITLE>
+<H1>Bookmarks</H1>
+<DL><p>
+`
+
+// htmlWriter пишет формат Netscape в том виде, в каком его экспортируют и принимают браузеры.
+type htmlWriter struct {
+	w      io.Writer
+	header bool
+}
+
+func (hw *htmlWriter) writeHeader() error {
+	if hw.header {
+		return nil
+	}
+	hw.header = true
+
+	_, err := io.WriteString(hw.w, htmlHeader)
+
+	return err
+}
+
+func (hw *htmlWriter) Write(b Bookmark) error {
+	if err := hw.writeHeader(); err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`    <DT><A HREF="`)
+	sb.WriteString(html.EscapeString(b.URL))
+	sb.WriteString(`"`)
+	if added := unixTime(b.AddedAt); added != "" {
+		sb.WriteString(` ADD_DATE="` + added + `"`)
+	}
+	if len(b.Tags) > 0 {
+		sb.WriteString(` TAGS="` + html.EscapeString(strings.Join(b.Tags, ",")) + `"`)
+	}
+	sb.WriteString(">")
+	sb.WriteString(html.EscapeString(title(b)))
+	sb.WriteString("</A>\n")
+	if b.Description != "" {
+		sb.WriteString("    <DD>" + html.EscapeString(b.Description) + "\n")
+	}
+
+	_, err := io.WriteString(hw.w, sb.String())
+
+	return err
+}
+
+func (hw *htmlWriter) Close() error {
+	if err := hw.writeHeader(); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(hw.w, "</DL><p>\n")
+
+	return err
+}
+
+// markdownWriter пишет список ссылок, удобный для чтения и вставки в заметки.
+type markdownWriter struct {
+	w      io.Writer
+	header bool
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`)
+
+func (mw *markdownWriter) writeHeader() error {
+	if mw.header {
+		return nil
+	}
+	mw.header = true
+
+	_, err := io.WriteString(mw.w, "# Bookmarks\n\n")
+
+	return err
+}
+
+func (mw *markdownWriter) Write(b Bookmark) error {
+	if err := mw.writeHeader(); err != nil {
+		return err
+	}
+
+	// Скобки в адресе ломают ссылку Markdown, поэтому адрес берется в угловые скобки
+	line := fmt.Sprintf("- [%s](<%s>)", markdownEscaper.Replace(title(b)), strings.ReplaceAll(b.URL, ">", "%3E"))
+	if !b.AddedAt.IsZero() {
+		line += " — " + b.AddedAt.UTC().Format(time.DateOnly)
+	}
+	for _, tag := range b.Tags {
+		line += " `" + strings.ReplaceAll(tag, "`", "'") + "`"
+	}
+	if b.Description != "" {
+		line += "\n  " + strings.Join(strings.Fields(b.Description), " ")
+	}
+
+	_, err := io.WriteString(mw.w, line+"\n")
+
+	return err
+}
+
+func (mw *markdownWriter) Close() error {
+	return mw.writeHeader()
+}
+
+func title(b Bookmark) string {
+	if b.Title != "" {
+		return b.Title
+	}
+
+	return b.URL
+}
+
+func unixTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func nonNil(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+
+	return tags
+}
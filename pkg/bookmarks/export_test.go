@@ -0,0 +1,111 @@
+package bookmarks
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var exported = []Bookmark{
+	{
+		URL:         "https://go.dev/?a=1&b=2",
+		Title:       `Go "home" <page>`,
+		Description: "Official site",
+		Tags:        []string{"go", "lang"},
+		AddedAt:     time.Unix(1700000100, 0).UTC(),
+	},
+	{
+		URL:     "https://example.com/",
+		AddedAt: time.Unix(1600000000, 0).UTC(),
+	},
+}
+
+func export(t *testing.T, format ExportFormat, items []Bookmark) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := NewWriter(format, &buf)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	for _, b := range items {
+		if err := w.Write(b); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	return buf.String()
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		format ExportFormat
+		want   []Bookmark
+	}{
+		{
+			name:   "test_netscape_html",
+			format: ExportHTML,
+			want: []Bookmark{
+				exported[0],
+				{URL: "https://example.com/", Title: "https://example.com/", AddedAt: exported[1].AddedAt},
+			},
+		},
+		{
+			name:   "test_pocket_csv",
+			format: ExportCSV,
+			want: []Bookmark{
+				{URL: exported[0].URL, Title: exported[0].Title, Tags: exported[0].Tags, AddedAt: exported[0].AddedAt},
+				{URL: "https://example.com/", AddedAt: exported[1].AddedAt},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := Parse(strings.NewReader(export(t, tt.format, exported)), FormatAuto)
+				if err != nil {
+					t.Fatalf("Parse() error = %v", err)
+				}
+
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestWriter_JSON(t *testing.T) {
+	var got []map[string]any
+	if err := json.Unmarshal([]byte(export(t, ExportJSON, exported)), &got); err != nil {
+		t.Fatalf("json Unmarshal() error = %v", err)
+	}
+
+	if len(got) != 2 || got[0]["url"] != exported[0].URL || got[1]["title"] != "" {
+		t.Errorf("export = %v", got)
+	}
+
+	if empty := export(t, ExportJSON, nil); empty != "[]\n" {
+		t.Errorf("empty export = %q, want %q", empty, "[]\n")
+	}
+}
+
+func TestWriter_Markdown(t *testing.T) {
+	want := "# Bookmarks\n\n" +
+		"- [Go \"home\" <page>](<https://go.dev/?a=1&b=2>) — 2023-11-14 `go` `lang`\n  Official site\n" +
+		"- [https://example.com/](<https://example.com/>) — 2020-09-13\n"
+
+	if got := export(t, ExportMarkdown, exported); got != want {
+		t.Errorf("export = %q, want %q", got, want)
+	}
+}
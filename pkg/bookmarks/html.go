@@ -0,0 +1,134 @@
+package bookmarks
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ParseHTML разбирает HTML в формате Netscape. Папки задаются заголовком H3 и следующим за ним списком DL,
+// дата добавления берется из ADD_DATE (браузеры) или TIME_ADDED (Pocket), теги - из TAGS.
+func ParseHTML(r io.Reader) ([]Bookmark, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("html.Parse: %w", err)
+	}
+
+	var res []Bookmark
+	walk(doc, nil, &res)
+
+	return res, nil
+}
+
+func walk(n *html.Node, folder []string, res *[]Bookmark) {
+	if n.Type == html.ElementNode {
+		switch n.DataAtom {
+		case atom.A:
+			if b, ok := anchor(n, folder); ok {
+				*res = append(*res, b)
+			}
+			return
+		case atom.Dl:
+			if name := folderName(n); name != "" {
+				folder = append(folder[:len(folder):len(folder)], name)
+			}
+		default:
+		}
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, folder, res)
+	}
+}
+
+// folderName возвращает название папки из заголовка, предшествующего списку.
+func folderName(dl *html.Node) string {
+	for s := dl.PrevSibling; s != nil; s = s.PrevSibling {
+		if s.Type != html.ElementNode {
+			continue
+		}
+
+		if s.DataAtom == atom.H3 {
+			return strings.TrimSpace(text(s))
+		}
+
+		return ""
+	}
+
+	return ""
+}
+
+func anchor(n *html.Node, folder []string) (Bookmark, bool) {
+	b := Bookmark{Title: strings.TrimSpace(text(n)), Folder: folder}
+
+	for _, a := range n.Attr {
+		switch strings.ToLower(a.Key) {
+		case "href":
+			b.URL = strings.TrimSpace(a.Val)
+		case "tags":
+			b.Tags = splitTags(a.Val, ",")
+		case "add_date", "time_added":
+			if sec, err := strconv.ParseInt(strings.TrimSpace(a.Val), 10, 64); err == nil && sec > 0 {
+				b.AddedAt = time.Unix(sec, 0).UTC()
+			}
+		}
+	}
+
+	// Закладки-скрипты и якоря браузеры тоже экспортируют, их не импортируем
+	if !strings.HasPrefix(b.URL, "http://") && !strings.HasPrefix(b.URL, "https://") {
+		return b, false
+	}
+
+	if dd := description(n.Parent); dd != "" {
+		b.Description = dd
+	}
+
+	return b, true
+}
+
+// description возвращает текст DD, который Netscape-формат ставит после DT с закладкой.
+func description(dt *html.Node) string {
+	if dt == nil || dt.DataAtom != atom.Dt {
+		return ""
+	}
+
+	for s := dt.NextSibling; s != nil; s = s.NextSibling {
+		if s.Type != html.ElementNode {
+			continue
+		}
+
+		if s.DataAtom == atom.Dd {
+			return strings.TrimSpace(text(s))
+		}
+
+		return ""
+	}
+
+	return ""
+}
+
+func text(n *html.Node) string {
+	var sb strings.Builder
+
+	var collect func(*html.Node)
+	collect = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+		}
+		// Вложенные списки DD не относятся к описанию
+		if n.Type == html.ElementNode && n.DataAtom == atom.Dl {
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			collect(c)
+		}
+	}
+	collect(n)
+
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
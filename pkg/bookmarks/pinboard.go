@@ -0,0 +1,47 @@
+package bookmarks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type pinboardPost struct {
+	Href        string `json:"href"`
+	Description string `json:"description"` // в Pinboard это заголовок
+	Extended    string `json:"extended"`
+	Tags        string `json:"tags"`
+	Time        string `json:"time"`
+}
+
+// ParsePinboard разбирает JSON-экспорт Pinboard. Теги в нем разделены пробелами.
+func ParsePinboard(r io.Reader) ([]Bookmark, error) {
+	var posts []pinboardPost
+	if err := json.NewDecoder(r).Decode(&posts); err != nil {
+		return nil, fmt.Errorf("json Decode: %w", err)
+	}
+
+	res := make([]Bookmark, 0, len(posts))
+	for _, p := range posts {
+		if strings.TrimSpace(p.Href) == "" {
+			continue
+		}
+
+		b := Bookmark{
+			URL:         strings.TrimSpace(p.Href),
+			Title:       strings.TrimSpace(p.Description),
+			Description: strings.TrimSpace(p.Extended),
+			Tags:        splitTags(p.Tags, " "),
+		}
+
+		if t, err := time.Parse(time.RFC3339, p.Time); err == nil {
+			b.AddedAt = t.UTC()
+		}
+
+		res = append(res, b)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,115 @@
+// Package breaker реализует автоматический выключатель: после серии отказов вызовы к сервису
+// на время прекращаются, чтобы не копить зависшие запросы и дать сервису восстановиться.
+package breaker
+
+import (
+	"sync"
+	"time"
+)
+
+// State - состояние выключателя.
+type State int
+
+const (
+	Closed   State = iota // вызовы проходят
+	Open                  // вызовы отклоняются до истечения паузы
+	HalfOpen              // пауза истекла, пропускается один пробный вызов
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// Breaker размыкается после threshold отказов подряд и через openFor пропускает пробный вызов:
+// успех замыкает выключатель, отказ размыкает снова. Безопасен для одновременного использования.
+type Breaker struct {
+	threshold int
+	openFor   time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+}
+
+// New создает замкнутый выключатель. threshold меньше 1 считается равным 1.
+func New(threshold int, openFor time.Duration) *Breaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	return &Breaker{threshold: threshold, openFor: openFor, now: time.Now}
+}
+
+// Allow сообщает, можно ли выполнить вызов. Если нельзя, возвращает, через сколько стоит повторить.
+// Каждый разрешенный вызов должен закончиться Success, Failure или Cancel.
+func (b *Breaker) Allow() (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Open:
+		if wait := b.openedAt.Add(b.openFor).Sub(b.now()); wait > 0 {
+			return wait, false
+		}
+		b.state = HalfOpen
+		return 0, true
+	case HalfOpen:
+		// Пробный вызов еще выполняется, остальные ждут его результата
+		return b.openFor, false
+	default:
+		return 0, true
+	}
+}
+
+// Success отмечает успешный вызов.
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = Closed
+	b.failures = 0
+}
+
+// Failure отмечает отказ сервиса.
+func (b *Breaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.state == HalfOpen || b.failures >= b.threshold {
+		b.state = Open
+		b.openedAt = b.now()
+		b.failures = 0
+	}
+}
+
+// Cancel отмечает вызов, который ничего не сказал о сервисе, например отмененный клиентом.
+// Если это был пробный вызов, следующий вызов снова станет пробным.
+func (b *Breaker) Cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == HalfOpen {
+		b.state = Open
+		b.openedAt = b.now().Add(-b.openFor)
+	}
+}
+
+// State возвращает текущее состояние выключателя.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
@@ -0,0 +1,72 @@
+package breaker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := New(3, 10*time.Second)
+	b.now = func() time.Time { return now }
+
+	allow := func(want bool) time.Duration {
+		t.Helper()
+		wait, ok := b.Allow()
+		if ok != want {
+			t.Fatalf("Allow() = %v, want %v (state %s)", ok, want, b.State())
+		}
+		return wait
+	}
+
+	// Отказы вперемешку с успехами не размыкают выключатель
+	for i := 0; i < 5; i++ {
+		allow(true)
+		b.Failure()
+		allow(true)
+		b.Success()
+	}
+
+	for i := 0; i < 3; i++ {
+		allow(true)
+		b.Failure()
+	}
+	if got := b.State(); got != Open {
+		t.Fatalf("State() = %s after 3 failures, want open", got)
+	}
+
+	now = now.Add(4 * time.Second)
+	if wait := allow(false); wait != 6*time.Second {
+		t.Errorf("Allow() wait = %v, want 6s", wait)
+	}
+
+	// После паузы проходит только один пробный вызов
+	now = now.Add(6 * time.Second)
+	allow(true)
+	allow(false)
+
+	b.Failure()
+	if got := b.State(); got != Open {
+		t.Fatalf("State() = %s after failed probe, want open", got)
+	}
+
+	// Отмененная проба не оставляет выключатель в ожидании ее результата
+	now = now.Add(10 * time.Second)
+	allow(true)
+	b.Cancel()
+	allow(true)
+	b.Success()
+	if got := b.State(); got != Closed {
+		t.Fatalf("State() = %s after successful probe, want closed", got)
+	}
+	allow(true)
+}
+
+func TestNew_Threshold(t *testing.T) {
+	b := New(0, time.Second)
+	b.Failure()
+
+	if got := b.State(); got != Open {
+		t.Errorf("State() = %s after a failure with threshold 0, want open", got)
+	}
+}
@@ -0,0 +1,73 @@
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  *atomAuthor `xml:"author,omitempty"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Summary    string         `xml:"summary,omitempty"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// WriteAtom записывает ленту в формате Atom 1.0 (RFC 4287).
+func WriteAtom(w io.Writer, f Feed) error {
+	feed := atomFeed{
+		ID:      f.ID,
+		Title:   f.Title,
+		Updated: f.updated().Format(time.RFC3339),
+		Links:   []atomLink{{Rel: "self", Type: "application/atom+xml", Href: f.Self}},
+		Entries: make([]atomEntry, len(f.Items)),
+	}
+	// У записей нет своего автора, поэтому по RFC 4287 автор обязан быть у ленты
+	if f.Author != "" {
+		feed.Author = &atomAuthor{Name: f.Author}
+	}
+
+	for i, it := range f.Items {
+		entry := atomEntry{
+			ID:        it.ID,
+			Title:     it.title(),
+			Link:      atomLink{Rel: "alternate", Href: it.URL},
+			Published: it.Published.UTC().Format(time.RFC3339),
+			Updated:   it.updated().UTC().Format(time.RFC3339),
+			Summary:   it.Description,
+		}
+		for _, c := range it.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: c})
+		}
+		feed.Entries[i] = entry
+	}
+
+	return write(w, feed)
+}
@@ -0,0 +1,79 @@
+// Package feed формирует ленты Atom 1.0 и RSS 2.0 из списка ссылок.
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	ContentTypeAtom = "application/atom+xml; charset=utf-8"
+	ContentTypeRSS  = "application/rss+xml; charset=utf-8"
+)
+
+// Feed описывает ленту независимо от формата. ID и Self должны быть абсолютными URL.
+type Feed struct {
+	ID          string
+	Title       string
+	Description string
+	Self        string // адрес самой ленты
+	Author      string
+	Updated     time.Time // используется, если в ленте нет записей
+	Items       []Item
+}
+
+type Item struct {
+	ID          string // постоянный абсолютный URL записи
+	Title       string
+	URL         string
+	Description string
+	Categories  []string
+	Published   time.Time
+	Updated     time.Time
+}
+
+// updated возвращает дату последнего изменения ленты: самую позднюю дату среди записей.
+func (f Feed) updated() time.Time {
+	updated := f.Updated
+	for _, it := range f.Items {
+		if t := it.updated(); t.After(updated) {
+			updated = t
+		}
+	}
+
+	return updated.UTC()
+}
+
+func (it Item) updated() time.Time {
+	if it.Updated.After(it.Published) {
+		return it.Updated
+	}
+
+	return it.Published
+}
+
+func (it Item) title() string {
+	if it.Title != "" {
+		return it.Title
+	}
+
+	return it.URL
+}
+
+func write(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("xml Encode: %w", err)
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
@@ -0,0 +1,91 @@
+package feed
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+var testFeed = Feed{
+	ID:      "https://links.example.com/feeds/users/42.atom",
+	Title:   "Links of alice",
+	Self:    "https://links.example.com/feeds/users/42.atom",
+	Author:  "alice",
+	Updated: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	Items: []Item{
+		{
+			ID:          "https://links.example.com/api/v1/links/1",
+			Title:       "Go & <XML>",
+			URL:         "https://go.dev/?a=1&b=2",
+			Description: "The Go programming language",
+			Categories:  []string{"go", "lang"},
+			Published:   time.Date(2024, 3, 10, 12, 30, 0, 0, time.UTC),
+			Updated:     time.Date(2024, 3, 11, 8, 0, 0, 0, time.FixedZone("MSK", 3*60*60)),
+		},
+		{
+			ID:        "https://links.example.com/api/v1/links/2",
+			URL:       "https://example.com/",
+			Published: time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC),
+		},
+	},
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		write  func(*bytes.Buffer, Feed) error
+		feed   Feed
+	}{
+		{
+			name:   "test_atom",
+			golden: "atom.golden",
+			write:  func(b *bytes.Buffer, f Feed) error { return WriteAtom(b, f) },
+			feed:   testFeed,
+		},
+		{
+			name:   "test_rss",
+			golden: "rss.golden",
+			write:  func(b *bytes.Buffer, f Feed) error { return WriteRSS(b, f) },
+			feed:   testFeed,
+		},
+		{
+			name:   "test_atom_empty",
+			golden: "atom_empty.golden",
+			write:  func(b *bytes.Buffer, f Feed) error { return WriteAtom(b, f) },
+			feed:   Feed{ID: testFeed.ID, Title: testFeed.Title, Self: testFeed.Self, Author: "alice", Updated: testFeed.Updated},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var buf bytes.Buffer
+				if err := tt.write(&buf, tt.feed); err != nil {
+					t.Fatalf("write error = %v", err)
+				}
+
+				path := filepath.Join("testdata", tt.golden)
+				if *update {
+					if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+						t.Fatalf("WriteFile() error = %v", err)
+					}
+				}
+
+				want, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatalf("ReadFile() error = %v", err)
+				}
+
+				if got := buf.String(); got != string(want) {
+					t.Errorf("output mismatch\n got: %s\nwant: %s", got, want)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,74 @@
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Self          rssSelf   `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+// rssSelf - рекомендуемая валидаторами ссылка RSS-ленты на саму себя.
+type rssSelf struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description,omitempty"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// WriteRSS записывает ленту в формате RSS 2.0.
+func WriteRSS(w io.Writer, f Feed) error {
+	description := f.Description
+	if description == "" {
+		description = f.Title
+	}
+
+	channel := rssChannel{
+		Title:         f.Title,
+		Link:          f.Self,
+		Description:   description,
+		Self:          rssSelf{Href: f.Self, Rel: "self", Type: "application/rss+xml"},
+		LastBuildDate: f.updated().Format(time.RFC1123Z),
+		Items:         make([]rssItem, len(f.Items)),
+	}
+
+	for i, it := range f.Items {
+		channel.Items[i] = rssItem{
+			Title:       it.title(),
+			Link:        it.URL,
+			GUID:        rssGUID{Value: it.ID},
+			PubDate:     it.Published.UTC().Format(time.RFC1123Z),
+			Description: it.Description,
+			Categories:  it.Categories,
+		}
+	}
+
+	return write(w, rss{Version: "2.0", Atom: "http://www.w3.org/2005/Atom", Channel: channel})
+}
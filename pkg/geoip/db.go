@@ -0,0 +1,101 @@
+// Package geoip определяет страну по IP-адресу с помощью локального CSV-файла диапазонов
+// в формате "начало,конец,код страны", как в бесплатных базах DB-IP и IP2Location Lite.
+package geoip
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+)
+
+var ErrInvalidRange = errors.New("invalid ip range")
+
+type ipRange struct {
+	start   netip.Addr
+	end     netip.Addr
+	country string
+}
+
+// DB хранит отсортированные диапазоны адресов. Нулевой указатель допустим и ничего не находит,
+// поэтому без настроенного файла геолокацию можно просто не загружать.
+type DB struct {
+	ranges []ipRange
+}
+
+func Open(path string) (*DB, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("os Open: %w", err)
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+func Load(r io.Reader) (*DB, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	db := &DB{}
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("csv Read: %w", err)
+		}
+
+		if len(record) < 3 {
+			return nil, fmt.Errorf("line %d: %w", line, ErrInvalidRange)
+		}
+
+		start, errStart := netip.ParseAddr(strings.TrimSpace(record[0]))
+		end, errEnd := netip.ParseAddr(strings.TrimSpace(record[1]))
+		if errStart != nil || errEnd != nil || start.Is4() != end.Is4() || end.Less(start) {
+			// Первая строка может оказаться заголовком
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %w", line, ErrInvalidRange)
+		}
+
+		db.ranges = append(db.ranges, ipRange{
+			start:   start,
+			end:     end,
+			country: strings.ToUpper(strings.TrimSpace(record[2])),
+		})
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool { return db.ranges[i].start.Less(db.ranges[j].start) })
+
+	return db, nil
+}
+
+// Country возвращает код страны или пустую строку, если адрес не найден.
+func (db *DB) Country(addr netip.Addr) string {
+	if db == nil || !addr.IsValid() {
+		return ""
+	}
+
+	addr = addr.Unmap()
+
+	// Ищем последний диапазон, начинающийся не позже адреса
+	i := sort.Search(len(db.ranges), func(i int) bool { return addr.Less(db.ranges[i].start) }) - 1
+	if i < 0 {
+		return ""
+	}
+
+	r := db.ranges[i]
+	if r.start.Is4() != addr.Is4() || r.end.Less(addr) {
+		return ""
+	}
+
+	return r.country
+}
@@ -0,0 +1,73 @@
+package geoip
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func TestDB_Country(t *testing.T) {
+	db, err := Load(strings.NewReader(`ip_start,ip_end,country
+1.0.0.0,1.0.0.255,au
+5.255.255.0,5.255.255.255,RU
+2001:db8::,2001:db8::ffff,DE
+`))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		addr string
+		want string
+	}{
+		{
+			name: "test_ipv4",
+			addr: "1.0.0.17",
+			want: "AU",
+		},
+		{
+			name: "test_range_end",
+			addr: "5.255.255.255",
+			want: "RU",
+		},
+		{
+			name: "test_gap",
+			addr: "2.0.0.1",
+			want: "",
+		},
+		{
+			name: "test_ipv4_mapped",
+			addr: "::ffff:1.0.0.1",
+			want: "AU",
+		},
+		{
+			name: "test_ipv6",
+			addr: "2001:db8::1",
+			want: "DE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if got := db.Country(netip.MustParseAddr(tt.addr)); got != tt.want {
+					t.Errorf("Country() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestDB_CountryNil(t *testing.T) {
+	var db *DB
+	if got := db.Country(netip.MustParseAddr("1.0.0.1")); got != "" {
+		t.Errorf("Country() = %v, want empty", got)
+	}
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	if _, err := Load(strings.NewReader("1.0.0.0,1.0.0.255,AU\nbad,range,RU\n")); err == nil {
+		t.Error("Load() error = nil, want error")
+	}
+}
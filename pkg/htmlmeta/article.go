@@ -0,0 +1,271 @@
+package htmlmeta
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// WordsPerMinute средняя скорость чтения, по которой оценивается время чтения статьи.
+const WordsPerMinute = 200
+
+const minParagraphLen = 25
+
+var (
+	skipTags = map[string]bool{
+		"script": true, "style": true, "noscript": true, "nav": true, "header": true, "footer": true,
+		"aside": true, "form": true, "iframe": true, "svg": true, "button": true, "select": true,
+		"template": true, "object": true, "embed": true, "canvas": true, "menu": true,
+	}
+
+	blockTags = map[string]bool{
+		"p": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+		"li": true, "pre": true, "blockquote": true, "dd": true, "dt": true, "figcaption": true,
+		"td": true, "th": true,
+	}
+
+	boilerplateRe = regexp.MustCompile(
+		`(?i)(^|[\s_-])(nav|navbar|menu|footer|header|sidebar|side-bar|widget|ad|ads|advert|advertisement|` +
+			`banner|sponsor|promo|comment|comments|share|sharing|social|cookie|consent|popup|modal|related|` +
+			`breadcrumb|breadcrumbs|subscribe|newsletter|masthead|pagination)($|[\s_-])`,
+	)
+
+	spaceRe = regexp.MustCompile(`\s+`)
+)
+
+type Article struct {
+	Text        string        `json:"text"`
+	WordCount   int           `json:"word_count"`
+	ReadingTime time.Duration `json:"reading_time"`
+}
+
+// ExtractArticle вырезает из документа навигацию, рекламу и прочий шаблонный мусор
+// и возвращает основной текст статьи вместе с количеством слов и оценкой времени чтения.
+func ExtractArticle(ctx context.Context, r io.Reader) (*Article, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("html.Parse: %w", err)
+	}
+
+	if err := clean(ctx, doc); err != nil {
+		return nil, fmt.Errorf("clean: %w", err)
+	}
+
+	root := findByTag(doc, "article")
+	if root == nil {
+		root = findByTag(doc, "main")
+	}
+	if root == nil {
+		root = bestCandidate(doc)
+	}
+	if root == nil {
+		return &Article{}, nil
+	}
+
+	var blocks []string
+	collectBlocks(root, &blocks)
+	if len(blocks) == 0 {
+		if text := normalizeSpace(textContent(root)); text != "" {
+			blocks = append(blocks, text)
+		}
+	}
+
+	text := strings.Join(blocks, "\n\n")
+	words := len(strings.Fields(text))
+
+	return &Article{
+		Text:        text,
+		WordCount:   words,
+		ReadingTime: ReadingTime(words),
+	}, nil
+}
+
+// ReadingTime оценивает время чтения текста из words слов, округляя вверх до минуты.
+func ReadingTime(words int) time.Duration {
+	if words <= 0 {
+		return 0
+	}
+
+	minutes := math.Ceil(float64(words) / WordsPerMinute)
+
+	return time.Duration(minutes) * time.Minute
+}
+
+// clean удаляет из дерева узлы, которые заведомо не относятся к содержимому статьи.
+func clean(ctx context.Context, n *html.Node) error {
+	for c := n.FirstChild; c != nil; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		next := c.NextSibling
+		switch {
+		case c.Type == html.CommentNode:
+			n.RemoveChild(c)
+		case c.Type == html.ElementNode && (skipTags[c.Data] || isBoilerplate(c)):
+			n.RemoveChild(c)
+		default:
+			if err := clean(ctx, c); err != nil {
+				return err
+			}
+		}
+		c = next
+	}
+
+	return nil
+}
+
+func isBoilerplate(n *html.Node) bool {
+	if n.Data == "body" || n.Data == "html" || n.Data == "article" || n.Data == "main" {
+		return false
+	}
+
+	for _, attr := range n.Attr {
+		switch attr.Key {
+		case "class", "id":
+			if boilerplateRe.MatchString(attr.Val) {
+				return true
+			}
+		case "role":
+			switch strings.ToLower(attr.Val) {
+			case "navigation", "banner", "complementary", "contentinfo", "dialog":
+				return true
+			}
+		case "hidden":
+			return true
+		case "aria-hidden":
+			if attr.Val == "true" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func findByTag(n *html.Node, tag string) *html.Node {
+	if n.Type == html.ElementNode && n.Data == tag {
+		return n
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if found := findByTag(c, tag); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// bestCandidate выбирает контейнер с наибольшим количеством текста в абзацах,
+// штрафуя контейнеры, где большая часть текста находится в ссылках.
+func bestCandidate(doc *html.Node) *html.Node {
+	scores := make(map[*html.Node]float64)
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "p" && n.Parent != nil {
+			text := normalizeSpace(textContent(n))
+			if len(text) >= minParagraphLen {
+				score := 1 + float64(strings.Count(text, ",")) + math.Min(float64(len(text))/100, 3)
+				scores[n.Parent] += score
+				if gp := n.Parent.Parent; gp != nil {
+					scores[gp] += score / 2
+				}
+			}
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	var (
+		best      *html.Node
+		bestScore float64
+	)
+	for n, score := range scores {
+		score *= 1 - linkDensity(n)
+		if score > bestScore {
+			best, bestScore = n, score
+		}
+	}
+
+	if best == nil {
+		return findByTag(doc, "body")
+	}
+
+	return best
+}
+
+func linkDensity(n *html.Node) float64 {
+	total := len(normalizeSpace(textContent(n)))
+	if total == 0 {
+		return 0
+	}
+
+	var links int
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "a" {
+			links += len(normalizeSpace(textContent(n)))
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+
+	return float64(links) / float64(total)
+}
+
+func collectBlocks(n *html.Node, blocks *[]string) {
+	if n.Type == html.ElementNode && blockTags[n.Data] {
+		if text := normalizeSpace(textContent(n)); text != "" {
+			*blocks = append(*blocks, text)
+		}
+		return
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		collectBlocks(c, blocks)
+	}
+}
+
+func textContent(n *html.Node) string {
+	var sb strings.Builder
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			sb.WriteString(n.Data)
+		case html.ElementNode:
+			if n.Data == "br" {
+				sb.WriteString(" ")
+			}
+		default:
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+		if n.Type == html.ElementNode && blockTags[n.Data] {
+			sb.WriteString(" ")
+		}
+	}
+	walk(n)
+
+	return sb.String()
+}
+
+func normalizeSpace(s string) string {
+	return strings.TrimSpace(spaceRe.ReplaceAllString(s, " "))
+}
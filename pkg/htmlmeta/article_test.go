@@ -0,0 +1,104 @@
+package htmlmeta
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExtractArticle(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		contains    []string
+		notContains []string
+		wantWords   int
+	}{
+		{
+			name:      "test_empty_reader",
+			input:     "",
+			wantWords: 0,
+		},
+		{
+			name: "test_article_tag",
+			input: `<html>
+						<body>
+							<nav><a href="/">Home</a><a href="/about">About</a></nav>
+							<article>
+								<h1>Article title</h1>
+								<p>First paragraph of the article.</p>
+								<div class="share-buttons">Share on social networks</div>
+								<p>Second paragraph of the article.</p>
+							</article>
+							<footer>Copyright</footer>
+						</body>
+					</html>`,
+			contains:    []string{"Article title", "First paragraph of the article.", "Second paragraph of the article."},
+			notContains: []string{"Home", "Share on social networks", "Copyright"},
+			wantWords:   12,
+		},
+		{
+			name: "test_best_candidate",
+			input: `<html>
+						<body>
+							<div id="sidebar"><p>Sidebar text that is long enough to be a paragraph.</p></div>
+							<div class="links"><p><a href="/1">A link list that is long enough, but it is all links</a></p></div>
+							<div class="content">
+								<p>The main content is here, and it is long, with commas, and words.</p>
+								<p>Another paragraph of the main content, also long enough to count.</p>
+								<script>var tracking = true;</script>
+							</div>
+						</body>
+					</html>`,
+			contains:    []string{"The main content is here", "Another paragraph of the main content"},
+			notContains: []string{"Sidebar text", "A link list", "tracking"},
+			wantWords:   24,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := ExtractArticle(context.Background(), strings.NewReader(tt.input))
+				if err != nil {
+					t.Fatalf("ExtractArticle() error = %v", err)
+				}
+
+				for _, s := range tt.contains {
+					if !strings.Contains(got.Text, s) {
+						t.Errorf("ExtractArticle() text = %q, want it to contain %q", got.Text, s)
+					}
+				}
+
+				for _, s := range tt.notContains {
+					if strings.Contains(got.Text, s) {
+						t.Errorf("ExtractArticle() text = %q, want it not to contain %q", got.Text, s)
+					}
+				}
+
+				if got.WordCount != tt.wantWords {
+					t.Errorf("ExtractArticle() words = %v, want %v", got.WordCount, tt.wantWords)
+				}
+			},
+		)
+	}
+}
+
+func TestReadingTime(t *testing.T) {
+	tests := []struct {
+		words int
+		want  time.Duration
+	}{
+		{words: 0, want: 0},
+		{words: 1, want: time.Minute},
+		{words: WordsPerMinute, want: time.Minute},
+		{words: WordsPerMinute + 1, want: 2 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := ReadingTime(tt.words); got != tt.want {
+			t.Errorf("ReadingTime(%d) = %v, want %v", tt.words, got, tt.want)
+		}
+	}
+}
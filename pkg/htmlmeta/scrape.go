@@ -13,6 +13,7 @@ type Meta struct {
 	Title       string   `json:"title"`
 	Description string   `json:"description,omitempty"`
 	Tags        []string `json:"keywords,omitempty"`
+	Canonical   string   `json:"canonical,omitempty"` // адрес из <link rel="canonical">, как указан на странице
 }
 
 func Parse(ctx context.Context, r io.Reader) (*Meta, error) {
@@ -35,6 +36,8 @@ func traverse(ctx context.Context, n *html.Node, m *Meta) error {
 		switch n.Data {
 		case "meta":
 			parseMeta(m, n)
+		case "link":
+			parseLink(m, n)
 		case "title":
 			if n.Parent.Data == "head" {
 				m.Title = n.FirstChild.Data
@@ -43,7 +46,7 @@ func traverse(ctx context.Context, n *html.Node, m *Meta) error {
 		}
 	}
 
-	done := m.Title != "" && len(m.Tags) > 0 && m.Description != ""
+	done := m.Title != "" && len(m.Tags) > 0 && m.Description != "" && m.Canonical != ""
 	if done {
 		return nil
 	}
@@ -97,3 +100,23 @@ func parseMeta(m *Meta, n *html.Node) {
 	default:
 	}
 }
+
+func parseLink(m *Meta, n *html.Node) {
+	var (
+		href        string
+		isCanonical bool
+	)
+
+	for _, attr := range n.Attr {
+		switch attr.Key {
+		case "rel":
+			isCanonical = strings.EqualFold(strings.TrimSpace(attr.Val), "canonical")
+		case "href":
+			href = strings.TrimSpace(attr.Val)
+		}
+	}
+
+	if isCanonical && m.Canonical == "" {
+		m.Canonical = href
+	}
+}
@@ -36,6 +36,20 @@ func TestParse(t *testing.T) {
 				Tags:        []string{"keyword1", "keyword2", "keyword3"},
 			},
 		},
+		{
+			name: "test_with_canonical",
+			input: `<html>
+						<head>
+							<title>Test Title</title>
+							<link rel="stylesheet" href="/style.css">
+							<link rel="Canonical" href=" https://example.com/article ">
+						</head>
+					</html>`,
+			expected: Meta{
+				Title:     "Test Title",
+				Canonical: "https://example.com/article",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.15.8
+// source: imports.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ImportServiceClient is the client API for ImportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ImportServiceClient interface {
+	// ImportLinks принимает файл экспорта частями. user_id и format берутся из первого сообщения.
+	ImportLinks(ctx context.Context, opts ...grpc.CallOption) (ImportService_ImportLinksClient, error)
+	GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error)
+}
+
+type importServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImportServiceClient(cc grpc.ClientConnInterface) ImportServiceClient {
+	return &importServiceClient{cc}
+}
+
+func (c *importServiceClient) ImportLinks(ctx context.Context, opts ...grpc.CallOption) (ImportService_ImportLinksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ImportService_ServiceDesc.Streams[0], "/pb.ImportService/ImportLinks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &importServiceImportLinksClient{stream}
+	return x, nil
+}
+
+type ImportService_ImportLinksClient interface {
+	Send(*ImportLinksRequest) error
+	CloseAndRecv() (*ImportJob, error)
+	grpc.ClientStream
+}
+
+type importServiceImportLinksClient struct {
+	grpc.ClientStream
+}
+
+func (x *importServiceImportLinksClient) Send(m *ImportLinksRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *importServiceImportLinksClient) CloseAndRecv() (*ImportJob, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportJob)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *importServiceClient) GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, "/pb.ImportService/GetImportJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImportServiceServer is the server API for ImportService service.
+// All implementations must embed UnimplementedImportServiceServer
+// for forward compatibility
+type ImportServiceServer interface {
+	// ImportLinks принимает файл экспорта частями. user_id и format берутся из первого сообщения.
+	ImportLinks(ImportService_ImportLinksServer) error
+	GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error)
+	mustEmbedUnimplementedImportServiceServer()
+}
+
+// UnimplementedImportServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedImportServiceServer struct {
+}
+
+func (UnimplementedImportServiceServer) ImportLinks(ImportService_ImportLinksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportLinks not implemented")
+}
+func (UnimplementedImportServiceServer) GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportJob not implemented")
+}
+func (UnimplementedImportServiceServer) mustEmbedUnimplementedImportServiceServer() {}
+
+// UnsafeImportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImportServiceServer will
+// result in compilation errors.
+type UnsafeImportServiceServer interface {
+	mustEmbedUnimplementedImportServiceServer()
+}
+
+func RegisterImportServiceServer(s grpc.ServiceRegistrar, srv ImportServiceServer) {
+	s.RegisterService(&ImportService_ServiceDesc, srv)
+}
+
+func _ImportService_ImportLinks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ImportServiceServer).ImportLinks(&importServiceImportLinksServer{stream})
+}
+
+type ImportService_ImportLinksServer interface {
+	SendAndClose(*ImportJob) error
+	Recv() (*ImportLinksRequest, error)
+	grpc.ServerStream
+}
+
+type importServiceImportLinksServer struct {
+	grpc.ServerStream
+}
+
+func (x *importServiceImportLinksServer) SendAndClose(m *ImportJob) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *importServiceImportLinksServer) Recv() (*ImportLinksRequest, error) {
+	m := new(ImportLinksRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ImportService_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImportServiceServer).GetImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ImportService/GetImportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImportServiceServer).GetImportJob(ctx, req.(*GetImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ImportService_ServiceDesc is the grpc.ServiceDesc for ImportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ImportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ImportService",
+	HandlerType: (*ImportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetImportJob",
+			Handler:    _ImportService_GetImportJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportLinks",
+			Handler:       _ImportService_ImportLinks_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "imports.proto",
+}
@@ -22,12 +22,41 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LinkServiceClient interface {
-	CreateLink(ctx context.Context, in *CreateLinkRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateLink(ctx context.Context, in *CreateLinkRequest, opts ...grpc.CallOption) (*CreateLinkResponse, error)
 	GetLink(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*Link, error)
 	GetLinkByUserID(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (*ListLinkResponse, error)
 	UpdateLink(ctx context.Context, in *UpdateLinkRequest, opts ...grpc.CallOption) (*Empty, error)
 	DeleteLink(ctx context.Context, in *DeleteLinkRequest, opts ...grpc.CallOption) (*Empty, error)
 	ListLinks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListLinkResponse, error)
+	GetLinkContent(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*LinkContent, error)
+	ListSnapshots(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	GetSnapshot(ctx context.Context, in *GetSnapshotRequest, opts ...grpc.CallOption) (*SnapshotData, error)
+	FindLinks(ctx context.Context, in *FindLinksRequest, opts ...grpc.CallOption) (*ListLinkResponse, error)
+	ListTags(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*UpdateTagsResponse, error)
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*UpdateTagsResponse, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*UpdateTagsResponse, error)
+	// Отдает все ссылки пользователя по одной, не собирая их в один ответ
+	ExportLinks(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (LinkService_ExportLinksClient, error)
+	// Недавно сохраненные публичные ссылки всех пользователей
+	ListPublicLinks(ctx context.Context, in *ListPublicLinksRequest, opts ...grpc.CallOption) (*ListLinkResponse, error)
+	GetLinkSettings(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (*LinkSettings, error)
+	UpdateLinkSettings(ctx context.Context, in *LinkSettings, opts ...grpc.CallOption) (*LinkSettings, error)
+	// Меняет состояние списка ссылок пользователя: прочитана, избранная, в архиве
+	UpdateLinksState(ctx context.Context, in *UpdateLinksStateRequest, opts ...grpc.CallOption) (*UpdateLinksStateResponse, error)
+	CreateNote(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*Note, error)
+	ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (*ListNotesResponse, error)
+	UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*Note, error)
+	DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Корзина пользователя: удаленные ссылки, которые еще не очищены
+	ListDeletedLinks(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (*ListLinkResponse, error)
+	RestoreLink(ctx context.Context, in *RestoreLinkRequest, opts ...grpc.CallOption) (*Link, error)
+	// Ход очистки данных удаленного пользователя по событию user.deleted
+	GetUserCleanup(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (*UserCleanup, error)
+	// История правок ссылки, последние правки первыми
+	ListLinkRevisions(ctx context.Context, in *ListLinkRevisionsRequest, opts ...grpc.CallOption) (*ListLinkRevisionsResponse, error)
+	// Возвращает поля ссылки к выбранной ревизии, возврат сам становится новой правкой
+	RevertLink(ctx context.Context, in *RevertLinkRequest, opts ...grpc.CallOption) (*Link, error)
 }
 
 type linkServiceClient struct {
@@ -38,8 +67,8 @@ func NewLinkServiceClient(cc grpc.ClientConnInterface) LinkServiceClient {
 	return &linkServiceClient{cc}
 }
 
-func (c *linkServiceClient) CreateLink(ctx context.Context, in *CreateLinkRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
+func (c *linkServiceClient) CreateLink(ctx context.Context, in *CreateLinkRequest, opts ...grpc.CallOption) (*CreateLinkResponse, error) {
+	out := new(CreateLinkResponse)
 	err := c.cc.Invoke(ctx, "/pb.LinkService/CreateLink", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -92,16 +121,266 @@ func (c *linkServiceClient) ListLinks(ctx context.Context, in *Empty, opts ...gr
 	return out, nil
 }
 
+func (c *linkServiceClient) GetLinkContent(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*LinkContent, error) {
+	out := new(LinkContent)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/GetLinkContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) ListSnapshots(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) GetSnapshot(ctx context.Context, in *GetSnapshotRequest, opts ...grpc.CallOption) (*SnapshotData, error) {
+	out := new(SnapshotData)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/GetSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) FindLinks(ctx context.Context, in *FindLinksRequest, opts ...grpc.CallOption) (*ListLinkResponse, error) {
+	out := new(ListLinkResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/FindLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) ListTags(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*UpdateTagsResponse, error) {
+	out := new(UpdateTagsResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/RenameTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*UpdateTagsResponse, error) {
+	out := new(UpdateTagsResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/MergeTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*UpdateTagsResponse, error) {
+	out := new(UpdateTagsResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/DeleteTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) ExportLinks(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (LinkService_ExportLinksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LinkService_ServiceDesc.Streams[0], "/pb.LinkService/ExportLinks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &linkServiceExportLinksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LinkService_ExportLinksClient interface {
+	Recv() (*Link, error)
+	grpc.ClientStream
+}
+
+type linkServiceExportLinksClient struct {
+	grpc.ClientStream
+}
+
+func (x *linkServiceExportLinksClient) Recv() (*Link, error) {
+	m := new(Link)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *linkServiceClient) ListPublicLinks(ctx context.Context, in *ListPublicLinksRequest, opts ...grpc.CallOption) (*ListLinkResponse, error) {
+	out := new(ListLinkResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/ListPublicLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) GetLinkSettings(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (*LinkSettings, error) {
+	out := new(LinkSettings)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/GetLinkSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) UpdateLinkSettings(ctx context.Context, in *LinkSettings, opts ...grpc.CallOption) (*LinkSettings, error) {
+	out := new(LinkSettings)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/UpdateLinkSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) UpdateLinksState(ctx context.Context, in *UpdateLinksStateRequest, opts ...grpc.CallOption) (*UpdateLinksStateResponse, error) {
+	out := new(UpdateLinksStateResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/UpdateLinksState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) CreateNote(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*Note, error) {
+	out := new(Note)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/CreateNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (*ListNotesResponse, error) {
+	out := new(ListNotesResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/ListNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*Note, error) {
+	out := new(Note)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/UpdateNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/DeleteNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) ListDeletedLinks(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (*ListLinkResponse, error) {
+	out := new(ListLinkResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/ListDeletedLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) RestoreLink(ctx context.Context, in *RestoreLinkRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/RestoreLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) GetUserCleanup(ctx context.Context, in *GetLinksByUserId, opts ...grpc.CallOption) (*UserCleanup, error) {
+	out := new(UserCleanup)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/GetUserCleanup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) ListLinkRevisions(ctx context.Context, in *ListLinkRevisionsRequest, opts ...grpc.CallOption) (*ListLinkRevisionsResponse, error) {
+	out := new(ListLinkRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/ListLinkRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linkServiceClient) RevertLink(ctx context.Context, in *RevertLinkRequest, opts ...grpc.CallOption) (*Link, error) {
+	out := new(Link)
+	err := c.cc.Invoke(ctx, "/pb.LinkService/RevertLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LinkServiceServer is the server API for LinkService service.
 // All implementations must embed UnimplementedLinkServiceServer
 // for forward compatibility
 type LinkServiceServer interface {
-	CreateLink(context.Context, *CreateLinkRequest) (*Empty, error)
+	CreateLink(context.Context, *CreateLinkRequest) (*CreateLinkResponse, error)
 	GetLink(context.Context, *GetLinkRequest) (*Link, error)
 	GetLinkByUserID(context.Context, *GetLinksByUserId) (*ListLinkResponse, error)
 	UpdateLink(context.Context, *UpdateLinkRequest) (*Empty, error)
 	DeleteLink(context.Context, *DeleteLinkRequest) (*Empty, error)
 	ListLinks(context.Context, *Empty) (*ListLinkResponse, error)
+	GetLinkContent(context.Context, *GetLinkRequest) (*LinkContent, error)
+	ListSnapshots(context.Context, *GetLinkRequest) (*ListSnapshotsResponse, error)
+	GetSnapshot(context.Context, *GetSnapshotRequest) (*SnapshotData, error)
+	FindLinks(context.Context, *FindLinksRequest) (*ListLinkResponse, error)
+	ListTags(context.Context, *GetLinksByUserId) (*ListTagsResponse, error)
+	RenameTag(context.Context, *RenameTagRequest) (*UpdateTagsResponse, error)
+	MergeTags(context.Context, *MergeTagsRequest) (*UpdateTagsResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*UpdateTagsResponse, error)
+	// Отдает все ссылки пользователя по одной, не собирая их в один ответ
+	ExportLinks(*GetLinksByUserId, LinkService_ExportLinksServer) error
+	// Недавно сохраненные публичные ссылки всех пользователей
+	ListPublicLinks(context.Context, *ListPublicLinksRequest) (*ListLinkResponse, error)
+	GetLinkSettings(context.Context, *GetLinksByUserId) (*LinkSettings, error)
+	UpdateLinkSettings(context.Context, *LinkSettings) (*LinkSettings, error)
+	// Меняет состояние списка ссылок пользователя: прочитана, избранная, в архиве
+	UpdateLinksState(context.Context, *UpdateLinksStateRequest) (*UpdateLinksStateResponse, error)
+	CreateNote(context.Context, *CreateNoteRequest) (*Note, error)
+	ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error)
+	UpdateNote(context.Context, *UpdateNoteRequest) (*Note, error)
+	DeleteNote(context.Context, *DeleteNoteRequest) (*Empty, error)
+	// Корзина пользователя: удаленные ссылки, которые еще не очищены
+	ListDeletedLinks(context.Context, *GetLinksByUserId) (*ListLinkResponse, error)
+	RestoreLink(context.Context, *RestoreLinkRequest) (*Link, error)
+	// Ход очистки данных удаленного пользователя по событию user.deleted
+	GetUserCleanup(context.Context, *GetLinksByUserId) (*UserCleanup, error)
+	// История правок ссылки, последние правки первыми
+	ListLinkRevisions(context.Context, *ListLinkRevisionsRequest) (*ListLinkRevisionsResponse, error)
+	// Возвращает поля ссылки к выбранной ревизии, возврат сам становится новой правкой
+	RevertLink(context.Context, *RevertLinkRequest) (*Link, error)
 	mustEmbedUnimplementedLinkServiceServer()
 }
 
@@ -109,7 +388,7 @@ type LinkServiceServer interface {
 type UnimplementedLinkServiceServer struct {
 }
 
-func (UnimplementedLinkServiceServer) CreateLink(context.Context, *CreateLinkRequest) (*Empty, error) {
+func (UnimplementedLinkServiceServer) CreateLink(context.Context, *CreateLinkRequest) (*CreateLinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLink not implemented")
 }
 func (UnimplementedLinkServiceServer) GetLink(context.Context, *GetLinkRequest) (*Link, error) {
@@ -127,6 +406,72 @@ func (UnimplementedLinkServiceServer) DeleteLink(context.Context, *DeleteLinkReq
 func (UnimplementedLinkServiceServer) ListLinks(context.Context, *Empty) (*ListLinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
 }
+func (UnimplementedLinkServiceServer) GetLinkContent(context.Context, *GetLinkRequest) (*LinkContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkContent not implemented")
+}
+func (UnimplementedLinkServiceServer) ListSnapshots(context.Context, *GetLinkRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedLinkServiceServer) GetSnapshot(context.Context, *GetSnapshotRequest) (*SnapshotData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
+}
+func (UnimplementedLinkServiceServer) FindLinks(context.Context, *FindLinksRequest) (*ListLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindLinks not implemented")
+}
+func (UnimplementedLinkServiceServer) ListTags(context.Context, *GetLinksByUserId) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedLinkServiceServer) RenameTag(context.Context, *RenameTagRequest) (*UpdateTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedLinkServiceServer) MergeTags(context.Context, *MergeTagsRequest) (*UpdateTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedLinkServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*UpdateTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedLinkServiceServer) ExportLinks(*GetLinksByUserId, LinkService_ExportLinksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportLinks not implemented")
+}
+func (UnimplementedLinkServiceServer) ListPublicLinks(context.Context, *ListPublicLinksRequest) (*ListLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicLinks not implemented")
+}
+func (UnimplementedLinkServiceServer) GetLinkSettings(context.Context, *GetLinksByUserId) (*LinkSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkSettings not implemented")
+}
+func (UnimplementedLinkServiceServer) UpdateLinkSettings(context.Context, *LinkSettings) (*LinkSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLinkSettings not implemented")
+}
+func (UnimplementedLinkServiceServer) UpdateLinksState(context.Context, *UpdateLinksStateRequest) (*UpdateLinksStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLinksState not implemented")
+}
+func (UnimplementedLinkServiceServer) CreateNote(context.Context, *CreateNoteRequest) (*Note, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNote not implemented")
+}
+func (UnimplementedLinkServiceServer) ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotes not implemented")
+}
+func (UnimplementedLinkServiceServer) UpdateNote(context.Context, *UpdateNoteRequest) (*Note, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNote not implemented")
+}
+func (UnimplementedLinkServiceServer) DeleteNote(context.Context, *DeleteNoteRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNote not implemented")
+}
+func (UnimplementedLinkServiceServer) ListDeletedLinks(context.Context, *GetLinksByUserId) (*ListLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedLinks not implemented")
+}
+func (UnimplementedLinkServiceServer) RestoreLink(context.Context, *RestoreLinkRequest) (*Link, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreLink not implemented")
+}
+func (UnimplementedLinkServiceServer) GetUserCleanup(context.Context, *GetLinksByUserId) (*UserCleanup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserCleanup not implemented")
+}
+func (UnimplementedLinkServiceServer) ListLinkRevisions(context.Context, *ListLinkRevisionsRequest) (*ListLinkRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLinkRevisions not implemented")
+}
+func (UnimplementedLinkServiceServer) RevertLink(context.Context, *RevertLinkRequest) (*Link, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertLink not implemented")
+}
 func (UnimplementedLinkServiceServer) mustEmbedUnimplementedLinkServiceServer() {}
 
 // UnsafeLinkServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -248,6 +593,405 @@ func _LinkService_ListLinks_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LinkService_GetLinkContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).GetLinkContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/GetLinkContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).GetLinkContent(ctx, req.(*GetLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).ListSnapshots(ctx, req.(*GetLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_GetSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).GetSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/GetSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).GetSnapshot(ctx, req.(*GetSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_FindLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).FindLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/FindLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).FindLinks(ctx, req.(*FindLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinksByUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).ListTags(ctx, req.(*GetLinksByUserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/RenameTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/MergeTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/DeleteTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_ExportLinks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLinksByUserId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LinkServiceServer).ExportLinks(m, &linkServiceExportLinksServer{stream})
+}
+
+type LinkService_ExportLinksServer interface {
+	Send(*Link) error
+	grpc.ServerStream
+}
+
+type linkServiceExportLinksServer struct {
+	grpc.ServerStream
+}
+
+func (x *linkServiceExportLinksServer) Send(m *Link) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LinkService_ListPublicLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublicLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).ListPublicLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/ListPublicLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).ListPublicLinks(ctx, req.(*ListPublicLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_GetLinkSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinksByUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).GetLinkSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/GetLinkSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).GetLinkSettings(ctx, req.(*GetLinksByUserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_UpdateLinkSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).UpdateLinkSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/UpdateLinkSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).UpdateLinkSettings(ctx, req.(*LinkSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_UpdateLinksState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLinksStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).UpdateLinksState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/UpdateLinksState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).UpdateLinksState(ctx, req.(*UpdateLinksStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_CreateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).CreateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/CreateNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).CreateNote(ctx, req.(*CreateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_ListNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).ListNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/ListNotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).ListNotes(ctx, req.(*ListNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_UpdateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).UpdateNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/UpdateNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).UpdateNote(ctx, req.(*UpdateNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).DeleteNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/DeleteNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).DeleteNote(ctx, req.(*DeleteNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_ListDeletedLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinksByUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).ListDeletedLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/ListDeletedLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).ListDeletedLinks(ctx, req.(*GetLinksByUserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_RestoreLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).RestoreLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/RestoreLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).RestoreLink(ctx, req.(*RestoreLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_GetUserCleanup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinksByUserId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).GetUserCleanup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/GetUserCleanup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).GetUserCleanup(ctx, req.(*GetLinksByUserId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_ListLinkRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinkRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).ListLinkRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/ListLinkRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).ListLinkRevisions(ctx, req.(*ListLinkRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinkService_RevertLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinkServiceServer).RevertLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.LinkService/RevertLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinkServiceServer).RevertLink(ctx, req.(*RevertLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LinkService_ServiceDesc is the grpc.ServiceDesc for LinkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,7 +1023,97 @@ var LinkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLinks",
 			Handler:    _LinkService_ListLinks_Handler,
 		},
+		{
+			MethodName: "GetLinkContent",
+			Handler:    _LinkService_GetLinkContent_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _LinkService_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "GetSnapshot",
+			Handler:    _LinkService_GetSnapshot_Handler,
+		},
+		{
+			MethodName: "FindLinks",
+			Handler:    _LinkService_FindLinks_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _LinkService_ListTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _LinkService_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _LinkService_MergeTags_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _LinkService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "ListPublicLinks",
+			Handler:    _LinkService_ListPublicLinks_Handler,
+		},
+		{
+			MethodName: "GetLinkSettings",
+			Handler:    _LinkService_GetLinkSettings_Handler,
+		},
+		{
+			MethodName: "UpdateLinkSettings",
+			Handler:    _LinkService_UpdateLinkSettings_Handler,
+		},
+		{
+			MethodName: "UpdateLinksState",
+			Handler:    _LinkService_UpdateLinksState_Handler,
+		},
+		{
+			MethodName: "CreateNote",
+			Handler:    _LinkService_CreateNote_Handler,
+		},
+		{
+			MethodName: "ListNotes",
+			Handler:    _LinkService_ListNotes_Handler,
+		},
+		{
+			MethodName: "UpdateNote",
+			Handler:    _LinkService_UpdateNote_Handler,
+		},
+		{
+			MethodName: "DeleteNote",
+			Handler:    _LinkService_DeleteNote_Handler,
+		},
+		{
+			MethodName: "ListDeletedLinks",
+			Handler:    _LinkService_ListDeletedLinks_Handler,
+		},
+		{
+			MethodName: "RestoreLink",
+			Handler:    _LinkService_RestoreLink_Handler,
+		},
+		{
+			MethodName: "GetUserCleanup",
+			Handler:    _LinkService_GetUserCleanup_Handler,
+		},
+		{
+			MethodName: "ListLinkRevisions",
+			Handler:    _LinkService_ListLinkRevisions_Handler,
+		},
+		{
+			MethodName: "RevertLink",
+			Handler:    _LinkService_RevertLink_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportLinks",
+			Handler:       _LinkService_ExportLinks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "links.proto",
 }
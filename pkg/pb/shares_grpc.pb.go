@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.15.8
+// source: shares.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ShareServiceClient is the client API for ShareService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShareServiceClient interface {
+	CreateShare(ctx context.Context, in *CreateShareRequest, opts ...grpc.CallOption) (*Share, error)
+	ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error)
+	RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*Share, error)
+	OpenShare(ctx context.Context, in *OpenShareRequest, opts ...grpc.CallOption) (*SharedContent, error)
+}
+
+type shareServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShareServiceClient(cc grpc.ClientConnInterface) ShareServiceClient {
+	return &shareServiceClient{cc}
+}
+
+func (c *shareServiceClient) CreateShare(ctx context.Context, in *CreateShareRequest, opts ...grpc.CallOption) (*Share, error) {
+	out := new(Share)
+	err := c.cc.Invoke(ctx, "/pb.ShareService/CreateShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareServiceClient) ListShares(ctx context.Context, in *ListSharesRequest, opts ...grpc.CallOption) (*ListSharesResponse, error) {
+	out := new(ListSharesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ShareService/ListShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareServiceClient) RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*Share, error) {
+	out := new(Share)
+	err := c.cc.Invoke(ctx, "/pb.ShareService/RevokeShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareServiceClient) OpenShare(ctx context.Context, in *OpenShareRequest, opts ...grpc.CallOption) (*SharedContent, error) {
+	out := new(SharedContent)
+	err := c.cc.Invoke(ctx, "/pb.ShareService/OpenShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShareServiceServer is the server API for ShareService service.
+// All implementations must embed UnimplementedShareServiceServer
+// for forward compatibility
+type ShareServiceServer interface {
+	CreateShare(context.Context, *CreateShareRequest) (*Share, error)
+	ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error)
+	RevokeShare(context.Context, *RevokeShareRequest) (*Share, error)
+	OpenShare(context.Context, *OpenShareRequest) (*SharedContent, error)
+	mustEmbedUnimplementedShareServiceServer()
+}
+
+// UnimplementedShareServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedShareServiceServer struct {
+}
+
+func (UnimplementedShareServiceServer) CreateShare(context.Context, *CreateShareRequest) (*Share, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShare not implemented")
+}
+func (UnimplementedShareServiceServer) ListShares(context.Context, *ListSharesRequest) (*ListSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShares not implemented")
+}
+func (UnimplementedShareServiceServer) RevokeShare(context.Context, *RevokeShareRequest) (*Share, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShare not implemented")
+}
+func (UnimplementedShareServiceServer) OpenShare(context.Context, *OpenShareRequest) (*SharedContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenShare not implemented")
+}
+func (UnimplementedShareServiceServer) mustEmbedUnimplementedShareServiceServer() {}
+
+// UnsafeShareServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShareServiceServer will
+// result in compilation errors.
+type UnsafeShareServiceServer interface {
+	mustEmbedUnimplementedShareServiceServer()
+}
+
+func RegisterShareServiceServer(s grpc.ServiceRegistrar, srv ShareServiceServer) {
+	s.RegisterService(&ShareService_ServiceDesc, srv)
+}
+
+func _ShareService_CreateShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).CreateShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ShareService/CreateShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).CreateShare(ctx, req.(*CreateShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShareService_ListShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).ListShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ShareService/ListShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).ListShares(ctx, req.(*ListSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShareService_RevokeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).RevokeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ShareService/RevokeShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).RevokeShare(ctx, req.(*RevokeShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShareService_OpenShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).OpenShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ShareService/OpenShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).OpenShare(ctx, req.(*OpenShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShareService_ServiceDesc is the grpc.ServiceDesc for ShareService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShareService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ShareService",
+	HandlerType: (*ShareServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateShare",
+			Handler:    _ShareService_CreateShare_Handler,
+		},
+		{
+			MethodName: "ListShares",
+			Handler:    _ShareService_ListShares_Handler,
+		},
+		{
+			MethodName: "RevokeShare",
+			Handler:    _ShareService_RevokeShare_Handler,
+		},
+		{
+			MethodName: "OpenShare",
+			Handler:    _ShareService_OpenShare_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shares.proto",
+}
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.15.8
+// source: shortener.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ShortenerServiceClient is the client API for ShortenerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShortenerServiceClient interface {
+	SetLinkSlug(ctx context.Context, in *SetLinkSlugRequest, opts ...grpc.CallOption) (*LinkSlug, error)
+	ResolveSlug(ctx context.Context, in *ResolveSlugRequest, opts ...grpc.CallOption) (*ResolveSlugResponse, error)
+	GetLinkStats(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*LinkStats, error)
+}
+
+type shortenerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShortenerServiceClient(cc grpc.ClientConnInterface) ShortenerServiceClient {
+	return &shortenerServiceClient{cc}
+}
+
+func (c *shortenerServiceClient) SetLinkSlug(ctx context.Context, in *SetLinkSlugRequest, opts ...grpc.CallOption) (*LinkSlug, error) {
+	out := new(LinkSlug)
+	err := c.cc.Invoke(ctx, "/pb.ShortenerService/SetLinkSlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortenerServiceClient) ResolveSlug(ctx context.Context, in *ResolveSlugRequest, opts ...grpc.CallOption) (*ResolveSlugResponse, error) {
+	out := new(ResolveSlugResponse)
+	err := c.cc.Invoke(ctx, "/pb.ShortenerService/ResolveSlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortenerServiceClient) GetLinkStats(ctx context.Context, in *GetLinkRequest, opts ...grpc.CallOption) (*LinkStats, error) {
+	out := new(LinkStats)
+	err := c.cc.Invoke(ctx, "/pb.ShortenerService/GetLinkStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShortenerServiceServer is the server API for ShortenerService service.
+// All implementations must embed UnimplementedShortenerServiceServer
+// for forward compatibility
+type ShortenerServiceServer interface {
+	SetLinkSlug(context.Context, *SetLinkSlugRequest) (*LinkSlug, error)
+	ResolveSlug(context.Context, *ResolveSlugRequest) (*ResolveSlugResponse, error)
+	GetLinkStats(context.Context, *GetLinkRequest) (*LinkStats, error)
+	mustEmbedUnimplementedShortenerServiceServer()
+}
+
+// UnimplementedShortenerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedShortenerServiceServer struct {
+}
+
+func (UnimplementedShortenerServiceServer) SetLinkSlug(context.Context, *SetLinkSlugRequest) (*LinkSlug, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLinkSlug not implemented")
+}
+func (UnimplementedShortenerServiceServer) ResolveSlug(context.Context, *ResolveSlugRequest) (*ResolveSlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSlug not implemented")
+}
+func (UnimplementedShortenerServiceServer) GetLinkStats(context.Context, *GetLinkRequest) (*LinkStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkStats not implemented")
+}
+func (UnimplementedShortenerServiceServer) mustEmbedUnimplementedShortenerServiceServer() {}
+
+// UnsafeShortenerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShortenerServiceServer will
+// result in compilation errors.
+type UnsafeShortenerServiceServer interface {
+	mustEmbedUnimplementedShortenerServiceServer()
+}
+
+func RegisterShortenerServiceServer(s grpc.ServiceRegistrar, srv ShortenerServiceServer) {
+	s.RegisterService(&ShortenerService_ServiceDesc, srv)
+}
+
+func _ShortenerService_SetLinkSlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLinkSlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortenerServiceServer).SetLinkSlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ShortenerService/SetLinkSlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortenerServiceServer).SetLinkSlug(ctx, req.(*SetLinkSlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortenerService_ResolveSlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveSlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortenerServiceServer).ResolveSlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ShortenerService/ResolveSlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortenerServiceServer).ResolveSlug(ctx, req.(*ResolveSlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortenerService_GetLinkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortenerServiceServer).GetLinkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ShortenerService/GetLinkStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortenerServiceServer).GetLinkStats(ctx, req.(*GetLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShortenerService_ServiceDesc is the grpc.ServiceDesc for ShortenerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShortenerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ShortenerService",
+	HandlerType: (*ShortenerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetLinkSlug",
+			Handler:    _ShortenerService_SetLinkSlug_Handler,
+		},
+		{
+			MethodName: "ResolveSlug",
+			Handler:    _ShortenerService_ResolveSlug_Handler,
+		},
+		{
+			MethodName: "GetLinkStats",
+			Handler:    _ShortenerService_GetLinkStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shortener.proto",
+}
@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.15.8
+// source: userexports.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// UserExportServiceClient is the client API for UserExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserExportServiceClient interface {
+	// Ставит выгрузку в очередь. Запись пользователя передает вызывающий: links-srv не хранит пользователей
+	CreateUserExport(ctx context.Context, in *CreateUserExportRequest, opts ...grpc.CallOption) (*UserExport, error)
+	// Последняя выгрузка пользователя
+	GetUserExport(ctx context.Context, in *GetUserExportRequest, opts ...grpc.CallOption) (*UserExport, error)
+	// Отдает готовый ZIP-архив последней выгрузки частями
+	DownloadUserExport(ctx context.Context, in *GetUserExportRequest, opts ...grpc.CallOption) (UserExportService_DownloadUserExportClient, error)
+}
+
+type userExportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserExportServiceClient(cc grpc.ClientConnInterface) UserExportServiceClient {
+	return &userExportServiceClient{cc}
+}
+
+func (c *userExportServiceClient) CreateUserExport(ctx context.Context, in *CreateUserExportRequest, opts ...grpc.CallOption) (*UserExport, error) {
+	out := new(UserExport)
+	err := c.cc.Invoke(ctx, "/pb.UserExportService/CreateUserExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExportServiceClient) GetUserExport(ctx context.Context, in *GetUserExportRequest, opts ...grpc.CallOption) (*UserExport, error) {
+	out := new(UserExport)
+	err := c.cc.Invoke(ctx, "/pb.UserExportService/GetUserExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userExportServiceClient) DownloadUserExport(ctx context.Context, in *GetUserExportRequest, opts ...grpc.CallOption) (UserExportService_DownloadUserExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UserExportService_ServiceDesc.Streams[0], "/pb.UserExportService/DownloadUserExport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userExportServiceDownloadUserExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserExportService_DownloadUserExportClient interface {
+	Recv() (*UserExportChunk, error)
+	grpc.ClientStream
+}
+
+type userExportServiceDownloadUserExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *userExportServiceDownloadUserExportClient) Recv() (*UserExportChunk, error) {
+	m := new(UserExportChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UserExportServiceServer is the server API for UserExportService service.
+// All implementations must embed UnimplementedUserExportServiceServer
+// for forward compatibility
+type UserExportServiceServer interface {
+	// Ставит выгрузку в очередь. Запись пользователя передает вызывающий: links-srv не хранит пользователей
+	CreateUserExport(context.Context, *CreateUserExportRequest) (*UserExport, error)
+	// Последняя выгрузка пользователя
+	GetUserExport(context.Context, *GetUserExportRequest) (*UserExport, error)
+	// Отдает готовый ZIP-архив последней выгрузки частями
+	DownloadUserExport(*GetUserExportRequest, UserExportService_DownloadUserExportServer) error
+	mustEmbedUnimplementedUserExportServiceServer()
+}
+
+// UnimplementedUserExportServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUserExportServiceServer struct {
+}
+
+func (UnimplementedUserExportServiceServer) CreateUserExport(context.Context, *CreateUserExportRequest) (*UserExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserExport not implemented")
+}
+func (UnimplementedUserExportServiceServer) GetUserExport(context.Context, *GetUserExportRequest) (*UserExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserExport not implemented")
+}
+func (UnimplementedUserExportServiceServer) DownloadUserExport(*GetUserExportRequest, UserExportService_DownloadUserExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadUserExport not implemented")
+}
+func (UnimplementedUserExportServiceServer) mustEmbedUnimplementedUserExportServiceServer() {}
+
+// UnsafeUserExportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserExportServiceServer will
+// result in compilation errors.
+type UnsafeUserExportServiceServer interface {
+	mustEmbedUnimplementedUserExportServiceServer()
+}
+
+func RegisterUserExportServiceServer(s grpc.ServiceRegistrar, srv UserExportServiceServer) {
+	s.RegisterService(&UserExportService_ServiceDesc, srv)
+}
+
+func _UserExportService_CreateUserExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExportServiceServer).CreateUserExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.UserExportService/CreateUserExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExportServiceServer).CreateUserExport(ctx, req.(*CreateUserExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExportService_GetUserExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserExportServiceServer).GetUserExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.UserExportService/GetUserExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserExportServiceServer).GetUserExport(ctx, req.(*GetUserExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserExportService_DownloadUserExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetUserExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserExportServiceServer).DownloadUserExport(m, &userExportServiceDownloadUserExportServer{stream})
+}
+
+type UserExportService_DownloadUserExportServer interface {
+	Send(*UserExportChunk) error
+	grpc.ServerStream
+}
+
+type userExportServiceDownloadUserExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *userExportServiceDownloadUserExportServer) Send(m *UserExportChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// UserExportService_ServiceDesc is the grpc.ServiceDesc for UserExportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserExportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.UserExportService",
+	HandlerType: (*UserExportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUserExport",
+			Handler:    _UserExportService_CreateUserExport_Handler,
+		},
+		{
+			MethodName: "GetUserExport",
+			Handler:    _UserExportService_GetUserExport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadUserExport",
+			Handler:       _UserExportService_DownloadUserExport_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "userexports.proto",
+}
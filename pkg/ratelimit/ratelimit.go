@@ -0,0 +1,125 @@
+// Package ratelimit ограничивает частоту запросов по алгоритму token bucket.
+// Состояние корзин хранит Store: в памяти процесса или, когда понадобится, в общем хранилище
+// для нескольких экземпляров api-gw.
+package ratelimit
+
+import (
+	"container/list"
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit разрешает Requests запросов за Per. Корзина вмещает Requests токенов и равномерно
+// пополняется за Per, так что короткий всплеск до Requests запросов тоже допустим.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+func (l Limit) rate() float64 {
+	return float64(l.Requests) / l.Per.Seconds()
+}
+
+// Result - решение по запросу и состояние корзины после него.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration // через сколько корзина наполнится полностью
+	RetryAfter time.Duration // через сколько появится токен, если запрос отклонен
+}
+
+// Store хранит корзины по ключам. Take забирает токен из корзины key, если он есть.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+var _ Store = (*Memory)(nil)
+
+// sweepEvery - как часто Memory удаляет полные корзины, по которым давно не было запросов.
+const sweepEvery = time.Minute
+
+// Memory хранит корзины в памяти процесса. Подходит для одного экземпляра api-gw.
+type Memory struct {
+	now     func() time.Time
+	maxKeys int
+
+	mu        sync.Mutex
+	buckets   map[string]*list.Element // значения - *bucket
+	recent    *list.List               // корзины от последней использованной к самой давней
+	lastSweep time.Time
+}
+
+type bucket struct {
+	key     string
+	tokens  float64
+	updated time.Time
+	per     time.Duration
+}
+
+// NewMemory создает пустое хранилище не больше чем на maxKeys корзин. Когда места нет, вытесняется
+// корзина, к которой дольше всего не обращались: клиентов, которые шлют запросы с множества адресов,
+// нельзя считать всех сразу, но и память api-gw они не исчерпают.
+func NewMemory(maxKeys int) *Memory {
+	return &Memory{now: time.Now, maxKeys: maxKeys, buckets: make(map[string]*list.Element), recent: list.New()}
+}
+
+func (m *Memory) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	capacity := float64(limit.Requests)
+	var b *bucket
+	if e, ok := m.buckets[key]; ok {
+		m.recent.MoveToFront(e)
+		b = e.Value.(*bucket)
+	} else {
+		if m.maxKeys > 0 && m.recent.Len() >= m.maxKeys {
+			m.remove(m.recent.Back())
+		}
+		b = &bucket{key: key, tokens: capacity, updated: now}
+		m.buckets[key] = m.recent.PushFront(b)
+	}
+	b.per = limit.Per
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.updated).Seconds()*limit.rate())
+	b.updated = now
+
+	res := Result{Limit: limit.Requests}
+	if b.tokens >= 1 {
+		b.tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = seconds((1 - b.tokens) / limit.rate())
+	}
+	res.Remaining = int(b.tokens)
+	res.Reset = seconds((capacity - b.tokens) / limit.rate())
+
+	return res, nil
+}
+
+// sweep удаляет корзины, которые успели наполниться: они не отличаются от новых.
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepEvery {
+		return
+	}
+	m.lastSweep = now
+
+	for _, e := range m.buckets {
+		if b := e.Value.(*bucket); now.Sub(b.updated) >= b.per {
+			m.remove(e)
+		}
+	}
+}
+
+func (m *Memory) remove(e *list.Element) {
+	delete(m.buckets, m.recent.Remove(e).(*bucket).key)
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
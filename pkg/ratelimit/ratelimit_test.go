@@ -0,0 +1,87 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemory_Take(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := NewMemory(10)
+	m.now = func() time.Time { return now }
+
+	limit := Limit{Requests: 3, Per: 3 * time.Second}
+
+	for i := 2; i >= 0; i-- {
+		res, err := m.Take(ctx, "user:1", limit)
+		if err != nil {
+			t.Fatalf("Take() error = %v", err)
+		}
+		if !res.Allowed || res.Remaining != i {
+			t.Fatalf("Take() = %+v, want allowed with %d remaining", res, i)
+		}
+	}
+
+	res, _ := m.Take(ctx, "user:1", limit)
+	if res.Allowed {
+		t.Fatal("Take() allowed a request over the limit")
+	}
+	if res.RetryAfter != time.Second || res.Reset != 3*time.Second {
+		t.Errorf("Take() RetryAfter = %v, Reset = %v, want 1s and 3s", res.RetryAfter, res.Reset)
+	}
+
+	// Другой ключ расходует свою корзину
+	if res, _ := m.Take(ctx, "user:2", limit); !res.Allowed {
+		t.Error("Take() denied a request of another key")
+	}
+
+	// За секунду корзина пополняется на один токен
+	now = now.Add(time.Second)
+	if res, _ := m.Take(ctx, "user:1", limit); !res.Allowed || res.Remaining != 0 {
+		t.Errorf("Take() = %+v after refill, want allowed with 0 remaining", res)
+	}
+}
+
+func TestMemory_Sweep(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := NewMemory(10)
+	m.now = func() time.Time { return now }
+
+	m.Take(ctx, "ip:10.0.0.1", Limit{Requests: 10, Per: time.Second})
+	m.Take(ctx, "ip:10.0.0.2", Limit{Requests: 10, Per: time.Hour})
+
+	now = now.Add(2 * sweepEvery)
+	m.Take(ctx, "ip:10.0.0.3", Limit{Requests: 10, Per: time.Second})
+
+	if _, ok := m.buckets["ip:10.0.0.1"]; ok {
+		t.Error("full bucket was not swept")
+	}
+	if _, ok := m.buckets["ip:10.0.0.2"]; !ok {
+		t.Error("bucket that is still refilling was swept")
+	}
+}
+
+func TestMemory_MaxKeys(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory(2)
+	limit := Limit{Requests: 1, Per: time.Hour}
+
+	m.Take(ctx, "ip:10.0.0.1", limit)
+	m.Take(ctx, "ip:10.0.0.2", limit)
+	// Обращение к первой корзине делает самой давней вторую, ее и вытесняет третья
+	m.Take(ctx, "ip:10.0.0.1", limit)
+	m.Take(ctx, "ip:10.0.0.3", limit)
+
+	if len(m.buckets) != 2 || m.recent.Len() != 2 {
+		t.Fatalf("Memory holds %d buckets, want 2", len(m.buckets))
+	}
+	if _, ok := m.buckets["ip:10.0.0.2"]; ok {
+		t.Error("least recently used bucket was not evicted")
+	}
+	if _, ok := m.buckets["ip:10.0.0.1"]; !ok {
+		t.Error("recently used bucket was evicted")
+	}
+}
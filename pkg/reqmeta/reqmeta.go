@@ -0,0 +1,179 @@
+// Package reqmeta переносит сведения об HTTP запросе из api-gw в сервисы через метаданные gRPC:
+// ID запроса для сквозного поиска в логах, адрес клиента и пользователя, от имени которого выполняется запрос.
+package reqmeta
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	HeaderRequestID = "X-Request-ID"
+	HeaderActorID   = "X-User-ID"
+	// HeaderAdminToken - общий секрет, которым оператор подтверждает запросы администраторов
+	HeaderAdminToken = "X-Admin-Token"
+
+	keyRequestID  = "x-request-id"
+	keyClientIP   = "x-forwarded-for"
+	keyActorID    = "x-actor-id"
+	keyAdminToken = "x-admin-token"
+)
+
+// Info - сведения о запросе. Пустые поля не передаются.
+type Info struct {
+	RequestID  string
+	ClientIP   string
+	ActorID    string
+	AdminToken string
+}
+
+// FromHTTP собирает сведения о входящем HTTP запросе. Если клиент не прислал ID запроса, он генерируется.
+// Адрес клиента определяет proxies.ClientIP.
+func FromHTTP(r *http.Request, proxies Proxies) Info {
+	info := Info{
+		RequestID:  strings.TrimSpace(r.Header.Get(HeaderRequestID)),
+		ClientIP:   proxies.ClientIP(r),
+		ActorID:    strings.TrimSpace(r.Header.Get(HeaderActorID)),
+		AdminToken: strings.TrimSpace(r.Header.Get(HeaderAdminToken)),
+	}
+	if info.RequestID == "" {
+		info.RequestID = uuid.NewString()
+	}
+
+	return info
+}
+
+// Proxies - адреса и подсети балансировщиков перед api-gw. Только их X-Forwarded-For говорит об адресе клиента.
+type Proxies []netip.Prefix
+
+// ClientIP возвращает адрес соединения, а если оно пришло от доверенного балансировщика - ближайший
+// к api-gw адрес из X-Forwarded-For, который не принадлежит балансировщикам. Левые адреса списка
+// дописывает сам клиент, поэтому им верить нельзя.
+func (p Proxies) ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !p.trusted(host) {
+		return host
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		if !p.trusted(hop) {
+			return hop
+		}
+		host = hop
+	}
+
+	return host
+}
+
+func (p Proxies) trusted(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, prefix := range p {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AppendOutgoing добавляет сведения в исходящие метаданные gRPC вызовов, сделанных с этим контекстом.
+func (i Info) AppendOutgoing(ctx context.Context) context.Context {
+	kv := make([]string, 0, 8)
+	for _, pair := range [][2]string{
+		{keyRequestID, i.RequestID}, {keyClientIP, i.ClientIP}, {keyActorID, i.ActorID}, {keyAdminToken, i.AdminToken},
+	} {
+		if pair[1] != "" {
+			kv = append(kv, pair[0], pair[1])
+		}
+	}
+	if len(kv) == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// FromIncoming читает сведения из входящих метаданных gRPC вызова.
+func FromIncoming(ctx context.Context) Info {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Info{}
+	}
+
+	return fromMD(md)
+}
+
+// FromOutgoing читает сведения, добавленные в контекст AppendOutgoing, например в обработчиках api-gw.
+func FromOutgoing(ctx context.Context) Info {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		return Info{}
+	}
+
+	return fromMD(md)
+}
+
+func fromMD(md metadata.MD) Info {
+	get := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	return Info{
+		RequestID:  get(keyRequestID),
+		ClientIP:   get(keyClientIP),
+		ActorID:    get(keyActorID),
+		AdminToken: get(keyAdminToken),
+	}
+}
+
+// Admins - администраторы из конфигурации сервиса.
+type Admins struct {
+	ids   map[string]struct{}
+	token string
+}
+
+// NewAdmins создает список администраторов. Запрос администратора должен нести секрет token,
+// пустой token отключает доступ администраторов.
+func NewAdmins(ids []string, token string) Admins {
+	a := Admins{ids: make(map[string]struct{}, len(ids)), token: token}
+	for _, id := range ids {
+		a.ids[id] = struct{}{}
+	}
+
+	return a
+}
+
+// Allow сообщает, выполняется ли запрос от имени администратора. ActorID приходит из заголовка X-User-ID,
+// который клиент может подставить любым, поэтому сам по себе он не подтверждает личность: границей доступа
+// служит секрет из X-Admin-Token, известный только операторам.
+func (a Admins) Allow(i Info) bool {
+	if a.token == "" || subtle.ConstantTimeCompare([]byte(i.AdminToken), []byte(a.token)) != 1 {
+		return false
+	}
+	_, ok := a.ids[i.ActorID]
+
+	return ok
+}
@@ -0,0 +1,140 @@
+package reqmeta
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestFromHTTP(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		remote  string
+		want    Info
+	}{
+		{
+			name:    "test_forwarded_for_from_trusted_proxy",
+			headers: map[string]string{"X-Forwarded-For": "203.0.113.7, 10.0.0.1", HeaderRequestID: "req-1"},
+			remote:  "10.0.0.2:5000",
+			want:    Info{RequestID: "req-1", ClientIP: "203.0.113.7"},
+		},
+		{
+			name:    "test_remote_addr",
+			headers: map[string]string{HeaderRequestID: "req-2", HeaderActorID: "user-1"},
+			remote:  "192.0.2.10:41000",
+			want:    Info{RequestID: "req-2", ClientIP: "192.0.2.10", ActorID: "user-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				r := httptest.NewRequest("GET", "/", nil)
+				r.RemoteAddr = tt.remote
+				for k, v := range tt.headers {
+					r.Header.Set(k, v)
+				}
+
+				if got := FromHTTP(r, Proxies{netip.MustParsePrefix("10.0.0.0/8")}); got != tt.want {
+					t.Errorf("FromHTTP() = %+v, want %+v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestFromHTTP_GeneratesRequestID(t *testing.T) {
+	if got := FromHTTP(httptest.NewRequest("GET", "/", nil), nil); got.RequestID == "" {
+		t.Error("FromHTTP() must generate a request ID")
+	}
+}
+
+func TestInfo_RoundTrip(t *testing.T) {
+	want := Info{RequestID: "req-1", ClientIP: "203.0.113.7", ActorID: "user-1", AdminToken: "secret"}
+
+	ctx := want.AppendOutgoing(context.Background())
+	if got := FromOutgoing(ctx); got != want {
+		t.Errorf("FromOutgoing() = %+v, want %+v", got, want)
+	}
+
+	out, _ := metadata.FromOutgoingContext(ctx)
+	got := FromIncoming(metadata.NewIncomingContext(context.Background(), out))
+	if got != want {
+		t.Errorf("FromIncoming() = %+v, want %+v", got, want)
+	}
+}
+
+func TestProxies_ClientIP(t *testing.T) {
+	proxies := Proxies{netip.MustParsePrefix("10.0.0.0/8")}
+
+	tests := []struct {
+		name      string
+		remote    string
+		forwarded string
+		want      string
+	}{
+		{name: "test_direct_client", remote: "203.0.113.7:1234", want: "203.0.113.7"},
+		{
+			name:      "test_forwarded_for_from_untrusted_peer",
+			remote:    "203.0.113.7:1234",
+			forwarded: "198.51.100.1",
+			want:      "203.0.113.7",
+		},
+		{name: "test_trusted_proxy", remote: "10.0.0.2:1234", forwarded: "203.0.113.7", want: "203.0.113.7"},
+		{
+			name:      "test_client_cannot_prepend_addresses",
+			remote:    "10.0.0.2:1234",
+			forwarded: "198.51.100.1, 203.0.113.7, 10.0.0.3",
+			want:      "203.0.113.7",
+		},
+		{name: "test_trusted_proxy_without_forwarded_for", remote: "10.0.0.2:1234", want: "10.0.0.2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = tt.remote
+			if tt.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+
+			if got := proxies.ClientIP(req); got != tt.want {
+				t.Errorf("ClientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdmins_Allow(t *testing.T) {
+	admins := NewAdmins([]string{"admin-1"}, "secret")
+
+	tests := []struct {
+		name string
+		info Info
+		want bool
+	}{
+		{name: "test_admin_with_token", info: Info{ActorID: "admin-1", AdminToken: "secret"}, want: true},
+		{name: "test_admin_without_token", info: Info{ActorID: "admin-1"}, want: false},
+		{name: "test_admin_with_wrong_token", info: Info{ActorID: "admin-1", AdminToken: "guess"}, want: false},
+		{name: "test_user_with_token", info: Info{ActorID: "user-1", AdminToken: "secret"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if got := admins.Allow(tt.info); got != tt.want {
+					t.Errorf("Allow() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestAdmins_AllowWithoutToken(t *testing.T) {
+	if NewAdmins([]string{"admin-1"}, "").Allow(Info{ActorID: "admin-1"}) {
+		t.Error("Allow() must deny everyone when the token is not configured")
+	}
+}
@@ -1,19 +1,91 @@
 package scrape
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
 
 	"github.com/ptsypyshev/gb-golang-level3-new/pkg/htmlmeta"
 )
 
-var client = http.DefaultClient
+// MaxBodySize ограничивает размер загружаемой страницы.
+const MaxBodySize = 10 << 20
 
-var ErrStatusCodeInvalid = errors.New("status code invalid")
+// FetchTimeout ограничивает время загрузки одного ресурса вместе с редиректами и чтением тела.
+const FetchTimeout = 15 * time.Second
 
-func Parse(ctx context.Context, url string) (*htmlmeta.Meta, error) {
+const maxRedirects = 10
+
+var client = newClient(publicAddr)
+
+var (
+	ErrStatusCodeInvalid = errors.New("status code invalid")
+	ErrAddressForbidden  = errors.New("address forbidden")
+)
+
+// newClient создает клиент, который соединяется только с адресами, разрешенными allow.
+// Адрес проверяется в момент соединения, то есть после разрешения DNS и для каждого редиректа,
+// поэтому ни подмена DNS, ни редирект не приведут запрос во внутреннюю сеть.
+func newClient(allow func(netip.AddrPort) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addr, err := netip.ParseAddrPort(address)
+			if err != nil || !allow(addr) {
+				return fmt.Errorf("dial %s: %w", address, ErrAddressForbidden)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: FetchTimeout,
+		Transport: &http.Transport{
+			// Прокси из окружения соединялся бы вместо нас и обходил проверку адреса
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   5 * time.Second,
+			ResponseHeaderTimeout: 10 * time.Second,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errors.New("stopped after 10 redirects")
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("redirect to %s: %w", req.URL.Scheme, ErrAddressForbidden)
+			}
+			return nil
+		},
+	}
+}
+
+// publicAddr разрешает только публичные unicast-адреса.
+func publicAddr(addr netip.AddrPort) bool {
+	ip := addr.Addr().Unmap()
+
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// sharedAddressSpace (RFC 6598) используется провайдерами для CGNAT и не маршрутизируется в интернете.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// Page содержит загруженную страницу, чтобы разобрать ее несколькими парсерами без повторного запроса.
+type Page struct {
+	URL         string
+	ContentType string
+	Body        []byte
+}
+
+func Fetch(ctx context.Context, url string) (*Page, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("http NewRequestWithContext: %w", err)
@@ -29,7 +101,25 @@ func Parse(ctx context.Context, url string) (*htmlmeta.Meta, error) {
 		return nil, ErrStatusCodeInvalid
 	}
 
-	meta, err := htmlmeta.Parse(ctx, resp.Body)
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("io ReadAll: %w", err)
+	}
+
+	return &Page{
+		URL:         resp.Request.URL.String(),
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        body,
+	}, nil
+}
+
+func Parse(ctx context.Context, url string) (*htmlmeta.Meta, error) {
+	page, err := Fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := htmlmeta.Parse(ctx, bytes.NewReader(page.Body))
 	if err != nil {
 		return nil, fmt.Errorf("htmlmeta Parse: %w", err)
 	}
@@ -0,0 +1,72 @@
+package scrape
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34:80", true},
+		{"[2606:2800:220:1::1]:443", true},
+		{"127.0.0.1:80", false},
+		{"10.0.0.1:80", false},
+		{"172.16.0.1:80", false},
+		{"192.168.1.1:80", false},
+		{"169.254.169.254:80", false},
+		{"100.64.0.1:80", false},
+		{"0.0.0.0:80", false},
+		{"[::1]:80", false},
+		{"[fc00::1]:80", false},
+		{"[fe80::1]:80", false},
+		{"[::ffff:127.0.0.1]:80", false},
+	}
+
+	for _, tt := range tests {
+		if got := publicAddr(netip.MustParseAddrPort(tt.addr)); got != tt.want {
+			t.Errorf("publicAddr(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestFetch_RejectsLoopback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("internal"))
+	}))
+	defer srv.Close()
+
+	_, err := Fetch(context.Background(), srv.URL)
+	if !errors.Is(err, ErrAddressForbidden) {
+		t.Fatalf("Fetch() error = %v, want %v", err, ErrAddressForbidden)
+	}
+}
+
+func TestFetch_RejectsRedirectToForbiddenAddress(t *testing.T) {
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("internal"))
+	}))
+	defer internal.Close()
+
+	public := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusFound)
+	}))
+	defer public.Close()
+
+	// Разрешаем только первый сервер: редирект на второй должен упереться в проверку адреса
+	allowed := netip.MustParseAddrPort(public.Listener.Addr().String())
+	prev := client
+	client = newClient(func(addr netip.AddrPort) bool { return addr == allowed })
+	defer func() { client = prev }()
+
+	_, err := Fetch(context.Background(), public.URL)
+	if !errors.Is(err, ErrAddressForbidden) {
+		t.Fatalf("Fetch() error = %v, want %v", err, ErrAddressForbidden)
+	}
+}
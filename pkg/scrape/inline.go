@@ -0,0 +1,174 @@
+package scrape
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Inline встраивает в страницу внешние стили и изображения, пока их суммарный размер
+// не превысит budget байт. Ресурсы, которые не удалось загрузить или не влезли в бюджет, остаются ссылками.
+func Inline(ctx context.Context, page *Page, budget int64) ([]byte, error) {
+	doc, err := html.Parse(bytes.NewReader(page.Body))
+	if err != nil {
+		return nil, fmt.Errorf("html.Parse: %w", err)
+	}
+
+	base, err := url.Parse(page.URL)
+	if err != nil {
+		return nil, fmt.Errorf("url Parse: %w", err)
+	}
+
+	in := &inliner{base: base, budget: budget}
+	in.walk(ctx, doc)
+
+	var buf bytes.Buffer
+	if err := html.Render(&buf, doc); err != nil {
+		return nil, fmt.Errorf("html.Render: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+type inliner struct {
+	base   *url.URL
+	budget int64
+}
+
+func (in *inliner) walk(ctx context.Context, n *html.Node) {
+	if ctx.Err() != nil || in.budget <= 0 {
+		return
+	}
+
+	if n.Type == html.ElementNode {
+		switch n.DataAtom {
+		case atom.Link:
+			if strings.EqualFold(attr(n, "rel"), "stylesheet") {
+				in.inlineStylesheet(ctx, n)
+				return
+			}
+		case atom.Img:
+			in.inlineImage(ctx, n)
+		default:
+		}
+	}
+
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		in.walk(ctx, c)
+		c = next
+	}
+}
+
+func (in *inliner) inlineStylesheet(ctx context.Context, n *html.Node) {
+	data, _, ok := in.fetch(ctx, attr(n, "href"))
+	if !ok {
+		return
+	}
+
+	style := &html.Node{Type: html.ElementNode, Data: "style", DataAtom: atom.Style}
+	style.AppendChild(&html.Node{Type: html.TextNode, Data: string(data)})
+
+	n.Parent.InsertBefore(style, n)
+	n.Parent.RemoveChild(n)
+}
+
+func (in *inliner) inlineImage(ctx context.Context, n *html.Node) {
+	src := attr(n, "src")
+	if strings.HasPrefix(src, "data:") {
+		return
+	}
+
+	data, contentType, ok := in.fetch(ctx, src)
+	if !ok {
+		return
+	}
+
+	if !strings.HasPrefix(contentType, "image/") {
+		contentType = http.DetectContentType(data)
+	}
+
+	setAttr(n, "src", "data:"+contentType+";base64,"+base64.StdEncoding.EncodeToString(data))
+	removeAttr(n, "srcset")
+}
+
+func (in *inliner) fetch(ctx context.Context, ref string) ([]byte, string, bool) {
+	if ref == "" {
+		return nil, "", false
+	}
+
+	u, err := in.base.Parse(ref)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, "", false
+	}
+
+	// Отдельный срок на каждый ресурс, чтобы один медленный сервер не съел время всей страницы
+	ctx, cancel := context.WithTimeout(ctx, FetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, "", false
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		slog.Debug("cannot fetch resource for inlining", slog.String("url", u.String()), slog.Any("err", err))
+		return nil, "", false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", false
+	}
+
+	// Читаем на байт больше бюджета, чтобы понять, что ресурс в него не влезает
+	data, err := io.ReadAll(io.LimitReader(resp.Body, in.budget+1))
+	if err != nil || int64(len(data)) > in.budget {
+		return nil, "", false
+	}
+
+	in.budget -= int64(len(data))
+
+	return data, resp.Header.Get("Content-Type"), true
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+
+	return ""
+}
+
+func setAttr(n *html.Node, key, val string) {
+	for i := range n.Attr {
+		if n.Attr[i].Key == key {
+			n.Attr[i].Val = val
+			return
+		}
+	}
+
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: val})
+}
+
+func removeAttr(n *html.Node, key string) {
+	attrs := n.Attr[:0]
+	for _, a := range n.Attr {
+		if a.Key != key {
+			attrs = append(attrs, a)
+		}
+	}
+	n.Attr = attrs
+}
@@ -0,0 +1,67 @@
+package scrape
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+// allowAll подменяет клиент на время теста, потому что httptest слушает loopback.
+func allowAll(t *testing.T) {
+	t.Helper()
+
+	prev := client
+	client = newClient(func(netip.AddrPort) bool { return true })
+	t.Cleanup(func() { client = prev })
+}
+
+func TestInline(t *testing.T) {
+	allowAll(t)
+
+	png := []byte("\x89PNG\r\n\x1a\n0000")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/style.css":
+			w.Header().Set("Content-Type", "text/css")
+			_, _ = w.Write([]byte("body{color:red}"))
+		case "/small.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(png)
+		case "/large.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte(strings.Repeat("0", 1024)))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	page := &Page{
+		URL: srv.URL + "/article",
+		Body: []byte(`<html><head><link rel="stylesheet" href="/style.css"></head>
+			<body><img src="small.png"><img src="/large.png"><img src="/missing.png"></body></html>`),
+	}
+
+	got, err := Inline(context.Background(), page, 100)
+	if err != nil {
+		t.Fatalf("Inline() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"<style>body{color:red}</style>",
+		`src="data:image/png;base64,`,
+		`src="/large.png"`,
+		`src="/missing.png"`,
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("Inline() = %s, want it to contain %s", got, want)
+		}
+	}
+
+	if strings.Contains(string(got), "stylesheet") {
+		t.Errorf("Inline() = %s, want stylesheet link to be replaced", got)
+	}
+}
@@ -0,0 +1,48 @@
+// Package slug генерирует и проверяет короткие идентификаторы ссылок.
+package slug
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+)
+
+const (
+	alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	MinLength = 3
+	MaxLength = 64
+)
+
+var alphabetSize = big.NewInt(int64(len(alphabet)))
+
+// Generate возвращает случайный slug из n символов base62.
+func Generate(n int) (string, error) {
+	b := make([]byte, n)
+	for i := range b {
+		idx, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("rand Int: %w", err)
+		}
+		b[i] = alphabet[idx.Int64()]
+	}
+
+	return string(b), nil
+}
+
+// Valid проверяет пользовательский slug: латинские буквы, цифры, "-" и "_" длиной от MinLength до MaxLength.
+func Valid(s string) bool {
+	if len(s) < MinLength || len(s) > MaxLength {
+		return false
+	}
+
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9', c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c == '-', c == '_':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
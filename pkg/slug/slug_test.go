@@ -0,0 +1,58 @@
+package slug
+
+import "testing"
+
+func TestGenerate(t *testing.T) {
+	s, err := Generate(7)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if len(s) != 7 || !Valid(s) {
+		t.Errorf("Generate() = %q, want 7 base62 characters", s)
+	}
+}
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		name string
+		slug string
+		want bool
+	}{
+		{
+			name: "test_base62",
+			slug: "aZ09",
+			want: true,
+		},
+		{
+			name: "test_dash_and_underscore",
+			slug: "go-news_2024",
+			want: true,
+		},
+		{
+			name: "test_too_short",
+			slug: "ab",
+			want: false,
+		},
+		{
+			name: "test_path_characters",
+			slug: "../admin",
+			want: false,
+		},
+		{
+			name: "test_non_ascii",
+			slug: "ссылка",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if got := Valid(tt.slug); got != tt.want {
+					t.Errorf("Valid(%q) = %v, want %v", tt.slug, got, tt.want)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,127 @@
+package tagnorm
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalizer приводит теги к единому виду: NFKC нормализация юникода, нижний регистр,
+// без пробелов по краям и разделителей в конце, с заменой синонимов и отбрасыванием стоп-слов.
+type Normalizer struct {
+	maxLength int
+	stopWords map[string]struct{}
+	synonyms  map[string]string
+}
+
+// New создает нормализатор. Теги длиннее maxLength символов обрезаются, maxLength <= 0 снимает ограничение.
+// Ключи и значения synonyms нормализуются так же, как и теги.
+func New(maxLength int, stopWords []string, synonyms map[string]string) *Normalizer {
+	n := &Normalizer{
+		maxLength: maxLength,
+		stopWords: make(map[string]struct{}, len(stopWords)),
+		synonyms:  make(map[string]string, len(synonyms)),
+	}
+
+	for _, w := range stopWords {
+		if w = n.clean(w); w != "" {
+			n.stopWords[w] = struct{}{}
+		}
+	}
+
+	for from, to := range synonyms {
+		from, to = n.clean(from), n.clean(to)
+		if from != "" && to != "" {
+			n.synonyms[from] = to
+		}
+	}
+
+	return n
+}
+
+// Normalize возвращает нормализованный тег или пустую строку, если тег нужно отбросить.
+func (n *Normalizer) Normalize(tag string) string {
+	tag = n.clean(tag)
+
+	if to, ok := n.synonyms[tag]; ok {
+		tag = to
+	}
+
+	if _, ok := n.stopWords[tag]; ok {
+		return ""
+	}
+
+	return tag
+}
+
+// NormalizeAll нормализует теги, отбрасывая пустые и повторяющиеся с сохранением порядка.
+func (n *Normalizer) NormalizeAll(tags []string) []string {
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+
+	for _, tag := range tags {
+		tag = n.Normalize(tag)
+		if tag == "" {
+			continue
+		}
+
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+
+		seen[tag] = struct{}{}
+		res = append(res, tag)
+	}
+
+	return res
+}
+
+func (n *Normalizer) clean(tag string) string {
+	tag = norm.NFKC.String(tag)
+	tag = strings.ToLower(tag)
+	tag = strings.Join(strings.Fields(tag), " ")
+	tag = trim(tag)
+
+	if n.maxLength > 0 {
+		if runes := []rune(tag); len(runes) > n.maxLength {
+			tag = trim(string(runes[:n.maxLength]))
+		}
+	}
+
+	return tag
+}
+
+// trailingSeparators остаются в конце тега, когда теги вводят списком через запятую или точку с запятой.
+const trailingSeparators = ",;:"
+
+// trim убирает по краям пробелы и управляющие символы, а в конце еще и разделители.
+// Остальная пунктуация бывает частью названия, как в c++, c# и .net, поэтому сохраняется.
+func trim(tag string) string {
+	tag = strings.TrimFunc(tag, isBlank)
+
+	return strings.TrimRightFunc(tag, func(r rune) bool {
+		return isBlank(r) || strings.ContainsRune(trailingSeparators, r)
+	})
+}
+
+func isBlank(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsControl(r)
+}
+
+// Subtract возвращает теги из tags, которых нет в exclude.
+func Subtract(tags, exclude []string) []string {
+	skip := make(map[string]struct{}, len(exclude))
+	for _, tag := range exclude {
+		skip[tag] = struct{}{}
+	}
+
+	res := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if _, ok := skip[tag]; !ok {
+			res = append(res, tag)
+		}
+	}
+
+	return res
+}
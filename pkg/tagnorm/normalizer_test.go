@@ -0,0 +1,59 @@
+package tagnorm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizer_NormalizeAll(t *testing.T) {
+	n := New(10, []string{"the", "And"}, map[string]string{"JS": "javascript", "golang": "Go"})
+
+	tests := []struct {
+		name  string
+		input []string
+		want  []string
+	}{
+		{
+			name:  "test_empty",
+			input: nil,
+			want:  []string{},
+		},
+		{
+			name:  "test_case_space_and_separators",
+			input: []string{"  Go ", "go,", "go; ", "go:", "", " , ", "\tgo\n", "Web  Development!"},
+			want:  []string{"go", "web develo"},
+		},
+		{
+			name:  "test_meaningful_punctuation",
+			input: []string{"C++", "c#", ".NET", "node.js", "c++,"},
+			want:  []string{"c++", "c#", ".net", "node.js"},
+		},
+		{
+			name:  "test_unicode_normalization",
+			input: []string{"Ｇｏ", "caf\u00e9", "cafe\u0301"},
+			want:  []string{"go", "caf\u00e9"},
+		},
+		{
+			name:  "test_stop_words_and_synonyms",
+			input: []string{"The", "js", "JavaScript", "and", "golang"},
+			want:  []string{"javascript", "go"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if got := n.NormalizeAll(tt.input); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("NormalizeAll() = %q, want %q", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	got := Subtract([]string{"a", "b", "c"}, []string{"b"})
+	if want := []string{"a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Subtract() = %q, want %q", got, want)
+	}
+}
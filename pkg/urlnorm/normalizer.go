@@ -0,0 +1,107 @@
+// Package urlnorm приводит URL к каноническому виду, чтобы одинаковые страницы,
+// сохраненные с разными параметрами отслеживания, схемой или слешем на конце, совпадали.
+package urlnorm
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+var ErrInvalidURL = errors.New("invalid url")
+
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+type Normalizer struct {
+	params   map[string]struct{}
+	prefixes []string
+}
+
+// New создает нормализатор. Параметры из trackingParams удаляются из запроса,
+// параметр с "*" на конце задает префикс, например "utm_*". Регистр не учитывается.
+func New(trackingParams []string) *Normalizer {
+	n := &Normalizer{params: make(map[string]struct{}, len(trackingParams))}
+
+	for _, p := range trackingParams {
+		p = strings.ToLower(strings.TrimSpace(p))
+		switch {
+		case p == "":
+		case strings.HasSuffix(p, "*"):
+			n.prefixes = append(n.prefixes, strings.TrimSuffix(p, "*"))
+		default:
+			n.params[p] = struct{}{}
+		}
+	}
+
+	return n
+}
+
+// Normalize возвращает канонический вид адреса: схема https вместо http, хост в нижнем регистре
+// без порта по умолчанию, путь без завершающего слеша, отсортированный запрос без параметров
+// отслеживания и без фрагмента. Результат служит ключом сравнения, а не адресом для перехода.
+func (n *Normalizer) Normalize(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", fmt.Errorf("url Parse: %w", err)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidURL, raw)
+	}
+
+	host, port := u.Hostname(), u.Port()
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if port == defaultPorts[u.Scheme] {
+		port = ""
+	}
+	if port != "" {
+		host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	u.Scheme = "https"
+	u.Host = host
+	u.User = nil
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = strings.TrimRight(u.RawPath, "/")
+	u.RawQuery = n.query(u.Query())
+	u.Fragment, u.RawFragment = "", ""
+
+	return u.String(), nil
+}
+
+// query оставляет значимые параметры, Encode сортирует их по ключу.
+func (n *Normalizer) query(values url.Values) string {
+	for key, v := range values {
+		if n.tracking(key) {
+			values.Del(key)
+			continue
+		}
+		sort.Strings(v)
+	}
+
+	return values.Encode()
+}
+
+func (n *Normalizer) tracking(key string) bool {
+	key = strings.ToLower(key)
+	if _, ok := n.params[key]; ok {
+		return true
+	}
+
+	for _, prefix := range n.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
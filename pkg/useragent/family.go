@@ -0,0 +1,44 @@
+// Package useragent определяет семейство клиента по заголовку User-Agent без внешних баз.
+package useragent
+
+import "strings"
+
+const (
+	Bot     = "Bot"
+	Edge    = "Edge"
+	Opera   = "Opera"
+	Chrome  = "Chrome"
+	Firefox = "Firefox"
+	Safari  = "Safari"
+	Curl    = "curl"
+	Other   = "Other"
+)
+
+// rules проверяются по порядку: Edge и Opera притворяются Chrome, а Chrome — Safari.
+var rules = []struct {
+	family  string
+	markers []string
+}{
+	{Bot, []string{"bot", "crawler", "spider", "slurp", "facebookexternalhit", "preview"}},
+	{Curl, []string{"curl/", "wget/", "httpie/", "go-http-client/", "python-requests/"}},
+	{Edge, []string{"edg/", "edge/", "edga/", "edgios/"}},
+	{Opera, []string{"opr/", "opera"}},
+	{Firefox, []string{"firefox/", "fxios/"}},
+	{Chrome, []string{"chrome/", "crios/", "chromium/"}},
+	{Safari, []string{"safari/"}},
+}
+
+// Family возвращает семейство клиента. Пустой или неизвестный User-Agent относится к Other.
+func Family(ua string) string {
+	ua = strings.ToLower(ua)
+
+	for _, rule := range rules {
+		for _, marker := range rule.markers {
+			if strings.Contains(ua, marker) {
+				return rule.family
+			}
+		}
+	}
+
+	return Other
+}
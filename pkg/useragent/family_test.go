@@ -0,0 +1,62 @@
+package useragent
+
+import "testing"
+
+func TestFamily(t *testing.T) {
+	tests := []struct {
+		name string
+		ua   string
+		want string
+	}{
+		{
+			name: "test_empty",
+			ua:   "",
+			want: Other,
+		},
+		{
+			name: "test_chrome",
+			ua:   "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/122.0.0.0 Safari/537.36",
+			want: Chrome,
+		},
+		{
+			name: "test_edge",
+			ua:   "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/122.0.0.0 Safari/537.36 Edg/122.0.2365.66",
+			want: Edge,
+		},
+		{
+			name: "test_opera",
+			ua:   "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/121.0.0.0 Safari/537.36 OPR/107.0.0.0",
+			want: Opera,
+		},
+		{
+			name: "test_firefox",
+			ua:   "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:123.0) Gecko/20100101 Firefox/123.0",
+			want: Firefox,
+		},
+		{
+			name: "test_safari",
+			ua:   "Mozilla/5.0 (iPhone; CPU iPhone OS 17_3 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.3 Mobile/15E148 Safari/604.1",
+			want: Safari,
+		},
+		{
+			name: "test_bot",
+			ua:   "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			want: Bot,
+		},
+		{
+			name: "test_curl",
+			ua:   "curl/8.5.0",
+			want: Curl,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if got := Family(tt.ua); got != tt.want {
+					t.Errorf("Family() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
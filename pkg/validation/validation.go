@@ -0,0 +1,104 @@
+// Package validation собирает нарушения полей запроса gRPC в ошибку InvalidArgument
+// с деталями errdetails.BadRequest, которые api-gw отдает клиенту списком.
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors копит нарушения. Нулевое значение готово к работе.
+type Errors struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+// Add добавляет нарушение поля field.
+func (e *Errors) Add(field, format string, args ...any) {
+	e.violations = append(e.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// Err возвращает ошибку InvalidArgument со всеми нарушениями или nil, если их нет.
+func (e *Errors) Err() error {
+	if len(e.violations) == 0 {
+		return nil
+	}
+
+	msg := e.violations[0].Field + ": " + e.violations[0].Description
+	if n := len(e.violations); n > 1 {
+		msg += fmt.Sprintf(" and %d more violations", n-1)
+	}
+
+	st, err := status.New(codes.InvalidArgument, msg).WithDetails(&errdetails.BadRequest{FieldViolations: e.violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+
+	return st.Err()
+}
+
+// FieldViolations возвращает нарушения из деталей ошибки gRPC.
+func FieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	var res []*errdetails.BadRequest_FieldViolation
+	for _, d := range status.Convert(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			res = append(res, br.FieldViolations...)
+		}
+	}
+
+	return res
+}
+
+// MaxLength проверяет, что значение не длиннее max байт.
+func (e *Errors) MaxLength(field, value string, max int) {
+	if len(value) > max {
+		e.Add(field, "must be at most %d bytes long", max)
+	}
+}
+
+// HTTPURL проверяет, что значение - абсолютный адрес http или https с хостом не длиннее max байт.
+func (e *Errors) HTTPURL(field, value string, max int) {
+	switch {
+	case value == "":
+		e.Add(field, "is required")
+		return
+	case len(value) > max:
+		e.Add(field, "must be at most %d bytes long", max)
+		return
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		e.Add(field, "is not a valid URL")
+		return
+	}
+
+	if scheme := strings.ToLower(u.Scheme); scheme != "http" && scheme != "https" {
+		e.Add(field, "must use http or https scheme")
+		return
+	}
+
+	if u.Host == "" {
+		e.Add(field, "must have a host")
+	}
+}
+
+// UUID проверяет, что значение - UUID.
+func (e *Errors) UUID(field, value string) {
+	if value == "" {
+		e.Add(field, "is required")
+		return
+	}
+
+	if _, err := uuid.Parse(value); err != nil {
+		e.Add(field, "must be a UUID")
+	}
+}
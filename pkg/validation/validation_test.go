@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrors_HTTPURL(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "test_https",
+			value: "https://go.dev/doc",
+		},
+		{
+			name:  "test_empty",
+			value: "",
+			want:  "is required",
+		},
+		{
+			name:  "test_javascript_scheme",
+			value: "javascript:alert(1)",
+			want:  "must use http or https scheme",
+		},
+		{
+			name:  "test_without_host",
+			value: "http:///path",
+			want:  "must have a host",
+		},
+		{
+			name:  "test_too_long",
+			value: "https://go.dev/" + strings.Repeat("a", 100),
+			want:  "must be at most 64 bytes long",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var errs Errors
+				errs.HTTPURL("url", tt.value, 64)
+
+				got := FieldViolations(errs.Err())
+				if tt.want == "" {
+					if len(got) != 0 {
+						t.Errorf("HTTPURL(%q) violations = %v, want none", tt.value, got)
+					}
+					return
+				}
+
+				if len(got) != 1 || got[0].Field != "url" || got[0].Description != tt.want {
+					t.Errorf("HTTPURL(%q) violations = %v, want %q", tt.value, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestErrors_Err(t *testing.T) {
+	var errs Errors
+	if err := errs.Err(); err != nil {
+		t.Fatalf("Err() = %v, want nil without violations", err)
+	}
+
+	errs.UUID("user_id", "42")
+	errs.MaxLength("title", "long title", 4)
+
+	err := errs.Err()
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Err() code = %v, want InvalidArgument", status.Code(err))
+	}
+
+	got := FieldViolations(err)
+	if len(got) != 2 || got[0].Field != "user_id" || got[1].Field != "title" {
+		t.Errorf("FieldViolations() = %v, want user_id and title", got)
+	}
+}
@@ -4,14 +4,23 @@ import "os"
 
 const mainURL = "http://localhost:8081/api/v1/"
 
+// Администратор тестового окружения, см. USERS_ADMIN_IDS и USERS_ADMIN_TOKEN
+const (
+	adminID    = "5a0e9f3c-2b71-4d86-b4c9-0e7d1f2a6b38"
+	adminToken = "test-admin-token"
+)
+
 func SetupEnv() {
 	os.Setenv("USERS_DB_PORT", "5434")
 	os.Setenv("USERS_DB_NAME", "final")
 	os.Setenv("USERS_GRPC_ADDR", ":52001")
+	os.Setenv("USERS_ADMIN_IDS", adminID)
+	os.Setenv("USERS_ADMIN_TOKEN", adminToken)
 	os.Setenv("LINKS_DB_PORT", "27018")
 	os.Setenv("LINKS_GRPC_ADDR", ":51001")
 	os.Setenv("LINKS_AMQP_PORT", "5674")
 	os.Setenv("LINKS_AMQP_QNAME", "final")
+	os.Setenv("LINKS_USERS_ADDR", ":52001")
 	os.Setenv("APIGW_ADDR", ":8081")
 	os.Setenv("APIGW_USERS_CLIENT_ADDR", ":52001")
 	os.Setenv("APIGW_LINKS_CLIENT_ADDR", ":51001")
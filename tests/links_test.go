@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
@@ -19,6 +20,21 @@ func (s *IntegrationTestSuite) TestLinkHandlers() {
 
 	var linkID primitive.ObjectID
 
+	const (
+		ownerID    = "6f0c6f9e-3c8a-4a55-9a0c-2b1d7f3e9c11"
+		strangerID = "0b7d3c51-94a2-4f0e-8d6a-5e2f1c9b7a40"
+	)
+
+	// links-srv проверяет, что владелец ссылки существует в users-srv
+	connStr := s.conf.UsersService.Postgres.ConnectionURL()
+	assert.NoError(t, CreateSchema(connStr))
+	assert.NoError(t, CreateUser(connStr, ownerID, "link-owner"))
+	assert.NoError(t, CreateUser(connStr, strangerID, "link-stranger"))
+	t.Cleanup(func() {
+		assert.NoError(t, DeleteUser(connStr, ownerID))
+		assert.NoError(t, DeleteUser(connStr, strangerID))
+	})
+
 	t.Run("Create Link", func(t *testing.T) {
 		if testing.Short() {
 			t.Skip()
@@ -27,6 +43,7 @@ func (s *IntegrationTestSuite) TestLinkHandlers() {
 		var client http.Client
 
 		reqBody := `{
+			"user_id": "` + ownerID + `",
 			"title": "main page",
 			"url": "https://gb.ru/",
 			"tags": [
@@ -65,6 +82,7 @@ func (s *IntegrationTestSuite) TestLinkHandlers() {
 		}
 		err = json.Unmarshal(resBody, &result)
 		assert.NoError(t, err)
+		require.Len(t, result.Links, 1)
 		assert.Equal(t, "https://gb.ru/", result.Links[0].URL)
 		linkID = result.Links[0].ID
 	})
@@ -91,15 +109,176 @@ func (s *IntegrationTestSuite) TestLinkHandlers() {
 		assert.Equal(t, "https://gb.ru/", link.URL)
 	})
 
+	t.Run("Hide Private Link", func(t *testing.T) {
+		var client http.Client
+
+		// Ссылки без явного уровня доступа публичные, поэтому приватную ссылку владелец помечает сам
+		reqBody := `{"user_id": "` + ownerID + `", "url": "https://gb.ru/private", "visibility": "private"}`
+		req, err := http.NewRequest(http.MethodPost, mainURL+"links", strings.NewReader(reqBody))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var private database.Link
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&private))
+		resp.Body.Close()
+
+		// Автор запроса передается в X-User-ID, без него приватная ссылка тоже не видна
+		for actorID, want := range map[string]int{
+			"":         http.StatusNotFound,
+			strangerID: http.StatusNotFound,
+			ownerID:    http.StatusOK,
+		} {
+			for _, path := range []string{"links/" + private.ID.Hex(), "links/" + private.ID.Hex() + "/snapshots"} {
+				req, err := http.NewRequest(http.MethodGet, mainURL+path, nil)
+				require.NoError(t, err)
+				if actorID != "" {
+					req.Header.Set("X-User-ID", actorID)
+				}
+
+				resp, err := client.Do(req)
+				require.NoError(t, err)
+				assert.Equal(t, want, resp.StatusCode, "%s as %q", path, actorID)
+				resp.Body.Close()
+			}
+		}
+
+		// Другой пользователь не видит приватную ссылку владельца ни в поиске, ни в списке по ID владельца
+		for _, tc := range []struct {
+			path, actorID string
+			want          int
+		}{
+			{"links?user_id=" + ownerID, strangerID, 1},
+			{"links?user_id=" + ownerID, ownerID, 2},
+			{"links/user/" + ownerID, strangerID, 1},
+			{"links/user/" + ownerID, ownerID, 2},
+		} {
+			req, err := http.NewRequest(http.MethodGet, mainURL+tc.path, nil)
+			require.NoError(t, err)
+			req.Header.Set("X-User-ID", tc.actorID)
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var result struct {
+				Links []database.Link `json:"links"`
+			}
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+			resp.Body.Close()
+			assert.Len(t, result.Links, tc.want, "%s as %s", tc.path, tc.actorID)
+		}
+	})
+
+	t.Run("Link Settings Owner Only", func(t *testing.T) {
+		var client http.Client
+
+		for _, tc := range []struct {
+			method, actorID string
+			want            int
+		}{
+			// Без заголовка запрос не проходит проверку по спецификации
+			{http.MethodGet, "", http.StatusBadRequest},
+			{http.MethodGet, strangerID, http.StatusForbidden},
+			{http.MethodPut, strangerID, http.StatusForbidden},
+			{http.MethodGet, ownerID, http.StatusOK},
+		} {
+			req, err := http.NewRequest(tc.method, mainURL+"users/"+ownerID+"/link-settings",
+				strings.NewReader(`{"default_visibility": "public"}`))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			if tc.actorID != "" {
+				req.Header.Set("X-User-ID", tc.actorID)
+			}
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, resp.StatusCode, "%s as %q", tc.method, tc.actorID)
+			resp.Body.Close()
+		}
+	})
+
+	t.Run("Stats And Export Owner Only", func(t *testing.T) {
+		var client http.Client
+
+		for _, tc := range []struct {
+			path, actorID string
+			want          int
+		}{
+			{"links/" + linkID.Hex() + "/stats", "", http.StatusBadRequest},
+			{"links/" + linkID.Hex() + "/stats", strangerID, http.StatusNotFound},
+			{"links/" + linkID.Hex() + "/stats", ownerID, http.StatusOK},
+			{"links/export?user_id=" + ownerID, strangerID, http.StatusForbidden},
+			{"links/export?user_id=" + ownerID, ownerID, http.StatusOK},
+		} {
+			req, err := http.NewRequest(http.MethodGet, mainURL+tc.path, nil)
+			require.NoError(t, err)
+			if tc.actorID != "" {
+				req.Header.Set("X-User-ID", tc.actorID)
+			}
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, resp.StatusCode, "%s as %q", tc.path, tc.actorID)
+			resp.Body.Close()
+		}
+	})
+
+	t.Run("Slug Owner Only", func(t *testing.T) {
+		var client http.Client
+
+		for actorID, want := range map[string]int{strangerID: http.StatusNotFound, ownerID: http.StatusOK} {
+			req, err := http.NewRequest(http.MethodPut, mainURL+"links/"+linkID.Hex()+"/slug", strings.NewReader(`{}`))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-User-ID", actorID)
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			assert.Equal(t, want, resp.StatusCode, "as %s", actorID)
+			resp.Body.Close()
+		}
+	})
+
 	t.Run("Update Link", func(t *testing.T) {
 		var client http.Client
 
-		reqBody := fmt.Sprintf(`{"id": "%s", "url": "https://ya.ru"}`, linkID.Hex())
+		// Чужую ссылку PUT не меняет
+		reqBody := fmt.Sprintf(`{"user_id": "%s", "url": "https://example.com"}`, strangerID)
 		req, err := http.NewRequest(http.MethodPut, mainURL+"links/"+linkID.Hex(), strings.NewReader(reqBody))
 		req.Header.Set("Content-Type", "application/json")
 		assert.NoError(t, err)
 
 		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		resp.Body.Close()
+
+		// Ссылку с новым ID PUT создает
+		newID := primitive.NewObjectID().Hex()
+		req, err = http.NewRequest(http.MethodPut, mainURL+"links/"+newID, strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		assert.NoError(t, err)
+
+		resp, err = client.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		resp.Body.Close()
+
+		resp, err = client.Get(mainURL + "links/" + newID)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+
+		reqBody = fmt.Sprintf(`{"id": "%s", "user_id": "%s", "url": "https://ya.ru"}`, linkID.Hex(), ownerID)
+		req, err = http.NewRequest(http.MethodPut, mainURL+"links/"+linkID.Hex(), strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		assert.NoError(t, err)
+
+		resp, err = client.Do(req)
 		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 		assert.NoError(t, err)
 
@@ -132,10 +311,20 @@ func (s *IntegrationTestSuite) TestLinkHandlers() {
 	t.Run("Delete Link", func(t *testing.T) {
 		var client http.Client
 
+		// Чужую ссылку от своего имени удалить нельзя
 		req, err := http.NewRequest(http.MethodDelete, mainURL+"links/"+linkID.Hex(), nil)
 		assert.NoError(t, err)
+		req.Header.Set("X-User-ID", strangerID)
 
 		resp, err := client.Do(req)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.NoError(t, err)
+
+		// Запрос без X-User-ID удаляет ссылку, как и раньше
+		req, err = http.NewRequest(http.MethodDelete, mainURL+"links/"+linkID.Hex(), nil)
+		assert.NoError(t, err)
+
+		resp, err = client.Do(req)
 		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 		assert.NoError(t, err)
 
@@ -157,6 +346,6 @@ func (s *IntegrationTestSuite) TestLinkHandlers() {
 
 		resp, err := client.Do(req)
 		assert.NoError(t, err)
-		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 }
@@ -17,6 +17,7 @@ type IntegrationTestSuite struct {
 	suite.Suite
 	conf       config.Config
 	closer     *env.Closer
+	stop       context.CancelFunc // останавливает фоновые истории links-srv
 	pgPool     *dockertest.Pool
 	pgRes      *dockertest.Resource
 	mongoPool  *dockertest.Pool
@@ -57,6 +58,14 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		s.Assert().NoError(err)
 	}()
 
+	// Очистка и восстановление данных пользователей идут по событиям users-srv
+	storiesCtx, stop := context.WithCancel(context.Background())
+	s.stop = stop
+	go func() {
+		err := e.UserCleanup.Run(storiesCtx)
+		s.Assert().ErrorIs(err, context.Canceled)
+	}()
+
 	go func() {
 		defer e.APIGWHTTPServer.Close()
 		err := e.APIGWHTTPServer.ListenAndServe()
@@ -70,6 +79,7 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 	defer Stop(s.pgPool, s.pgRes)
 	defer Stop(s.mongoPool, s.mongoRes)
 	defer Stop(s.rabbitPool, s.rabbitRes)
+	s.stop()
 	s.closer.Close(context.Background())
 }
 
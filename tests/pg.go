@@ -22,9 +22,33 @@ func CreateSchema(connStr string) error {
 		password   TEXT NOT NULL,
 		created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
 		updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		deleted_at TIMESTAMP WITH TIME ZONE,
 
 		CONSTRAINT pk_users_idx PRIMARY KEY (id),
 		CONSTRAINT users_username_uniq_idx UNIQUE (username)
 	)`)
 	return err
 }
+
+// CreateUser добавляет пользователя напрямую в базу, например владельца ссылок для тестов links-srv.
+func CreateUser(connStr, id, username string) error {
+	return exec(connStr, `INSERT INTO users (id, username, password) VALUES ($1, $2, 'test-password')`, id, username)
+}
+
+// DeleteUser удаляет пользователя, созданного CreateUser.
+func DeleteUser(connStr, id string) error {
+	return exec(connStr, `DELETE FROM users WHERE id = $1`, id)
+}
+
+func exec(connStr, query string, args ...any) error {
+	ctx := context.TODO()
+
+	usersDBConn, err := pgxpool.Connect(ctx, connStr)
+	if err != nil {
+		return err
+	}
+	defer usersDBConn.Close()
+
+	_, err = usersDBConn.Exec(ctx, query, args...)
+	return err
+}
@@ -7,11 +7,13 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ptsypyshev/gb-golang-level3-new/internal/database"
+	"github.com/ptsypyshev/gb-golang-level3-new/pkg/api/apiv1"
 )
 
 func (s *IntegrationTestSuite) TestUserHandlers() {
@@ -28,7 +30,7 @@ func (s *IntegrationTestSuite) TestUserHandlers() {
 
 		var client http.Client
 
-		reqBody := `{"username": "pavel", "password": "test"}`
+		reqBody := `{"username": "pavel", "password": "test-password"}`
 		req, err := http.NewRequest(http.MethodPost, mainURL+"users", strings.NewReader(reqBody))
 		req.Header.Set("Content-Type", "application/json")
 		assert.NoError(t, err)
@@ -91,6 +93,31 @@ func (s *IntegrationTestSuite) TestUserHandlers() {
 		assert.Equal(t, "pavel", user.Username)
 	})
 
+	t.Run("Read Profile", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip()
+		}
+
+		var client http.Client
+
+		req, err := http.NewRequest(http.MethodGet, mainURL+"users/"+userID.String()+"/profile", nil)
+		assert.NoError(t, err)
+
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+
+		var profile apiv1.UserProfile
+		err = json.NewDecoder(resp.Body).Decode(&profile)
+		assert.NoError(t, err)
+		assert.Equal(t, false, profile.Partial)
+		if assert.NotNil(t, profile.User) {
+			assert.Equal(t, "pavel", profile.User.Username)
+		}
+	})
+
 	t.Run("Update User", func(t *testing.T) {
 		if testing.Short() {
 			t.Skip()
@@ -169,7 +196,7 @@ func (s *IntegrationTestSuite) TestUserHandlers() {
 
 		resp, err := client.Do(req)
 		assert.NoError(t, err)
-		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 
 	t.Run("Create User Bad", func(t *testing.T) {
@@ -189,3 +216,127 @@ func (s *IntegrationTestSuite) TestUserHandlers() {
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 }
+
+func (s *IntegrationTestSuite) TestUserRestore() {
+	t := s.T()
+	if testing.Short() {
+		t.Skip()
+	}
+
+	const userID = "3e9a7c12-5b64-4d8f-a0e1-7c2b9d4f6a58"
+
+	connStr := s.conf.UsersService.Postgres.ConnectionURL()
+	assert.NoError(t, CreateSchema(connStr))
+	assert.NoError(t, CreateUser(connStr, userID, "restored-user"))
+	t.Cleanup(func() {
+		assert.NoError(t, DeleteUser(connStr, userID))
+	})
+
+	var client http.Client
+
+	do := func(method, path string, body string) *http.Response {
+		req, err := http.NewRequest(method, mainURL+path, strings.NewReader(body))
+		assert.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+
+		return resp
+	}
+
+	cleanupStatus := func() string {
+		resp := do(http.MethodGet, "users/"+userID+"/cleanup", "")
+		defer resp.Body.Close()
+
+		var cleanup apiv1.UserCleanup
+		if resp.StatusCode != http.StatusOK || json.NewDecoder(resp.Body).Decode(&cleanup) != nil {
+			return ""
+		}
+
+		return string(cleanup.Status)
+	}
+
+	resp := do(http.MethodPost, "links", `{"user_id": "`+userID+`", "title": "go", "url": "https://go.dev/"}`)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var link struct {
+		ID string `json:"id"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&link))
+	resp.Body.Close()
+
+	linkPath := "links/" + link.ID
+
+	resp = do(http.MethodPost, "collections", `{"user_id": "`+userID+`", "name": "reading"}`)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var collection struct {
+		ID string `json:"id"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&collection))
+	resp.Body.Close()
+
+	collectionPath := "collections/" + collection.ID
+
+	resp = do(http.MethodDelete, "users/"+userID, "")
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	// Ссылки и коллекции удаленного пользователя links-srv перемещает в корзину по событию
+	assert.Eventually(t, func() bool { return cleanupStatus() == "done" }, 10*time.Second, 100*time.Millisecond)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodGet, linkPath, "").StatusCode)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodGet, collectionPath, "").StatusCode)
+
+	resp = do(http.MethodPost, "trash/users/"+userID+"/restore", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// После восстановления пользователя его ссылки и коллекции возвращаются из корзины
+	assert.Eventually(t, func() bool { return cleanupStatus() == "restored" }, 10*time.Second, 100*time.Millisecond)
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, linkPath, "").StatusCode)
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, collectionPath, "").StatusCode)
+}
+
+func (s *IntegrationTestSuite) TestUserExportAccess() {
+	t := s.T()
+	if testing.Short() {
+		t.Skip()
+	}
+
+	const (
+		userID     = "8c1f4e27-6a3d-4b95-9e02-5d7a1c3b8f64"
+		strangerID = "b2d95a6e-0f4c-47a1-8e3b-9c6d2f1a7e05"
+	)
+
+	connStr := s.conf.UsersService.Postgres.ConnectionURL()
+	assert.NoError(t, CreateSchema(connStr))
+	assert.NoError(t, CreateUser(connStr, userID, "exported-user"))
+	t.Cleanup(func() {
+		assert.NoError(t, DeleteUser(connStr, userID))
+	})
+
+	var client http.Client
+
+	do := func(method, actorID, token string) int {
+		req, err := http.NewRequest(method, mainURL+"users/"+userID+"/export", nil)
+		assert.NoError(t, err)
+		req.Header.Set("X-User-ID", actorID)
+		if token != "" {
+			req.Header.Set("X-Admin-Token", token)
+		}
+
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	// X-User-ID клиент подставляет сам, поэтому ни чужой, ни совпадающий с владельцем ID доступа не дает
+	assert.Equal(t, http.StatusForbidden, do(http.MethodPost, strangerID, ""))
+	assert.Equal(t, http.StatusForbidden, do(http.MethodPost, userID, ""))
+	assert.Equal(t, http.StatusForbidden, do(http.MethodGet, userID, ""))
+	assert.Equal(t, http.StatusForbidden, do(http.MethodPost, adminID, "wrong-token"))
+
+	assert.Equal(t, http.StatusAccepted, do(http.MethodPost, adminID, adminToken))
+	assert.NotEqual(t, http.StatusForbidden, do(http.MethodGet, adminID, adminToken))
+}